@@ -0,0 +1,100 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+
+	"git.neds.sh/matty/entain/pkg/featureflag"
+)
+
+// queryAliases maps friendlier query parameters onto the nested field paths
+// grpc-gateway derives from the request messages, by route. e.g.
+//
+//	/v1/events?sport=tennis&from=2022-01-01T00:00:00Z
+//
+// is equivalent to
+//
+//	/v1/events?filter.sports=tennis&filter.advertised_start_after=2022-01-01T00:00:00Z
+var queryAliases = map[string]map[string]string{
+	"/v1/events": {
+		"sport":     "filter.sports",
+		"league":    "filter.leagues",
+		"side":      "filter.sides",
+		"id":        "filter.ids",
+		"not_id":    "filter.not_ids",
+		"not_sport": "filter.not_sports",
+		"visible":   "filter.visible",
+		"from":      "filter.advertised_start_after",
+		"to":        "filter.advertised_start_before",
+		"within":    "filter.starting_within",
+	},
+	"/v1/sports": {
+		"league":  "filter.leagues",
+		"visible": "filter.visible",
+		"from":    "filter.advertised_start_after",
+		"to":      "filter.advertised_start_before",
+		"within":  "filter.starting_within",
+	},
+	"/v1/races": {
+		"meeting": "filter.meeting_ids",
+		"id":      "filter.ids",
+		"number":  "filter.number",
+		"name":    "filter.name_contains",
+		"visible": "filter.visible",
+		"from":    "filter.advertised_start_after",
+		"to":      "filter.advertised_start_before",
+		"within":  "filter.starting_within",
+	},
+	"/v1/watch-events": {
+		"sport":     "filter.sports",
+		"league":    "filter.leagues",
+		"side":      "filter.sides",
+		"id":        "filter.ids",
+		"not_id":    "filter.not_ids",
+		"not_sport": "filter.not_sports",
+		"visible":   "filter.visible",
+		"from":      "filter.advertised_start_after",
+		"to":        "filter.advertised_start_before",
+		"within":    "filter.starting_within",
+	},
+	"/v1/watch-races": {
+		"meeting": "filter.meeting_ids",
+		"id":      "filter.ids",
+		"number":  "filter.number",
+		"name":    "filter.name_contains",
+		"visible": "filter.visible",
+		"from":    "filter.advertised_start_after",
+		"to":      "filter.advertised_start_before",
+		"within":  "filter.starting_within",
+	},
+}
+
+// withQueryAliases rewrites aliased query parameters on GET requests to their
+// full field paths before they reach the gateway. Values given under both an
+// alias and the full path are combined.
+func withQueryAliases(next http.Handler, flags *featureflag.Flags) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		aliases, ok := queryAliases[r.URL.Path]
+		if !ok || r.Method != http.MethodGet || !flags.Enabled(flagQueryAliases) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		r.URL.RawQuery = translateQuery(r.URL.Query(), aliases).Encode()
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func translateQuery(query url.Values, aliases map[string]string) url.Values {
+	translated := make(url.Values, len(query))
+
+	for key, values := range query {
+		if path, ok := aliases[key]; ok {
+			key = path
+		}
+		translated[key] = append(translated[key], values...)
+	}
+
+	return translated
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"git.neds.sh/matty/entain/pkg/errorreason"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys forwarding the client authenticated by its API key or bearer
+// token to the services, with its roles comma separated.
+const (
+	identityMetadataKey = "x-client-identity"
+	rolesMetadataKey    = "x-client-roles"
+)
+
+// apiKeyHeader carries the API key of the client.
+const apiKeyHeader = "X-Api-Key"
+
+// bearerPrefix prefixes bearer tokens in the Authorization header.
+const bearerPrefix = "Bearer "
+
+// adminRole is the role clients need for the gateway's own admin endpoints.
+const adminRole = "admin"
+
+type identityContextKey struct{}
+
+// identity is an authenticated client.
+type identity struct {
+	Client string
+	Roles  []string
+}
+
+// KeyStore holds the API keys of the clients allowed to make changes. Keys
+// are stored as their SHA-256 hashes so the file doesn't hold usable keys.
+type KeyStore struct {
+	Keys []APIKey `json:"keys"`
+
+	byHash map[string]*identity
+}
+
+// APIKey identifies a client by the hash of its key.
+type APIKey struct {
+	Client string `json:"client"`
+	// SHA256 is the hex encoded SHA-256 hash of the key.
+	SHA256 string `json:"sha256"`
+	// Roles are granted to the client, e.g. admin.
+	Roles []string `json:"roles"`
+}
+
+// loadKeyStore reads API keys from a JSON file.
+func loadKeyStore(path string) (*KeyStore, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys KeyStore
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, fmt.Errorf("invalid api keys file %s: %w", path, err)
+	}
+
+	keys.byHash = make(map[string]*identity)
+
+	for _, key := range keys.Keys {
+		hash, err := hex.DecodeString(key.SHA256)
+		if key.Client == "" || err != nil || len(hash) != sha256.Size {
+			return nil, fmt.Errorf("invalid api key of client %q, expected a client and the hex sha256 of the key", key.Client)
+		}
+
+		if _, ok := keys.byHash[string(hash)]; ok {
+			return nil, fmt.Errorf("api key of client %s is used by another client", key.Client)
+		}
+		keys.byHash[string(hash)] = &identity{Client: key.Client, Roles: key.Roles}
+	}
+
+	return &keys, nil
+}
+
+// Authenticate returns the client an API key belongs to.
+func (k *KeyStore) Authenticate(key string) (*identity, bool) {
+	hash := sha256.Sum256([]byte(key))
+	client, ok := k.byHash[string(hash[:])]
+	return client, ok
+}
+
+// withAuth authenticates requests by the bearer JWT in the Authorization
+// header, or the API key in the X-Api-Key header, adding the client to the
+// context for identityMetadata. Requests with an invalid token or unknown key
+// are rejected, those without either are anonymous and the services only let
+// them read. Nil keys or tokens disable that kind of authentication.
+func withAuth(next http.Handler, keys *KeyStore, tokens *TokenVerifier) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Callers can't claim an identity by sending the metadata
+		// themselves.
+		r.Header.Del(runtime.MetadataHeaderPrefix + identityMetadataKey)
+		r.Header.Del(runtime.MetadataHeaderPrefix + rolesMetadataKey)
+
+		var client *identity
+
+		authorization := r.Header.Get("Authorization")
+		key := r.Header.Get(apiKeyHeader)
+
+		switch {
+		case strings.HasPrefix(authorization, bearerPrefix) && tokens != nil:
+			verified, err := tokens.Verify(strings.TrimPrefix(authorization, bearerPrefix))
+			if err != nil {
+				writeStatus(w, errorreason.InvalidCredentials.Status("invalid bearer token", nil))
+				return
+			}
+			client = verified
+		case key != "" && keys != nil:
+			authenticated, ok := keys.Authenticate(key)
+			if !ok {
+				writeStatus(w, errorreason.InvalidCredentials.Status("invalid API key", nil))
+				return
+			}
+			client = authenticated
+		default:
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), identityContextKey{}, client)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// identityMetadata forwards the client authenticated for a request, and its
+// roles, to the services as gRPC metadata.
+func identityMetadata(ctx context.Context, r *http.Request) metadata.MD {
+	client, ok := r.Context().Value(identityContextKey{}).(*identity)
+	if !ok {
+		return nil
+	}
+
+	md := metadata.Pairs(identityMetadataKey, client.Client)
+	if len(client.Roles) > 0 {
+		md.Set(rolesMetadataKey, strings.Join(client.Roles, ","))
+	}
+
+	return md
+}
+
+// requireAdmin serves the gateway's own admin endpoints, which the services'
+// -require-role never sees, only to clients granted the admin role. Anonymous
+// requests get a 401 and clients without the role a 403.
+func requireAdmin(next runtime.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		client, ok := r.Context().Value(identityContextKey{}).(*identity)
+		if !ok {
+			writeStatus(w, errorreason.AuthenticationRequired.Status("an API key or bearer token is required", nil))
+			return
+		}
+
+		if !client.hasRole(adminRole) {
+			writeStatus(w, errorreason.RoleRequired.Status(fmt.Sprintf("the %s role is required", adminRole), map[string]string{"role": adminRole}))
+			return
+		}
+
+		next(w, r, pathParams)
+	}
+}
+
+// hasRole reports whether the client was granted the role.
+func (i *identity) hasRole(role string) bool {
+	for _, granted := range i.Roles {
+		if granted == role {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+// writeKeyStore writes the clients of each key, with their roles, to an API
+// keys file and loads it.
+func writeKeyStore(t *testing.T, keys map[string]APIKey) *KeyStore {
+	t.Helper()
+
+	var store KeyStore
+	for key, apiKey := range keys {
+		hash := sha256.Sum256([]byte(key))
+		apiKey.SHA256 = hex.EncodeToString(hash[:])
+		store.Keys = append(store.Keys, apiKey)
+	}
+
+	data, err := json.Marshal(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "api-keys.json")
+	if err := ioutil.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := loadKeyStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return loaded
+}
+
+func TestRequireAdmin(t *testing.T) {
+	keys := writeKeyStore(t, map[string]APIKey{
+		"trader-key": {Client: "trader", Roles: []string{"trader"}},
+		"admin-key":  {Client: "ops", Roles: []string{"trader", adminRole}},
+	})
+
+	mux := runtime.NewServeMux()
+	err := mux.HandlePath("PUT", "/v1/admin/api/flags/{name}", requireAdmin(func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handler := withAuth(mux, keys, nil)
+
+	tests := []struct {
+		name string
+		key  string
+		want int
+	}{
+		{name: "anonymous", want: http.StatusUnauthorized},
+		{name: "unknown key", key: "guessed-key", want: http.StatusUnauthorized},
+		{name: "without admin role", key: "trader-key", want: http.StatusForbidden},
+		{name: "admin", key: "admin-key", want: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("PUT", "/v1/admin/api/flags/catalogue", nil)
+			if tt.key != "" {
+				r.Header.Set(apiKeyHeader, tt.key)
+			}
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, r)
+
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d: %s", w.Code, tt.want, w.Body)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+)
+
+// Backends the gateway calls.
+const (
+	backendRacing = "racing"
+	backendSports = "sports"
+)
+
+// Backends resolves the addresses of each backend, which can be changed while
+// the gateway runs. Requests are balanced over the addresses of a backend, and
+// the connections of addresses removed are closed once the requests in flight
+// on them finish, so backends can be moved without restarting the gateway.
+type Backends struct {
+	mu        sync.Mutex
+	resolvers map[string]*manual.Resolver
+	addrs     map[string][]string
+}
+
+// newBackends creates the resolvers of the backends with their initial
+// addresses.
+func newBackends(endpoints map[string][]string) (*Backends, error) {
+	b := &Backends{
+		resolvers: make(map[string]*manual.Resolver),
+		addrs:     make(map[string][]string),
+	}
+
+	for name, addrs := range endpoints {
+		if len(addrs) == 0 {
+			return nil, fmt.Errorf("no addresses for backend %s", name)
+		}
+
+		r := manual.NewBuilderWithScheme("backend-" + name)
+		r.InitialState(resolverState(addrs))
+
+		b.resolvers[name] = r
+		b.addrs[name] = addrs
+	}
+
+	return b, nil
+}
+
+// Dial connects to the backend, balancing requests over its addresses.
+func (b *Backends) Dial(ctx context.Context, name string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	r, ok := b.resolvers[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown backend %s", name)
+	}
+
+	opts = append(opts, grpc.WithResolvers(r))
+
+	return grpc.DialContext(ctx, r.Scheme()+":///"+name, opts...)
+}
+
+// Set replaces the addresses of the backend. It must be dialled first.
+func (b *Backends) Set(name string, addrs []string) error {
+	if len(addrs) == 0 {
+		return fmt.Errorf("no addresses for backend %s", name)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	r, ok := b.resolvers[name]
+	if !ok {
+		return fmt.Errorf("unknown backend %s", name)
+	}
+
+	r.UpdateState(resolverState(addrs))
+	b.addrs[name] = addrs
+
+	return nil
+}
+
+// Endpoints returns the addresses of each backend.
+func (b *Backends) Endpoints() map[string][]string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	endpoints := make(map[string][]string, len(b.addrs))
+	for name, addrs := range b.addrs {
+		endpoints[name] = append([]string(nil), addrs...)
+	}
+
+	return endpoints
+}
+
+// LoadFile sets the addresses of the backends from a JSON file of addresses
+// by backend name. Backends not in the file are left as they are.
+func (b *Backends) LoadFile(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var endpoints map[string][]string
+	if err := json.Unmarshal(data, &endpoints); err != nil {
+		return fmt.Errorf("invalid backends file %s: %w", path, err)
+	}
+
+	// Check every backend before changing any so a bad file is ignored as a
+	// whole.
+	for name, addrs := range endpoints {
+		if _, ok := b.resolvers[name]; !ok {
+			return fmt.Errorf("unknown backend %s in backends file %s", name, path)
+		}
+		if len(addrs) == 0 {
+			return fmt.Errorf("no addresses for backend %s in backends file %s", name, path)
+		}
+	}
+
+	for name, addrs := range endpoints {
+		if err := b.Set(name, addrs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Watch reloads the file whenever it is modified, checking every interval,
+// until the context is cancelled.
+func (b *Backends) Watch(ctx context.Context, path string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var lastModified time.Time
+	if info, err := os.Stat(path); err == nil {
+		lastModified = info.ModTime()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		info, err := os.Stat(path)
+		if err != nil || !info.ModTime().After(lastModified) {
+			continue
+		}
+		lastModified = info.ModTime()
+
+		if err := b.LoadFile(path); err != nil {
+			log.Printf("failed reloading backends: %s\n", err)
+			continue
+		}
+
+		log.Printf("reloaded backends from %s\n", path)
+	}
+}
+
+// resolverState lists the addresses, verifying the certificate of each
+// against its own host when calling over TLS rather than the backend name.
+func resolverState(addrs []string) resolver.State {
+	state := resolver.State{}
+
+	for _, addr := range addrs {
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			host = addr
+		}
+
+		state.Addresses = append(state.Addresses, resolver.Address{Addr: addr, ServerName: host})
+	}
+
+	return state
+}
+
+// splitEndpoints splits a comma separated list of addresses.
+func splitEndpoints(value string) []string {
+	var addrs []string
+
+	for _, addr := range strings.Split(value, ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			addrs = append(addrs, addr)
+		}
+	}
+
+	return addrs
+}
+
+// newListBackendsHandler serves the addresses of each backend.
+func newListBackendsHandler(backends *Backends) func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		type backend struct {
+			Name      string   `json:"name"`
+			Endpoints []string `json:"endpoints"`
+		}
+
+		response := struct {
+			Backends []backend `json:"backends"`
+		}{Backends: []backend{}}
+
+		endpoints := backends.Endpoints()
+
+		names := make([]string, 0, len(endpoints))
+		for name := range endpoints {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			response.Backends = append(response.Backends, backend{Name: name, Endpoints: endpoints[name]})
+		}
+
+		writeJSON(w, http.StatusOK, response)
+	}
+}
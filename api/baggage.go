@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"git.neds.sh/matty/entain/pkg/tracing"
+	"google.golang.org/grpc/metadata"
+)
+
+// jurisdictionHeader gives the jurisdiction the client is betting in, e.g.
+// VIC.
+const jurisdictionHeader = "X-Jurisdiction"
+
+// withBaggage describes each request with the client, its jurisdiction and
+// the experiment variants it was assigned by withExperiments, as baggage.
+// Every span and log line of the request, in the gateway and the services,
+// is annotated with them.
+//
+// Baggage sent by the caller is not continued, as it is attached to
+// everything logged for the request.
+func withBaggage(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		baggage := make(tracing.Baggage)
+
+		if clientID := r.Header.Get(clientIDHeader); clientID != "" {
+			baggage[tracing.BaggageClientID] = clientID
+		}
+		if jurisdiction := r.Header.Get(jurisdictionHeader); jurisdiction != "" {
+			baggage[tracing.BaggageJurisdiction] = jurisdiction
+		}
+
+		assignments, _ := r.Context().Value(experimentsContextKey{}).([]string)
+		for _, assignment := range assignments {
+			parts := strings.SplitN(assignment, "=", 2)
+			baggage[tracing.BaggageExperimentPrefix+parts[0]] = parts[len(parts)-1]
+		}
+
+		if len(baggage) == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(tracing.ContextWithBaggage(r.Context(), baggage)))
+	})
+}
+
+// baggageMetadata forwards the baggage of a request to the services as gRPC
+// metadata.
+func baggageMetadata(ctx context.Context, r *http.Request) metadata.MD {
+	baggage := tracing.BaggageFromContext(r.Context())
+	if len(baggage) == 0 {
+		return nil
+	}
+
+	return metadata.Pairs(tracing.BaggageKey, tracing.FormatBaggage(baggage))
+}
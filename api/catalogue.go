@@ -0,0 +1,284 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"git.neds.sh/matty/entain/api/proto"
+	"git.neds.sh/matty/entain/pkg/featureflag"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// sortablePrefix marks the line of an order_by field comment listing the
+// fields which can be sorted on.
+const sortablePrefix = "Sortable:"
+
+// Catalogue is a machine readable description of the API for dev portals.
+type Catalogue struct {
+	Services  []CatalogueService  `json:"services"`
+	Messages  []CatalogueMessage  `json:"messages"`
+	Resources []CatalogueResource `json:"resources"`
+}
+
+// CatalogueService describes a gRPC service and its methods.
+type CatalogueService struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Methods     []CatalogueMethod `json:"methods"`
+}
+
+// CatalogueMethod describes a single RPC and its HTTP binding.
+type CatalogueMethod struct {
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	Request         string `json:"request"`
+	Response        string `json:"response"`
+	ServerStreaming bool   `json:"server_streaming"`
+	HTTPMethod      string `json:"http_method,omitempty"`
+	HTTPPath        string `json:"http_path,omitempty"`
+}
+
+// CatalogueMessage describes a message and its fields.
+type CatalogueMessage struct {
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	Fields      []CatalogueField `json:"fields"`
+}
+
+// CatalogueField describes a single field of a message.
+type CatalogueField struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Repeated    bool   `json:"repeated"`
+	Optional    bool   `json:"optional"`
+	Description string `json:"description"`
+}
+
+// CatalogueResource describes how a resource can be listed.
+type CatalogueResource struct {
+	Resource       string   `json:"resource"`
+	ListMethod     string   `json:"list_method"`
+	FilterFields   []string `json:"filter_fields"`
+	SortableFields []string `json:"sortable_fields"`
+}
+
+// newCatalogueHandler builds the catalogue from the embedded descriptors once
+// and serves it as JSON.
+func newCatalogueHandler(flags *featureflag.Flags) (func(w http.ResponseWriter, r *http.Request, _ map[string]string), error) {
+	catalogue, err := buildCatalogue(proto.Descriptors)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(catalogue)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		if !flags.Enabled(flagCatalogue) {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	}, nil
+}
+
+func buildCatalogue(descriptors []byte) (*Catalogue, error) {
+	var set descriptorpb.FileDescriptorSet
+	if err := protobuf.Unmarshal(descriptors, &set); err != nil {
+		return nil, err
+	}
+
+	catalogue := Catalogue{
+		Services:  []CatalogueService{},
+		Messages:  []CatalogueMessage{},
+		Resources: []CatalogueResource{},
+	}
+
+	for _, fdp := range set.File {
+		// Imports such as timestamp.proto are already registered by the
+		// generated code.
+		file, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+		if err != nil {
+			return nil, err
+		}
+
+		comments := file.SourceLocations()
+
+		services := file.Services()
+		for i := 0; i < services.Len(); i++ {
+			catalogue.Services = append(catalogue.Services, describeService(services.Get(i), comments))
+			catalogue.Resources = append(catalogue.Resources, describeResources(services.Get(i), comments)...)
+		}
+
+		messages := file.Messages()
+		for i := 0; i < messages.Len(); i++ {
+			catalogue.Messages = append(catalogue.Messages, describeMessage(messages.Get(i), comments))
+		}
+	}
+
+	return &catalogue, nil
+}
+
+func describeService(service protoreflect.ServiceDescriptor, comments protoreflect.SourceLocations) CatalogueService {
+	described := CatalogueService{
+		Name:        string(service.FullName()),
+		Description: comment(comments, service),
+		Methods:     []CatalogueMethod{},
+	}
+
+	methods := service.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		method := methods.Get(i)
+
+		describedMethod := CatalogueMethod{
+			Name:            string(method.Name()),
+			Description:     comment(comments, method),
+			Request:         string(method.Input().FullName()),
+			Response:        string(method.Output().FullName()),
+			ServerStreaming: method.IsStreamingServer(),
+		}
+		describedMethod.HTTPMethod, describedMethod.HTTPPath = httpBinding(method)
+
+		described.Methods = append(described.Methods, describedMethod)
+	}
+
+	return described
+}
+
+// describeResources finds the resources listed by a service. A List method
+// returns a repeated resource and may take a filter and order_by.
+func describeResources(service protoreflect.ServiceDescriptor, comments protoreflect.SourceLocations) []CatalogueResource {
+	var resources []CatalogueResource
+
+	methods := service.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		method := methods.Get(i)
+		if !strings.HasPrefix(string(method.Name()), "List") {
+			continue
+		}
+
+		var resource protoreflect.MessageDescriptor
+		outputFields := method.Output().Fields()
+		for j := 0; j < outputFields.Len(); j++ {
+			if field := outputFields.Get(j); field.IsList() && field.Message() != nil {
+				resource = field.Message()
+				break
+			}
+		}
+		if resource == nil {
+			continue
+		}
+
+		described := CatalogueResource{
+			Resource:       string(resource.FullName()),
+			ListMethod:     string(method.FullName()),
+			FilterFields:   []string{},
+			SortableFields: []string{},
+		}
+
+		input := method.Input().Fields()
+		if filter := input.ByName("filter"); filter != nil && filter.Message() != nil {
+			filterFields := filter.Message().Fields()
+			for j := 0; j < filterFields.Len(); j++ {
+				described.FilterFields = append(described.FilterFields, string(filterFields.Get(j).Name()))
+			}
+		}
+
+		if orderBy := input.ByName("order_by"); orderBy != nil {
+			described.SortableFields = sortableFields(comment(comments, orderBy))
+		}
+
+		resources = append(resources, described)
+	}
+
+	return resources
+}
+
+func describeMessage(message protoreflect.MessageDescriptor, comments protoreflect.SourceLocations) CatalogueMessage {
+	described := CatalogueMessage{
+		Name:        string(message.FullName()),
+		Description: comment(comments, message),
+		Fields:      []CatalogueField{},
+	}
+
+	fields := message.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+
+		fieldType := field.Kind().String()
+		if field.Message() != nil {
+			fieldType = string(field.Message().FullName())
+		}
+
+		described.Fields = append(described.Fields, CatalogueField{
+			Name:        string(field.Name()),
+			Type:        fieldType,
+			Repeated:    field.IsList(),
+			Optional:    field.HasOptionalKeyword(),
+			Description: comment(comments, field),
+		})
+	}
+
+	return described
+}
+
+func httpBinding(method protoreflect.MethodDescriptor) (string, string) {
+	rule, ok := protobuf.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+	if !ok || rule == nil {
+		return "", ""
+	}
+
+	switch pattern := rule.Pattern.(type) {
+	case *annotations.HttpRule_Get:
+		return http.MethodGet, pattern.Get
+	case *annotations.HttpRule_Post:
+		return http.MethodPost, pattern.Post
+	case *annotations.HttpRule_Put:
+		return http.MethodPut, pattern.Put
+	case *annotations.HttpRule_Patch:
+		return http.MethodPatch, pattern.Patch
+	case *annotations.HttpRule_Delete:
+		return http.MethodDelete, pattern.Delete
+	}
+
+	return "", ""
+}
+
+// comment returns the cleaned up leading comment of a descriptor.
+func comment(comments protoreflect.SourceLocations, desc protoreflect.Descriptor) string {
+	lines := strings.Split(strings.TrimSpace(comments.ByDescriptor(desc).LeadingComments), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// sortableFields parses the "Sortable: a, b" line of an order_by comment.
+func sortableFields(comment string) []string {
+	fields := []string{}
+
+	for _, line := range strings.Split(comment, "\n") {
+		if !strings.HasPrefix(line, sortablePrefix) {
+			continue
+		}
+
+		for _, field := range strings.Split(strings.TrimPrefix(line, sortablePrefix), ",") {
+			if field = strings.TrimSpace(field); field != "" {
+				fields = append(fields, field)
+			}
+		}
+	}
+
+	return fields
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// conditionalMaxTracked is the number of races and events whose last
+// modification the gateway keeps track of, forgetting them all once more are
+// seen.
+const conditionalMaxTracked = 10000
+
+// conditionalPath matches the paths of the races and events got by ID.
+var conditionalPath = regexp.MustCompile(`^/v1/(race|event)/[^/]+$`)
+
+// conditionalGets tracks when the gateway first served the current version of
+// each race and event, as they carry no time they were last modified.
+type conditionalGets struct {
+	now func() time.Time
+
+	mu   sync.Mutex
+	seen map[string]version
+}
+
+// version is a version of a race or event, identified by its ETag.
+type version struct {
+	etag string
+	// since is when the gateway first served the version, truncated to the
+	// second as in the Last-Modified header.
+	since time.Time
+}
+
+// newConditionalGets creates a new tracker of versions, first served at the
+// times given by the clock now.
+func newConditionalGets(now func() time.Time) *conditionalGets {
+	return &conditionalGets{now: now, seen: make(map[string]version)}
+}
+
+// version returns the version of the representation, see representation,
+// whose body hashes to the ETag, first served now unless it was served before.
+func (c *conditionalGets) version(representation, etag string) version {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if v, ok := c.seen[representation]; ok && v.etag == etag {
+		return v
+	}
+
+	if len(c.seen) >= conditionalMaxTracked {
+		c.seen = make(map[string]version)
+	}
+
+	v := version{etag: etag, since: c.now().UTC().Truncate(time.Second)}
+	c.seen[representation] = v
+
+	return v
+}
+
+// withConditionalGets gives the races and events got by ID an ETag, hashing
+// their body, and a Last-Modified time, answering with 304 Not Modified when
+// the client's If-None-Match or If-Modified-Since show it has them already.
+// ETags are weak, as the body may be wrapped in an envelope afterwards, so it
+// must run inside withEnvelope. The body depends on the profile of the client,
+// so it must run inside withProfiles too and responses vary by the
+// credentials the client is identified by.
+func withConditionalGets(next http.Handler) http.Handler {
+	versions := newConditionalGets(time.Now)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || !conditionalPath.MatchString(r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		buffered := &conditionalWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(buffered, r)
+
+		if buffered.status != http.StatusOK {
+			buffered.writeThrough()
+			return
+		}
+
+		key := representation(r)
+
+		hash := sha256.New()
+		hash.Write([]byte(key))
+		hash.Write(buffered.body.Bytes())
+		v := versions.version(key, `W/"`+hex.EncodeToString(hash.Sum(nil)[:16])+`"`)
+
+		w.Header().Add("Vary", apiKeyHeader+", Authorization")
+		w.Header().Set("ETag", v.etag)
+		w.Header().Set("Last-Modified", v.since.Format(http.TimeFormat))
+
+		if notModified(r, v) {
+			w.Header().Del("Content-Type")
+			w.Header().Del("Content-Length")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		buffered.writeThrough()
+	})
+}
+
+// representation identifies what is served for a request, the race or event
+// of the path as formatted for the profile of the client, the time_format and
+// the other query parameters, and the Accept header, so clients served
+// different bodies for the same path don't reset each other's versions.
+func representation(r *http.Request) string {
+	var profile string
+	if p, ok := profileFromContext(r.Context()); ok {
+		profile = p.Name
+	}
+
+	return strings.Join([]string{profile, r.Header.Get("Accept"), r.URL.Path, r.URL.RawQuery}, "\x00")
+}
+
+// notModified reports whether the client has the version already. As in RFC
+// 7232, If-Modified-Since is only considered without If-None-Match.
+func notModified(r *http.Request, v version) bool {
+	if match := r.Header.Get("If-None-Match"); match != "" {
+		for _, etag := range strings.Split(match, ",") {
+			etag = strings.TrimSpace(etag)
+			if etag == "*" || strings.TrimPrefix(etag, "W/") == strings.TrimPrefix(v.etag, "W/") {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	since, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+
+	return !v.since.After(since)
+}
+
+// conditionalWriter holds back a response until it has been written in full
+// so its ETag can be computed.
+type conditionalWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (c *conditionalWriter) WriteHeader(status int) {
+	c.status = status
+}
+
+func (c *conditionalWriter) Write(data []byte) (int, error) {
+	return c.body.Write(data)
+}
+
+// writeThrough writes the response held back.
+func (c *conditionalWriter) writeThrough() {
+	c.Header().Set("Content-Length", strconv.Itoa(c.body.Len()))
+	c.ResponseWriter.WriteHeader(c.status)
+	c.ResponseWriter.Write(c.body.Bytes())
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConditionalGetsVaryByRepresentation(t *testing.T) {
+	handler := withConditionalGets(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"1","time_format":"` + r.URL.Query().Get(timeFormatParam) + `"}`))
+	}))
+
+	get := func(target, ifNoneMatch string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("GET", target, nil)
+		if ifNoneMatch != "" {
+			r.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	rfc3339 := get("/v1/race/1", "")
+	epochMillis := get("/v1/race/1?time_format=epoch_millis", "")
+
+	for _, w := range []*httptest.ResponseRecorder{rfc3339, epochMillis} {
+		vary := strings.Join(w.Header().Values("Vary"), ", ")
+		if !strings.Contains(vary, apiKeyHeader) || !strings.Contains(vary, "Authorization") {
+			t.Errorf("Vary = %q, want %s and Authorization", vary, apiKeyHeader)
+		}
+	}
+
+	if rfc3339.Header().Get("ETag") == epochMillis.Header().Get("ETag") {
+		t.Fatalf("ETag of each time format = %s, want them to differ", rfc3339.Header().Get("ETag"))
+	}
+
+	tests := []struct {
+		name        string
+		target      string
+		ifNoneMatch string
+		want        int
+	}{
+		{name: "same format", target: "/v1/race/1", ifNoneMatch: rfc3339.Header().Get("ETag"), want: http.StatusNotModified},
+		{name: "other format", target: "/v1/race/1?time_format=epoch_millis", ifNoneMatch: rfc3339.Header().Get("ETag"), want: http.StatusOK},
+		{name: "other format matching", target: "/v1/race/1?time_format=epoch_millis", ifNoneMatch: epochMillis.Header().Get("ETag"), want: http.StatusNotModified},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := get(tt.target, tt.ifNoneMatch).Code; got != tt.want {
+				t.Errorf("status = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConditionalVersionsKeptPerRepresentation(t *testing.T) {
+	now := time.Date(2022, 1, 3, 12, 0, 0, 0, time.UTC)
+	versions := newConditionalGets(func() time.Time {
+		now = now.Add(time.Minute)
+		return now
+	})
+
+	rfc3339 := representation(httptest.NewRequest("GET", "/v1/race/1", nil))
+	epochMillis := representation(httptest.NewRequest("GET", "/v1/race/1?time_format=epoch_millis", nil))
+
+	first := versions.version(rfc3339, `W/"a"`)
+	versions.version(epochMillis, `W/"b"`)
+
+	if again := versions.version(rfc3339, `W/"a"`); again != first {
+		t.Errorf("version after serving another format = %+v, want %+v", again, first)
+	}
+	if changed := versions.version(rfc3339, `W/"c"`); !changed.since.After(first.since) {
+		t.Errorf("since of a changed version = %v, want after %v", changed.since, first.since)
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// corsExposedHeaders are the headers of responses scripts of other origins
+// can read, besides those safelisted.
+var corsExposedHeaders = []string{"X-Request-Id", "ETag", "Warning", "Age", "Retry-After"}
+
+// corsPolicy is which origins are allowed to call the gateway from a browser,
+// and how.
+type corsPolicy struct {
+	origins map[string]bool
+	// anyOrigin allows every origin, given as *.
+	anyOrigin bool
+	methods   string
+	headers   string
+	maxAge    string
+}
+
+// newCORSPolicy allows the comma separated origins, * for any, to make
+// requests with the methods and headers, caching preflight requests for
+// maxAge. Nil is returned when no origins are given, so none are allowed.
+func newCORSPolicy(origins, methods, headers string, maxAge time.Duration) *corsPolicy {
+	allowed := splitEndpoints(origins)
+	if len(allowed) == 0 {
+		return nil
+	}
+
+	policy := &corsPolicy{
+		origins: make(map[string]bool),
+		methods: strings.Join(splitEndpoints(methods), ", "),
+		headers: strings.Join(splitEndpoints(headers), ", "),
+		maxAge:  strconv.Itoa(int(maxAge.Seconds())),
+	}
+
+	for _, origin := range allowed {
+		if origin == "*" {
+			policy.anyOrigin = true
+		}
+		policy.origins[strings.TrimSuffix(origin, "/")] = true
+	}
+
+	return policy
+}
+
+func (p *corsPolicy) allows(origin string) bool {
+	return p.anyOrigin || p.origins[origin]
+}
+
+// withCORS lets browsers call the gateway from the origins of the policy,
+// answering their preflight requests itself. It must run first, as preflight
+// requests carry no credentials. A nil policy allows no origins.
+func withCORS(next http.Handler, policy *corsPolicy) http.Handler {
+	if policy == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Add("Vary", "Origin")
+
+		preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+
+		if !policy.allows(origin) {
+			if preflight {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+
+		if preflight {
+			w.Header().Add("Vary", "Access-Control-Request-Method")
+			w.Header().Add("Vary", "Access-Control-Request-Headers")
+			w.Header().Set("Access-Control-Allow-Methods", policy.methods)
+			w.Header().Set("Access-Control-Allow-Headers", policy.headers)
+			w.Header().Set("Access-Control-Max-Age", policy.maxAge)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		w.Header().Set("Access-Control-Expose-Headers", strings.Join(corsExposedHeaders, ", "))
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"git.neds.sh/matty/entain/pkg/logging"
+)
+
+// envelope wraps a response for the clients whose profile asks for it, so
+// every response has the same top level whatever the endpoint.
+type envelope struct {
+	RequestID  string          `json:"requestId"`
+	Timing     envelopeTiming  `json:"timing"`
+	Pagination json.RawMessage `json:"pagination,omitempty"`
+	Data       json.RawMessage `json:"data,omitempty"`
+	Error      json.RawMessage `json:"error,omitempty"`
+}
+
+// envelopeTiming is how long the gateway took to serve the request.
+type envelopeTiming struct {
+	ServerMs float64 `json:"serverMs"`
+}
+
+// paginationFields are the fields of list responses repeated in the
+// pagination of their envelope.
+var paginationFields = []string{"nextPageToken", "totalSize", "totalCount"}
+
+// withEnvelope wraps the JSON responses of clients whose profile enables it
+// in an envelope with the ID of the request, how long it took and the
+// pagination of lists, the response itself being its data, or its error when
+// it failed. Streams are left as they are. It must run after withProfiles
+// and withRequestLog.
+func withEnvelope(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		profile, ok := profileFromContext(r.Context())
+		if !ok || !profile.Envelope {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		start := time.Now()
+		buffered := &envelopeWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(buffered, r)
+
+		if buffered.streaming {
+			return
+		}
+
+		id, _ := logging.RequestIDFromContext(r.Context())
+		buffered.writeEnvelope(id, time.Since(start))
+	})
+}
+
+// envelopeWriter holds back a response until it has been written in full so
+// it can be wrapped, unless it is flushed as a stream.
+type envelopeWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+
+	// streaming is set once the response is flushed, after which it is
+	// written through as it arrives.
+	streaming bool
+}
+
+func (e *envelopeWriter) WriteHeader(status int) {
+	if e.streaming {
+		e.ResponseWriter.WriteHeader(status)
+		return
+	}
+
+	e.status = status
+}
+
+func (e *envelopeWriter) Write(data []byte) (int, error) {
+	if e.streaming {
+		return e.ResponseWriter.Write(data)
+	}
+
+	return e.body.Write(data)
+}
+
+// Flush gives up on wrapping the response, writing what is held back and
+// then the rest as it arrives.
+func (e *envelopeWriter) Flush() {
+	if !e.streaming {
+		e.streaming = true
+		e.ResponseWriter.WriteHeader(e.status)
+		e.ResponseWriter.Write(e.body.Bytes())
+	}
+
+	if flusher, ok := e.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack gives up on wrapping the response, handing over its connection to
+// upgrade it to a WebSocket after writing the status held back.
+func (e *envelopeWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := e.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response can't be hijacked")
+	}
+
+	if !e.streaming {
+		e.streaming = true
+		e.ResponseWriter.WriteHeader(e.status)
+	}
+
+	return hijacker.Hijack()
+}
+
+// writeEnvelope writes the response wrapped, or as it is when it isn't JSON.
+func (e *envelopeWriter) writeEnvelope(requestID string, elapsed time.Duration) {
+	body := bytes.TrimSpace(e.body.Bytes())
+
+	if !strings.Contains(e.Header().Get("Content-Type"), "json") || len(body) == 0 {
+		e.ResponseWriter.WriteHeader(e.status)
+		e.ResponseWriter.Write(e.body.Bytes())
+		return
+	}
+
+	wrapped := envelope{
+		RequestID: requestID,
+		Timing:    envelopeTiming{ServerMs: float64(elapsed.Microseconds()) / 1000},
+	}
+
+	if e.status >= http.StatusBadRequest {
+		wrapped.Error = body
+	} else {
+		wrapped.Data = body
+		wrapped.Pagination = pagination(body)
+	}
+
+	data, err := json.Marshal(wrapped)
+	if err != nil {
+		e.ResponseWriter.WriteHeader(e.status)
+		e.ResponseWriter.Write(e.body.Bytes())
+		return
+	}
+
+	e.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	e.ResponseWriter.WriteHeader(e.status)
+	e.ResponseWriter.Write(data)
+}
+
+// pagination returns the pagination fields of a list response, or nil when
+// the response has none.
+func pagination(body []byte) json.RawMessage {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil
+	}
+
+	found := make(map[string]json.RawMessage)
+	for _, name := range paginationFields {
+		if value, ok := fields[name]; ok {
+			found[name] = value
+		}
+	}
+
+	if len(found) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(found)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
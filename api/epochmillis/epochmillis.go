@@ -0,0 +1,114 @@
+// Package epochmillis provides a gateway marshaler rendering timestamps as
+// milliseconds since the epoch, for consumers which can't parse RFC 3339.
+package epochmillis
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// timestampName is the full name of google.protobuf.Timestamp.
+var timestampName = (&timestamppb.Timestamp{}).ProtoReflect().Descriptor().FullName()
+
+// Marshaler renders messages as the wrapped JSON marshaler does, except
+// google.protobuf.Timestamp fields are numbers of milliseconds since the
+// epoch. Requests are unmarshaled unchanged so still take RFC 3339.
+type Marshaler struct {
+	runtime.Marshaler
+}
+
+func (m *Marshaler) Marshal(v interface{}) ([]byte, error) {
+	data, err := m.Marshaler.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var tree interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&tree); err != nil {
+		return nil, err
+	}
+
+	switch v := v.(type) {
+	case proto.Message:
+		tree = convert(v.ProtoReflect(), tree)
+	case map[string]interface{}:
+		// Streamed messages are wrapped, e.g. {"result": message}.
+		if obj, ok := tree.(map[string]interface{}); ok {
+			for key, value := range v {
+				if msg, ok := value.(proto.Message); ok {
+					obj[key] = convert(msg.ProtoReflect(), obj[key])
+				}
+			}
+		}
+	default:
+		return data, nil
+	}
+
+	return json.Marshal(tree)
+}
+
+func (m *Marshaler) NewEncoder(w io.Writer) runtime.Encoder {
+	return runtime.EncoderFunc(func(v interface{}) error {
+		data, err := m.Marshal(v)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	})
+}
+
+// convert replaces the timestamps of msg in its JSON form, tree.
+func convert(msg protoreflect.Message, tree interface{}) interface{} {
+	if msg.Descriptor().FullName() == timestampName {
+		ts := msg.Interface().(*timestamppb.Timestamp)
+		return json.Number(strconv.FormatInt(ts.GetSeconds()*1000+int64(ts.GetNanos())/1e6, 10))
+	}
+
+	obj, ok := tree.(map[string]interface{})
+	if !ok {
+		return tree
+	}
+
+	msg.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if fd.Message() == nil || fd.IsMap() {
+			return true
+		}
+
+		// Fields are named in JSON by their JSON name unless the wrapped
+		// marshaler uses the proto names.
+		key := fd.JSONName()
+		rendered, ok := obj[key]
+		if !ok {
+			key = string(fd.Name())
+			if rendered, ok = obj[key]; !ok {
+				return true
+			}
+		}
+
+		if !fd.IsList() {
+			obj[key] = convert(value.Message(), rendered)
+			return true
+		}
+
+		if items, ok := rendered.([]interface{}); ok {
+			list := value.List()
+			for i := 0; i < list.Len() && i < len(items); i++ {
+				items[i] = convert(list.Get(i).Message(), items[i])
+			}
+		}
+
+		return true
+	})
+
+	return obj
+}
@@ -0,0 +1,123 @@
+package epochmillis
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"git.neds.sh/matty/entain/api/proto/racing"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMarshal(t *testing.T) {
+	start := time.Date(2022, 1, 2, 3, 4, 5, 678900000, time.UTC)
+	settled := time.Date(2022, 1, 2, 4, 0, 0, 0, time.UTC)
+
+	resp := &racing.ListRacesResponse{
+		Races: []*racing.Race{
+			{Id: 1, AdvertisedStartTime: timestamppb.New(start), SettledAt: timestamppb.New(settled)},
+			{Id: 2},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		marshaler runtime.Marshaler
+		// start and settled are the timestamps of the first race as
+		// rendered, the second has neither.
+		start, settled interface{}
+	}{
+		{
+			name:      "rfc3339",
+			marshaler: &runtime.JSONPb{MarshalOptions: protojson.MarshalOptions{EmitUnpopulated: true}},
+			start:     "2022-01-02T03:04:05.678900Z",
+			settled:   "2022-01-02T04:00:00Z",
+		},
+		{
+			name:      "epoch millis",
+			marshaler: &Marshaler{Marshaler: &runtime.JSONPb{MarshalOptions: protojson.MarshalOptions{EmitUnpopulated: true}}},
+			start:     json.Number("1641092645678"),
+			settled:   json.Number("1641096000000"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.marshaler.Marshal(resp)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			races := decodeRaces(t, data)
+
+			if got := races[0]["advertisedStartTime"]; got != tt.start {
+				t.Errorf("advertisedStartTime = %#v, want %#v", got, tt.start)
+			}
+			if got := races[0]["settledAt"]; got != tt.settled {
+				t.Errorf("settledAt = %#v, want %#v", got, tt.settled)
+			}
+			if got, ok := races[1]["advertisedStartTime"]; !ok || got != nil {
+				t.Errorf("unset advertisedStartTime = %#v, want null", got)
+			}
+		})
+	}
+}
+
+func TestMarshalStreamed(t *testing.T) {
+	m := &Marshaler{Marshaler: &runtime.JSONPb{}}
+
+	race := &racing.Race{Id: 1, AdvertisedStartTime: timestamppb.New(time.Unix(1641092645, 0))}
+
+	data, err := m.Marshal(map[string]interface{}{"result": race})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got struct {
+		Result struct {
+			AdvertisedStartTime json.Number `json:"advertisedStartTime"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Result.AdvertisedStartTime != "1641092645000" {
+		t.Errorf("advertisedStartTime = %s, want 1641092645000", got.Result.AdvertisedStartTime)
+	}
+}
+
+func TestUnmarshalTakesRFC3339(t *testing.T) {
+	m := &Marshaler{Marshaler: &runtime.JSONPb{}}
+
+	var race racing.Race
+	if err := m.Unmarshal([]byte(`{"advertisedStartTime": "2022-01-02T03:04:05Z"}`), &race); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := race.AdvertisedStartTime.AsTime(); !got.Equal(time.Unix(1641092645, 0)) {
+		t.Errorf("advertisedStartTime = %s, want 2022-01-02T03:04:05Z", got)
+	}
+}
+
+func decodeRaces(t *testing.T, data []byte) []map[string]interface{} {
+	t.Helper()
+
+	var resp struct {
+		Races []map[string]interface{} `json:"races"`
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resp.Races) != 2 {
+		t.Fatalf("got %d races, want 2", len(resp.Races))
+	}
+
+	return resp.Races
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"git.neds.sh/matty/entain/pkg/errorreason"
+	"git.neds.sh/matty/entain/pkg/logging"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorCatalogue lists the reasons of the errors the API can return, given in
+// the ErrorInfo detail of each error.
+type ErrorCatalogue struct {
+	Domain  string               `json:"domain"`
+	Reasons []ErrorCatalogueItem `json:"reasons"`
+}
+
+// ErrorCatalogueItem describes a reason and the status it is returned with.
+type ErrorCatalogueItem struct {
+	Reason      string `json:"reason"`
+	Code        string `json:"code"`
+	HTTPStatus  int    `json:"http_status"`
+	Description string `json:"description"`
+}
+
+// newErrorCatalogueHandler serves the error catalogue as JSON.
+func newErrorCatalogueHandler() func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	catalogue := ErrorCatalogue{Domain: errorreason.Domain}
+
+	for _, reason := range errorreason.Catalogue {
+		catalogue.Reasons = append(catalogue.Reasons, ErrorCatalogueItem{
+			Reason:      reason.Reason,
+			Code:        code.Code(reason.Code).String(),
+			HTTPStatus:  runtime.HTTPStatusFromCode(reason.Code),
+			Description: reason.Description,
+		})
+	}
+
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		writeJSON(w, http.StatusOK, catalogue)
+	}
+}
+
+// errorResponse is the body of every error the gateway returns, whether from
+// the services or the gateway itself: the status with the ID of the request.
+type errorResponse struct {
+	Code      int32             `json:"code"`
+	Message   string            `json:"message"`
+	Details   []json.RawMessage `json:"details"`
+	RequestID string            `json:"requestId,omitempty"`
+}
+
+// errorMarshaler marshals the status of an error as an errorResponse, so it
+// has the ID of the request. Other messages are marshaled as they are.
+type errorMarshaler struct {
+	runtime.Marshaler
+	requestID string
+}
+
+func (m errorMarshaler) Marshal(v interface{}) ([]byte, error) {
+	st, ok := v.(*spb.Status)
+	if !ok {
+		return m.Marshaler.Marshal(v)
+	}
+
+	// The status is marshaled by the marshaler of the request first, so
+	// details are written as its responses are.
+	body, err := m.Marshaler.Marshal(st)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp errorResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.Details == nil {
+		resp.Details = []json.RawMessage{}
+	}
+	resp.RequestID = m.requestID
+
+	return json.Marshal(resp)
+}
+
+// errorHandler writes the errors of calls to the services as errorResponses,
+// with the HTTP status of their code and Retry-After when they detail when to
+// retry.
+func errorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	setRetryAfter(w, err)
+
+	id, _ := logging.RequestIDFromContext(r.Context())
+	runtime.DefaultHTTPErrorHandler(ctx, mux, errorMarshaler{Marshaler: marshaler, requestID: id}, w, r, err)
+}
+
+// routingErrorHandler writes the errors of requests matching no route, with
+// the HTTP status of the routing error rather than that of its code, e.g. 405
+// for a method not allowed rather than 501.
+func routingErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, httpStatus int) {
+	code := codes.Internal
+	switch httpStatus {
+	case http.StatusBadRequest:
+		code = codes.InvalidArgument
+	case http.StatusMethodNotAllowed:
+		code = codes.Unimplemented
+	case http.StatusNotFound:
+		code = codes.NotFound
+	}
+
+	err := &runtime.HTTPStatusError{
+		HTTPStatus: httpStatus,
+		Err:        status.Error(code, http.StatusText(httpStatus)),
+	}
+	errorHandler(ctx, mux, marshaler, w, r, err)
+}
+
+// writeStatus writes an error the way errorHandler writes those of the
+// services, for errors returned before a request reaches them. The ID of the
+// request is that echoed in the response.
+func writeStatus(w http.ResponseWriter, st *status.Status) {
+	setRetryAfter(w, st.Err())
+
+	marshaler := errorMarshaler{Marshaler: jsonMarshaler(true), requestID: w.Header().Get(logging.RequestIDHeader)}
+
+	body, err := marshaler.Marshal(st.Proto())
+	if err != nil {
+		http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(runtime.HTTPStatusFromCode(st.Code()))
+	w.Write(body)
+}
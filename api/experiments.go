@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// clientIDHeader identifies the client to bucket into experiments.
+	clientIDHeader = "X-Client-Id"
+	// experimentsHeader echoes the assigned variants back to the client.
+	experimentsHeader = "X-Experiments"
+	// experimentsMetadataKey forwards the assigned variants to the services
+	// as "experiment=variant" values.
+	experimentsMetadataKey = "x-experiments"
+)
+
+type experimentsContextKey struct{}
+
+// Experiments are A/B experiments which clients are bucketed into.
+type Experiments struct {
+	Experiments []Experiment `json:"experiments"`
+}
+
+// Experiment is a named experiment split into weighted variants.
+type Experiment struct {
+	Name     string    `json:"name"`
+	Variants []Variant `json:"variants"`
+}
+
+// Variant is one arm of an experiment. A client is assigned a variant with
+// probability weight over the total weight of the experiment.
+type Variant struct {
+	Name   string `json:"name"`
+	Weight uint32 `json:"weight"`
+}
+
+// loadExperiments reads experiments from a JSON file.
+func loadExperiments(path string) (*Experiments, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var experiments Experiments
+	if err := json.Unmarshal(data, &experiments); err != nil {
+		return nil, fmt.Errorf("invalid experiments file %s: %w", path, err)
+	}
+
+	for _, experiment := range experiments.Experiments {
+		if experiment.totalWeight() == 0 {
+			return nil, fmt.Errorf("experiment %s has no weighted variants", experiment.Name)
+		}
+	}
+
+	return &experiments, nil
+}
+
+// Assign buckets a client into a variant of every experiment. The same client
+// always lands in the same variants while the config is unchanged.
+func (e *Experiments) Assign(clientID string) []string {
+	var assignments []string
+
+	for _, experiment := range e.Experiments {
+		assignments = append(assignments, experiment.Name+"="+experiment.variant(clientID))
+	}
+
+	return assignments
+}
+
+func (e Experiment) totalWeight() uint32 {
+	var total uint32
+	for _, variant := range e.Variants {
+		total += variant.Weight
+	}
+	return total
+}
+
+func (e Experiment) variant(clientID string) string {
+	// Hash the experiment name in so clients are bucketed independently
+	// across experiments.
+	h := fnv.New32a()
+	h.Write([]byte(e.Name + ":" + clientID))
+	bucket := h.Sum32() % e.totalWeight()
+
+	for _, variant := range e.Variants {
+		if bucket < variant.Weight {
+			return variant.Name
+		}
+		bucket -= variant.Weight
+	}
+
+	return ""
+}
+
+// withExperiments buckets requests carrying a client id into the
+// experiments, echoing the assignments in a response header and adding them
+// to the context for experimentsMetadata.
+func withExperiments(next http.Handler, experiments *Experiments) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientID := r.Header.Get(clientIDHeader)
+		if clientID == "" || len(experiments.Experiments) == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		assignments := experiments.Assign(clientID)
+		w.Header().Set(experimentsHeader, strings.Join(assignments, ", "))
+
+		ctx := context.WithValue(r.Context(), experimentsContextKey{}, assignments)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// experimentsMetadata forwards the experiment assignments of a request to the
+// services as gRPC metadata.
+func experimentsMetadata(ctx context.Context, r *http.Request) metadata.MD {
+	assignments, ok := r.Context().Value(experimentsContextKey{}).([]string)
+	if !ok {
+		return nil
+	}
+
+	return metadata.Pairs(experimentsMetadataKey, strings.Join(assignments, ","))
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"git.neds.sh/matty/entain/pkg/errorreason"
+	"git.neds.sh/matty/entain/pkg/featureflag"
+)
+
+// Feature flags gating behaviour of the gateway.
+const (
+	// flagQueryAliases enables the friendly query parameter aliases.
+	flagQueryAliases = "query_aliases"
+	// flagCatalogue enables the /v1/catalogue endpoint.
+	flagCatalogue = "catalogue"
+)
+
+// newFeatureFlags creates the gateway feature flags in their default states.
+func newFeatureFlags() *featureflag.Flags {
+	return featureflag.New(map[string]bool{
+		flagQueryAliases: true,
+		flagCatalogue:    true,
+	})
+}
+
+// featureFlag is the JSON representation of a flag, matching the FeatureFlag
+// message of the services.
+type featureFlag struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
+
+// newListFeatureFlagsHandler serves the gateway flags in the same shape as
+// the ListFeatureFlags RPC of the services.
+func newListFeatureFlagsHandler(flags *featureflag.Flags) func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		response := struct {
+			Flags []featureFlag `json:"flags"`
+		}{Flags: []featureFlag{}}
+
+		for _, name := range flags.Names() {
+			response.Flags = append(response.Flags, featureFlag{Name: name, Enabled: flags.Enabled(name)})
+		}
+
+		writeJSON(w, http.StatusOK, response)
+	}
+}
+
+// newSetFeatureFlagHandler turns a gateway flag on or off, taking a body of
+// {"enabled": true}.
+func newSetFeatureFlagHandler(flags *featureflag.Flags) func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		var body featureFlag
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeStatus(w, errorreason.InvalidValue.Status("invalid feature flag body", nil))
+			return
+		}
+
+		name := pathParams["name"]
+		if err := flags.Set(name, body.Enabled); err != nil {
+			if errors.Is(err, featureflag.ErrUnknownFlag) {
+				writeStatus(w, errorreason.NotFound.Status(err.Error(), nil))
+				return
+			}
+			writeStatus(w, errorreason.Internal.Status(err.Error(), nil))
+			return
+		}
+
+		writeJSON(w, http.StatusOK, featureFlag{Name: name, Enabled: flags.Enabled(name)})
+	}
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
@@ -3,13 +3,23 @@ module git.neds.sh/matty/entain/api
 go 1.16
 
 require (
+	git.neds.sh/matty/entain/pkg v0.0.0-00010101000000-000000000000
 	github.com/bufbuild/buf v0.37.0 // indirect
+	github.com/envoyproxy/protoc-gen-validate v0.6.3
+	github.com/golang-jwt/jwt/v4 v4.2.0
+	github.com/graphql-go/graphql v0.8.0
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.7.2
+	github.com/improbable-eng/grpc-web v0.15.0
+	go.uber.org/zap v1.16.0
 	golang.org/x/net v0.0.0-20211216030914-fe4d6282115f // indirect
+	golang.org/x/sync v0.0.0-20210220032951-036812b2e83c
 	golang.org/x/sys v0.0.0-20211216021012-1d35b9e2eb4e // indirect
 	golang.org/x/text v0.3.7 // indirect
 	google.golang.org/genproto v0.0.0-20211223182754-3ac035c7e7cb
 	google.golang.org/grpc v1.43.0
 	google.golang.org/grpc/cmd/protoc-gen-go-grpc v1.2.0
 	google.golang.org/protobuf v1.27.1
+	nhooyr.io/websocket v1.8.7
 )
+
+replace git.neds.sh/matty/entain/pkg => ../pkg
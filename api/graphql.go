@@ -0,0 +1,577 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+
+	"git.neds.sh/matty/entain/api/proto/racing"
+	"git.neds.sh/matty/entain/api/proto/sports"
+	"git.neds.sh/matty/entain/pkg/errorreason"
+	"github.com/graphql-go/graphql"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultGraphQLLimit = 20
+	maxGraphQLLimit     = 100
+	maxGraphQLQueryLen  = 16 << 10
+)
+
+// graphQLRequest is what the resolvers of a GraphQL query share, the HTTP
+// request it came in to call the services for, and the leagues loaded once
+// for every event of the query.
+type graphQLRequest struct {
+	r            *http.Request
+	mux          *runtime.ServeMux
+	racingClient racing.RacingClient
+	sportsClient sports.SportsClient
+
+	leaguesOnce sync.Once
+	leagues     map[int64]*sports.League
+	leaguesErr  error
+}
+
+type graphQLRequestContextKey struct{}
+
+func graphQLRequestFromContext(ctx context.Context) *graphQLRequest {
+	return ctx.Value(graphQLRequestContextKey{}).(*graphQLRequest)
+}
+
+// callContext annotates the context of a call to a service with the metadata
+// the gateway adds to requests, as for those transcoded from JSON.
+func (g *graphQLRequest) callContext(ctx context.Context, method string) (context.Context, error) {
+	return runtime.AnnotateContext(ctx, g.mux, g.r, method)
+}
+
+// league returns a league by ID, listing every league the first time one is
+// needed so the leagues of any number of events take a single call.
+func (g *graphQLRequest) league(ctx context.Context, id int64) (*sports.League, error) {
+	g.leaguesOnce.Do(func() {
+		callCtx, err := g.callContext(ctx, "/sports.Sports/ListLeagues")
+		if err != nil {
+			g.leaguesErr = err
+			return
+		}
+
+		response, err := g.sportsClient.ListLeagues(callCtx, &sports.ListLeaguesRequest{})
+		if err != nil {
+			g.leaguesErr = err
+			return
+		}
+
+		g.leagues = make(map[int64]*sports.League)
+		for _, league := range response.GetLeagues() {
+			g.leagues[league.GetId()] = league
+		}
+	})
+
+	return g.leagues[id], g.leaguesErr
+}
+
+// graphQLBody is a GraphQL query as POSTed, or given as query parameters to a
+// GET.
+type graphQLBody struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// newGraphQLHandler serves GraphQL queries of races, events and leagues,
+// resolved by calling the services, so nested data such as events with their
+// league and prices is fetched in a single round trip.
+func newGraphQLHandler(mux *runtime.ServeMux, racingClient racing.RacingClient, sportsClient sports.SportsClient) (func(w http.ResponseWriter, r *http.Request, _ map[string]string), error) {
+	schema, err := newGraphQLSchema()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		var body graphQLBody
+
+		if r.Method == http.MethodGet {
+			query := r.URL.Query()
+			body.Query = query.Get("query")
+			body.OperationName = query.Get("operationName")
+			if variables := query.Get("variables"); variables != "" {
+				if err := json.Unmarshal([]byte(variables), &body.Variables); err != nil {
+					writeStatus(w, errorreason.InvalidValue.Status("variables must be a JSON object", nil))
+					return
+				}
+			}
+		} else if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxGraphQLQueryLen)).Decode(&body); err != nil {
+			writeStatus(w, errorreason.InvalidValue.Status("the body must be a GraphQL query as JSON", nil))
+			return
+		}
+
+		if body.Query == "" || len(body.Query) > maxGraphQLQueryLen {
+			writeStatus(w, errorreason.InvalidValue.Status(fmt.Sprintf("query must be between 1 and %d characters", maxGraphQLQueryLen), nil))
+			return
+		}
+
+		request := &graphQLRequest{r: r, mux: mux, racingClient: racingClient, sportsClient: sportsClient}
+
+		result := graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  body.Query,
+			OperationName:  body.OperationName,
+			VariableValues: body.Variables,
+			Context:        context.WithValue(r.Context(), graphQLRequestContextKey{}, request),
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+	}, nil
+}
+
+func newGraphQLSchema() (graphql.Schema, error) {
+	league := protoObject("League", "A league events are played in.", graphql.Fields{
+		"id":      {Type: graphql.Int},
+		"name":    {Type: graphql.String},
+		"sport":   {Type: graphql.String},
+		"country": {Type: graphql.String},
+	})
+
+	price := protoObject("Price", "The price of a side of an event.", graphql.Fields{
+		"side":      {Type: graphql.String},
+		"name":      {Type: graphql.String},
+		"price":     {Type: graphql.Float},
+		"updatedAt": {Type: graphql.String},
+	})
+
+	market := protoObject("Market", "A market of an event with the prices of its sides.", graphql.Fields{
+		"name":   {Type: graphql.String},
+		"prices": {Type: graphql.NewList(price)},
+	})
+
+	score := protoObject("Score", "The current score of an event.", graphql.Fields{
+		"homeScore": {Type: graphql.Int},
+		"awayScore": {Type: graphql.Int},
+		"updatedAt": {Type: graphql.String},
+	})
+
+	eventResult := protoObject("EventResult", "The final score of a settled event.", graphql.Fields{
+		"homeScore":   {Type: graphql.Int},
+		"awayScore":   {Type: graphql.Int},
+		"winningSide": {Type: graphql.String},
+	})
+
+	event := protoObject("Event", "A sports event.", graphql.Fields{
+		"id":                      {Type: graphql.Int},
+		"sport":                   {Type: graphql.String},
+		"name":                    {Type: graphql.String},
+		"homeSideName":            {Type: graphql.String},
+		"awaySideName":            {Type: graphql.String},
+		"visible":                 {Type: graphql.Boolean},
+		"advertisedStartTime":     {Type: graphql.String},
+		"status":                  {Type: graphql.String},
+		"multiEligible":           {Type: graphql.Boolean},
+		"maxLegs":                 {Type: graphql.Int},
+		"settledAt":               {Type: graphql.String},
+		"result":                  {Type: eventResult},
+		"score":                   {Type: score},
+		"resultPending":           {Type: graphql.Boolean},
+		"estimatedSettlementTime": {Type: graphql.String},
+		"league": {
+			Type:    league,
+			Resolve: resolveEventLeague,
+		},
+		"markets": {
+			Type:    graphql.NewList(market),
+			Resolve: resolveMarkets,
+		},
+	})
+
+	runner := protoObject("Runner", "A runner of a race.", graphql.Fields{
+		"id":        {Type: graphql.Int},
+		"number":    {Type: graphql.Int},
+		"barrier":   {Type: graphql.Int},
+		"name":      {Type: graphql.String},
+		"scratched": {Type: graphql.Boolean},
+	})
+
+	raceResult := protoObject("RaceResult", "The result of a settled race.", graphql.Fields{
+		"winner": {Type: graphql.Int},
+	})
+
+	race := protoObject("Race", "A race of a meeting.", graphql.Fields{
+		"id":                  {Type: graphql.Int},
+		"meetingId":           {Type: graphql.Int},
+		"name":                {Type: graphql.String},
+		"number":              {Type: graphql.Int},
+		"visible":             {Type: graphql.Boolean},
+		"advertisedStartTime": {Type: graphql.String},
+		"status":              {Type: graphql.String},
+		"runners":             {Type: graphql.NewList(runner)},
+		"result":              {Type: raceResult},
+		"settledAt":           {Type: graphql.String},
+	})
+
+	ids := &graphql.ArgumentConfig{Type: graphql.NewList(graphql.Int)}
+	visible := &graphql.ArgumentConfig{Type: graphql.Boolean}
+	orderBy := &graphql.ArgumentConfig{Type: graphql.String, Description: "As the order_by of lists, e.g. advertised_start_time desc."}
+	limit := &graphql.ArgumentConfig{Type: graphql.Int, Description: fmt.Sprintf("How many to return, %d by default and at most %d.", defaultGraphQLLimit, maxGraphQLLimit)}
+	id := &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)}
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"events": {
+				Type: graphql.NewList(event),
+				Args: graphql.FieldConfigArgument{
+					"ids":       ids,
+					"sports":    {Type: graphql.NewList(graphql.String)},
+					"leagues":   {Type: graphql.NewList(graphql.Int)},
+					"notIds":    {Type: graphql.NewList(graphql.Int), Description: "Leaves out the events with these ids."},
+					"notSports": {Type: graphql.NewList(graphql.String), Description: "Leaves out the events of these sports."},
+					"visible":   visible,
+					"orderBy":   orderBy,
+					"limit":     limit,
+				},
+				Resolve: resolveEvents,
+			},
+			"event": {
+				Type:    event,
+				Args:    graphql.FieldConfigArgument{"id": id},
+				Resolve: resolveEvent,
+			},
+			"races": {
+				Type: graphql.NewList(race),
+				Args: graphql.FieldConfigArgument{
+					"ids":          ids,
+					"meetingIds":   {Type: graphql.NewList(graphql.Int)},
+					"number":       {Type: graphql.Int},
+					"nameContains": {Type: graphql.String, Description: "Only races whose name contains this, ignoring case."},
+					"visible":      visible,
+					"orderBy":      orderBy,
+					"limit":        limit,
+				},
+				Resolve: resolveRaces,
+			},
+			"race": {
+				Type:    race,
+				Args:    graphql.FieldConfigArgument{"id": id},
+				Resolve: resolveRace,
+			},
+			"leagues": {
+				Type:    graphql.NewList(league),
+				Args:    graphql.FieldConfigArgument{"sports": {Type: graphql.NewList(graphql.String)}},
+				Resolve: resolveLeagues,
+			},
+			"league": {
+				Type:    league,
+				Args:    graphql.FieldConfigArgument{"id": id},
+				Resolve: resolveLeague,
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+}
+
+func resolveEvents(p graphql.ResolveParams) (interface{}, error) {
+	request := graphQLRequestFromContext(p.Context)
+
+	size, err := graphQLLimit(p.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	in := &sports.ListEventsRequest{
+		Filter: &sports.ListEventsRequestFilter{
+			Ids:       int64Args(p.Args["ids"]),
+			Sports:    stringArgs(p.Args["sports"]),
+			Leagues:   int64Args(p.Args["leagues"]),
+			NotIds:    int64Args(p.Args["notIds"]),
+			NotSports: stringArgs(p.Args["notSports"]),
+		},
+		PageSize: size,
+	}
+	if value, ok := p.Args["visible"].(bool); ok {
+		in.Filter.Visible = &value
+	}
+	if value, ok := p.Args["orderBy"].(string); ok {
+		in.OrderBy = &value
+	}
+
+	ctx, err := request.callContext(p.Context, "/sports.Sports/ListEvents")
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := request.sportsClient.ListEvents(ctx, in)
+	if err != nil {
+		return nil, graphQLError(err)
+	}
+
+	return messages(response.GetEvents()), nil
+}
+
+func resolveEvent(p graphql.ResolveParams) (interface{}, error) {
+	request := graphQLRequestFromContext(p.Context)
+
+	ctx, err := request.callContext(p.Context, "/sports.Sports/GetEvent")
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := request.sportsClient.GetEvent(ctx, &sports.GetEventRequest{Id: int64(p.Args["id"].(int))})
+	if err != nil {
+		return nil, graphQLError(err)
+	}
+
+	return event, nil
+}
+
+func resolveEventLeague(p graphql.ResolveParams) (interface{}, error) {
+	league, err := graphQLRequestFromContext(p.Context).league(p.Context, p.Source.(*sports.Event).GetLeague())
+	if err != nil {
+		return nil, graphQLError(err)
+	}
+	if league == nil {
+		return nil, nil
+	}
+
+	return league, nil
+}
+
+// resolveMarkets gets the markets of each event of a query concurrently.
+func resolveMarkets(p graphql.ResolveParams) (interface{}, error) {
+	request := graphQLRequestFromContext(p.Context)
+
+	ctx, err := request.callContext(p.Context, "/sports.Sports/GetMarkets")
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		response *sports.GetMarketsResponse
+		done     = make(chan struct{})
+	)
+
+	go func() {
+		defer close(done)
+		response, err = request.sportsClient.GetMarkets(ctx, &sports.GetMarketsRequest{EventId: p.Source.(*sports.Event).GetId()})
+	}()
+
+	return func() (interface{}, error) {
+		<-done
+		if err != nil {
+			return nil, graphQLError(err)
+		}
+
+		return messages(response.GetMarkets()), nil
+	}, nil
+}
+
+func resolveRaces(p graphql.ResolveParams) (interface{}, error) {
+	request := graphQLRequestFromContext(p.Context)
+
+	size, err := graphQLLimit(p.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	in := &racing.ListRacesRequest{
+		Filter: &racing.ListRacesRequestFilter{
+			Ids:        int64Args(p.Args["ids"]),
+			MeetingIds: int64Args(p.Args["meetingIds"]),
+		},
+		PageSize: size,
+	}
+	if value, ok := p.Args["number"].(int); ok {
+		number := int64(value)
+		in.Filter.Number = &number
+	}
+	if value, ok := p.Args["nameContains"].(string); ok {
+		in.Filter.NameContains = &value
+	}
+	if value, ok := p.Args["visible"].(bool); ok {
+		in.Filter.Visible = &value
+	}
+	if value, ok := p.Args["orderBy"].(string); ok {
+		in.OrderBy = &value
+	}
+
+	ctx, err := request.callContext(p.Context, "/racing.Racing/ListRaces")
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := request.racingClient.ListRaces(ctx, in)
+	if err != nil {
+		return nil, graphQLError(err)
+	}
+
+	return messages(response.GetRaces()), nil
+}
+
+func resolveRace(p graphql.ResolveParams) (interface{}, error) {
+	request := graphQLRequestFromContext(p.Context)
+
+	ctx, err := request.callContext(p.Context, "/racing.Racing/GetRace")
+	if err != nil {
+		return nil, err
+	}
+
+	race, err := request.racingClient.GetRace(ctx, &racing.GetRaceRequest{Id: int64(p.Args["id"].(int))})
+	if err != nil {
+		return nil, graphQLError(err)
+	}
+
+	return race, nil
+}
+
+func resolveLeagues(p graphql.ResolveParams) (interface{}, error) {
+	request := graphQLRequestFromContext(p.Context)
+
+	ctx, err := request.callContext(p.Context, "/sports.Sports/ListLeagues")
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := request.sportsClient.ListLeagues(ctx, &sports.ListLeaguesRequest{Sports: stringArgs(p.Args["sports"])})
+	if err != nil {
+		return nil, graphQLError(err)
+	}
+
+	return messages(response.GetLeagues()), nil
+}
+
+func resolveLeague(p graphql.ResolveParams) (interface{}, error) {
+	request := graphQLRequestFromContext(p.Context)
+
+	ctx, err := request.callContext(p.Context, "/sports.Sports/GetLeague")
+	if err != nil {
+		return nil, err
+	}
+
+	league, err := request.sportsClient.GetLeague(ctx, &sports.GetLeagueRequest{Id: int64(p.Args["id"].(int))})
+	if err != nil {
+		return nil, graphQLError(err)
+	}
+
+	return league, nil
+}
+
+// protoObject is a GraphQL object of a proto message, its fields resolved
+// from those of the message with the same JSON name unless they have a
+// resolver of their own.
+func protoObject(name, description string, fields graphql.Fields) *graphql.Object {
+	for _, field := range fields {
+		if field.Resolve == nil {
+			field.Resolve = resolveProtoField
+		}
+	}
+
+	return graphql.NewObject(graphql.ObjectConfig{Name: name, Description: description, Fields: fields})
+}
+
+// resolveProtoField resolves a field of a proto message by its JSON name.
+// Timestamps are given in RFC 3339 format, and unset messages as null.
+func resolveProtoField(p graphql.ResolveParams) (interface{}, error) {
+	source, ok := p.Source.(proto.Message)
+	if !ok {
+		return nil, nil
+	}
+
+	msg := source.ProtoReflect()
+
+	fd := msg.Descriptor().Fields().ByJSONName(p.Info.FieldName)
+	if fd == nil {
+		return nil, fmt.Errorf("no field %s of %s", p.Info.FieldName, msg.Descriptor().Name())
+	}
+
+	value := msg.Get(fd)
+
+	switch {
+	case fd.IsList() && fd.Message() != nil:
+		list := value.List()
+		values := make([]interface{}, list.Len())
+		for i := range values {
+			values[i] = list.Get(i).Message().Interface()
+		}
+		return values, nil
+	case fd.Message() != nil:
+		if !msg.Has(fd) {
+			return nil, nil
+		}
+		if ts, ok := value.Message().Interface().(*timestamppb.Timestamp); ok {
+			return ts.AsTime().Format(time.RFC3339), nil
+		}
+		return value.Message().Interface(), nil
+	case fd.Kind() == protoreflect.EnumKind:
+		return string(fd.Enum().Values().ByNumber(value.Enum()).Name()), nil
+	}
+
+	return value.Interface(), nil
+}
+
+// messages returns a slice of messages as a list GraphQL can resolve.
+func messages(list interface{}) []interface{} {
+	slice := reflect.ValueOf(list)
+
+	values := make([]interface{}, slice.Len())
+	for i := range values {
+		values[i] = slice.Index(i).Interface()
+	}
+
+	return values
+}
+
+func graphQLLimit(args map[string]interface{}) (int32, error) {
+	limit, ok := args["limit"].(int)
+	if !ok {
+		return defaultGraphQLLimit, nil
+	}
+
+	if limit < 1 || limit > maxGraphQLLimit {
+		return 0, fmt.Errorf("limit must be between 1 and %d", maxGraphQLLimit)
+	}
+
+	return int32(limit), nil
+}
+
+func int64Args(arg interface{}) []int64 {
+	list, _ := arg.([]interface{})
+
+	var values []int64
+	for _, value := range list {
+		if i, ok := value.(int); ok {
+			values = append(values, int64(i))
+		}
+	}
+
+	return values
+}
+
+func stringArgs(arg interface{}) []string {
+	list, _ := arg.([]interface{})
+
+	var values []string
+	for _, value := range list {
+		if s, ok := value.(string); ok {
+			values = append(values, s)
+		}
+	}
+
+	return values
+}
+
+// graphQLError is the message of the status of an error from a service, as
+// GraphQL errors only have a message.
+func graphQLError(err error) error {
+	if st, ok := status.FromError(err); ok {
+		return fmt.Errorf("%s: %s", st.Code(), st.Message())
+	}
+
+	return err
+}
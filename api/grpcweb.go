@@ -0,0 +1,214 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// grpcWebDroppedMetadata are the metadata of gRPC-Web requests not forwarded
+// to the services, either describing the request to the gateway or set by it.
+var grpcWebDroppedMetadata = []string{
+	":authority", "content-type", "user-agent", "x-grpc-web", "x-user-agent",
+	"authorization", apiKeyHeaderMetadata, identityMetadataKey, rolesMetadataKey,
+	forwardedForMetadataKey,
+}
+
+// apiKeyHeaderMetadata is the API key header as metadata.
+var apiKeyHeaderMetadata = strings.ToLower(apiKeyHeader)
+
+// grpcWebRequestContextKey holds the HTTP request of a gRPC-Web call, so the
+// metadata the gateway adds to requests can be built for it.
+type grpcWebRequestContextKey struct{}
+
+// grpcWebProxy forwards gRPC-Web calls to the services as they are, without
+// decoding their messages, so every RPC of the services can be called
+// including streams.
+type grpcWebProxy struct {
+	// conns are the connections to the services by the prefix of the full
+	// names of their methods, e.g. /racing.Racing/.
+	conns map[string]*grpc.ClientConn
+	// metadata builds the metadata added to requests, as for those
+	// transcoded from JSON.
+	metadata []func(context.Context, *http.Request) metadata.MD
+}
+
+// newGRPCWebHandler serves gRPC-Web calls to the services of the connections,
+// keyed by the full name of the service, e.g. racing.Racing.
+func newGRPCWebHandler(conns map[string]*grpc.ClientConn, md ...func(context.Context, *http.Request) metadata.MD) *grpcweb.WrappedGrpcServer {
+	proxy := &grpcWebProxy{conns: make(map[string]*grpc.ClientConn), metadata: md}
+	for service, conn := range conns {
+		proxy.conns["/"+service+"/"] = conn
+	}
+
+	server := grpc.NewServer(grpc.ForceServerCodec(rawCodec{}), grpc.UnknownServiceHandler(proxy.handle))
+
+	return grpcweb.WrapServer(server)
+}
+
+// withGRPCWeb serves the gRPC-Web calls, passing the other requests on. It
+// must run after withAuth, as the client authenticated is forwarded, and
+// before withProfiles as profiles only shape JSON responses. Cross origin
+// requests are left to withCORS.
+func withGRPCWeb(next http.Handler, grpcWeb *grpcweb.WrappedGrpcServer) http.Handler {
+	if grpcWeb == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !grpcWeb.IsGrpcWebRequest(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), grpcWebRequestContextKey{}, r)
+		grpcWeb.HandleGrpcWebRequest(w, r.WithContext(ctx))
+	})
+}
+
+// handle forwards a call to the service of its method, its messages in both
+// directions, and the headers and trailers of the response.
+func (p *grpcWebProxy) handle(_ interface{}, stream grpc.ServerStream) error {
+	method, ok := grpc.MethodFromServerStream(stream)
+	if !ok {
+		return status.Error(codes.Internal, "no method for stream")
+	}
+
+	conn := p.conn(method)
+	if conn == nil {
+		return status.Errorf(codes.Unimplemented, "unknown method %s", method)
+	}
+
+	ctx, cancel := context.WithCancel(metadata.NewOutgoingContext(stream.Context(), p.outgoingMetadata(stream.Context())))
+	defer cancel()
+
+	client, err := conn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true, ClientStreams: true}, method, grpc.ForceCodec(rawCodec{}))
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			var frame rawFrame
+			if err := stream.RecvMsg(&frame); err != nil {
+				if errors.Is(err, io.EOF) {
+					client.CloseSend()
+				} else {
+					cancel()
+				}
+				return
+			}
+
+			if err := client.SendMsg(&frame); err != nil {
+				return
+			}
+		}
+	}()
+
+	for sentHeader := false; ; {
+		var frame rawFrame
+		err := client.RecvMsg(&frame)
+
+		if !sentHeader {
+			if header, err := client.Header(); err == nil {
+				stream.SendHeader(header)
+			}
+			sentHeader = true
+		}
+
+		if err != nil {
+			stream.SetTrailer(client.Trailer())
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		if err := stream.SendMsg(&frame); err != nil {
+			return err
+		}
+	}
+}
+
+// conn returns the connection to the service of the method, nil when it is
+// of none.
+func (p *grpcWebProxy) conn(method string) *grpc.ClientConn {
+	for prefix, conn := range p.conns {
+		if strings.HasPrefix(method, prefix) {
+			return conn
+		}
+	}
+
+	return nil
+}
+
+// outgoingMetadata is the metadata of the call to the service, that of the
+// gRPC-Web request bar what is dropped, with what the gateway adds.
+func (p *grpcWebProxy) outgoingMetadata(ctx context.Context) metadata.MD {
+	md, _ := metadata.FromIncomingContext(ctx)
+	md = md.Copy()
+
+	for _, key := range grpcWebDroppedMetadata {
+		md.Delete(key)
+	}
+
+	if r, ok := ctx.Value(grpcWebRequestContextKey{}).(*http.Request); ok {
+		if forwarded := forwardedFor(r); forwarded != "" {
+			md.Set(forwardedForMetadataKey, forwarded)
+		}
+
+		for _, build := range p.metadata {
+			md = metadata.Join(md, build(ctx, r))
+		}
+	}
+
+	return md
+}
+
+// forwardedFor is the X-Forwarded-For of the request with the address the
+// gateway was called from appended, as runtime.AnnotateContext forwards it for
+// JSON requests, so the last address can be trusted. It is empty when the
+// address is unknown.
+func forwardedFor(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return ""
+	}
+
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		return forwarded + ", " + host
+	}
+
+	return host
+}
+
+// rawFrame is a message as it was received, still encoded.
+type rawFrame struct {
+	data []byte
+}
+
+// rawCodec passes messages through as rawFrames. It is named proto as the
+// services are called with the content type of the calls.
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v interface{}) ([]byte, error) {
+	return v.(*rawFrame).data, nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	v.(*rawFrame).data = append([]byte(nil), data...)
+	return nil
+}
+
+func (rawCodec) Name() string {
+	return "proto"
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"git.neds.sh/matty/entain/api/proto/racing"
+	"git.neds.sh/matty/entain/pkg/ratelimit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestGRPCWebRateLimitedByPeer checks gRPC-Web calls are limited by the
+// address they come from, whatever X-Forwarded-For the caller sends.
+func TestGRPCWebRateLimitedByPeer(t *testing.T) {
+	limiter, err := ratelimit.New(map[string]ratelimit.Limit{ratelimit.Default: {Rate: 0.001, Burst: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	racing.RegisterRacingServer(server, &racingServer{})
+	go server.Serve(listener)
+	defer server.Stop()
+
+	conn, err := grpc.Dial(
+		"bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithInsecure(),
+		grpc.WithStreamInterceptor(newStreamRateLimitInterceptor(limiter)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	handler := withGRPCWeb(http.NotFoundHandler(), newGRPCWebHandler(map[string]*grpc.ClientConn{"racing.Racing": conn}))
+
+	calls := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor string
+		want         codes.Code
+	}{
+		{name: "first", remoteAddr: "203.0.113.7:1234", want: codes.OK},
+		{name: "same peer", remoteAddr: "203.0.113.7:5678", want: codes.ResourceExhausted},
+		{name: "same peer forwarded for another", remoteAddr: "203.0.113.7:5678", forwardedFor: "198.51.100.9", want: codes.ResourceExhausted},
+		{name: "other peer", remoteAddr: "198.51.100.9:1234", want: codes.OK},
+	}
+
+	for _, call := range calls {
+		r := grpcWebRequest(t, "/racing.Racing/GetRace", &racing.GetRaceRequest{Id: 1})
+		r.RemoteAddr = call.remoteAddr
+		if call.forwardedFor != "" {
+			r.Header.Set("X-Forwarded-For", call.forwardedFor)
+		}
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, r)
+
+		if got := grpcWebStatus(w); got != call.want {
+			t.Errorf("%s: status = %s, want %s", call.name, got, call.want)
+		}
+	}
+}
+
+// racingServer serves any race asked for.
+type racingServer struct {
+	racing.UnimplementedRacingServer
+}
+
+func (s *racingServer) GetRace(ctx context.Context, in *racing.GetRaceRequest) (*racing.Race, error) {
+	return &racing.Race{Id: in.GetId()}, nil
+}
+
+// grpcWebRequest builds a gRPC-Web call of the method with a single message.
+func grpcWebRequest(t *testing.T, method string, message proto.Message) *http.Request {
+	t.Helper()
+
+	data, err := proto.Marshal(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	frame := make([]byte, 5, 5+len(data))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(data)))
+	frame = append(frame, data...)
+
+	r := httptest.NewRequest("POST", method, bytes.NewReader(frame))
+	r.Header.Set("Content-Type", "application/grpc-web+proto")
+
+	return r
+}
+
+// grpcWebStatus returns the code of a gRPC-Web response, from its headers
+// when it failed before responding or else its trailer frame.
+func grpcWebStatus(w *httptest.ResponseRecorder) codes.Code {
+	trailers := w.Header().Get("Grpc-Status")
+
+	body := w.Body.Bytes()
+	for len(body) >= 5 {
+		length := binary.BigEndian.Uint32(body[1:5])
+		if body[0]&0x80 != 0 {
+			for _, line := range strings.Split(string(body[5:5+length]), "\r\n") {
+				if strings.HasPrefix(strings.ToLower(line), "grpc-status:") {
+					trailers = strings.TrimSpace(line[len("grpc-status:"):])
+				}
+			}
+		}
+		body = body[5+length:]
+	}
+
+	var code codes.Code
+	if err := code.UnmarshalJSON([]byte(trailers)); err != nil {
+		return codes.Unknown
+	}
+
+	return code
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// TokenVerifier verifies the bearer JWTs of clients, signed with HS256 by a
+// shared secret or RS256 by the private half of a public key.
+type TokenVerifier struct {
+	hmacKey  []byte
+	rsaKey   *rsa.PublicKey
+	audience string
+}
+
+// tokenClaims are the claims read from a bearer token, the subject is the
+// client.
+type tokenClaims struct {
+	Roles []string `json:"roles"`
+	jwt.RegisteredClaims
+}
+
+// loadTokenVerifier reads the keys bearer tokens are verified with, the
+// HS256 secret and the RS256 PEM public key. Either can be empty, nil is
+// returned when both are. Tokens must be issued for the audience, when given.
+func loadTokenVerifier(hmacKeyPath, rsaKeyPath, audience string) (*TokenVerifier, error) {
+	if hmacKeyPath == "" && rsaKeyPath == "" {
+		return nil, nil
+	}
+
+	verifier := &TokenVerifier{audience: audience}
+
+	if hmacKeyPath != "" {
+		key, err := ioutil.ReadFile(hmacKeyPath)
+		if err != nil {
+			return nil, err
+		}
+		if len(key) == 0 {
+			return nil, fmt.Errorf("empty jwt secret %s", hmacKeyPath)
+		}
+		verifier.hmacKey = key
+	}
+
+	if rsaKeyPath != "" {
+		data, err := ioutil.ReadFile(rsaKeyPath)
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := jwt.ParseRSAPublicKeyFromPEM(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid jwt public key %s: %w", rsaKeyPath, err)
+		}
+		verifier.rsaKey = key
+	}
+
+	return verifier, nil
+}
+
+// Verify checks the signature, expiry and audience of a token, returning the
+// client it was issued to and its roles.
+func (v *TokenVerifier) Verify(token string) (*identity, error) {
+	var claims tokenClaims
+
+	// Only the algorithms a key is configured for are accepted, so a token
+	// can't pick one, e.g. none or HS256 signed with the public key.
+	_, err := jwt.ParseWithClaims(token, &claims, func(token *jwt.Token) (interface{}, error) {
+		switch token.Method {
+		case jwt.SigningMethodHS256:
+			if v.hmacKey != nil {
+				return v.hmacKey, nil
+			}
+		case jwt.SigningMethodRS256:
+			if v.rsaKey != nil {
+				return v.rsaKey, nil
+			}
+		}
+
+		return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Subject == "" {
+		return nil, errors.New("token has no subject")
+	}
+
+	if v.audience != "" && !claims.VerifyAudience(v.audience, true) {
+		return nil, fmt.Errorf("token is not for audience %s", v.audience)
+	}
+
+	return &identity{Client: claims.Subject, Roles: claims.Roles}, nil
+}
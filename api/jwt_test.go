@@ -0,0 +1,157 @@
+package main
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const testAudience = "entain-api"
+
+// writeKeyFile writes a key to a file for loadTokenVerifier and returns its
+// path.
+func writeKeyFile(t *testing.T, name string, data []byte) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := ioutil.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestTokenVerifier(t *testing.T) {
+	hmacKey := []byte("test-secret")
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})
+
+	hmacPath := writeKeyFile(t, "jwt-secret", hmacKey)
+	rsaPath := writeKeyFile(t, "jwt-public.pem", publicPEM)
+
+	both, err := loadTokenVerifier(hmacPath, rsaPath, testAudience)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaOnly, err := loadTokenVerifier("", rsaPath, testAudience)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hmacOnly, err := loadTokenVerifier(hmacPath, "", testAudience)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	claims := func(subject string, audience string, expiresIn time.Duration) tokenClaims {
+		return tokenClaims{
+			Roles: []string{adminRole},
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject:   subject,
+				Audience:  jwt.ClaimStrings{audience},
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiresIn)),
+			},
+		}
+	}
+	sign := func(method jwt.SigningMethod, key interface{}, claims tokenClaims) string {
+		token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+
+	tests := []struct {
+		name       string
+		verifier   *TokenVerifier
+		token      string
+		wantClient string
+	}{
+		{
+			name:       "hs256",
+			verifier:   both,
+			token:      sign(jwt.SigningMethodHS256, hmacKey, claims("mobile-app", testAudience, time.Hour)),
+			wantClient: "mobile-app",
+		},
+		{
+			name:       "rs256",
+			verifier:   both,
+			token:      sign(jwt.SigningMethodRS256, rsaKey, claims("trading-desk", testAudience, time.Hour)),
+			wantClient: "trading-desk",
+		},
+		{
+			name:     "hs256 signed with the public key",
+			verifier: rsaOnly,
+			token:    sign(jwt.SigningMethodHS256, publicPEM, claims("mobile-app", testAudience, time.Hour)),
+		},
+		{
+			name:     "rs256 without a public key",
+			verifier: hmacOnly,
+			token:    sign(jwt.SigningMethodRS256, rsaKey, claims("mobile-app", testAudience, time.Hour)),
+		},
+		{
+			name:     "alg none",
+			verifier: both,
+			token:    sign(jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, claims("mobile-app", testAudience, time.Hour)),
+		},
+		{
+			name:     "wrong secret",
+			verifier: both,
+			token:    sign(jwt.SigningMethodHS256, []byte("other-secret"), claims("mobile-app", testAudience, time.Hour)),
+		},
+		{
+			name:     "expired",
+			verifier: both,
+			token:    sign(jwt.SigningMethodHS256, hmacKey, claims("mobile-app", testAudience, -time.Minute)),
+		},
+		{
+			name:     "no subject",
+			verifier: both,
+			token:    sign(jwt.SigningMethodHS256, hmacKey, claims("", testAudience, time.Hour)),
+		},
+		{
+			name:     "wrong audience",
+			verifier: both,
+			token:    sign(jwt.SigningMethodHS256, hmacKey, claims("mobile-app", "other-api", time.Hour)),
+		},
+		{
+			name:     "not a token",
+			verifier: both,
+			token:    "not.a.token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.verifier.Verify(tt.token)
+
+			if tt.wantClient == "" {
+				if err == nil {
+					t.Errorf("Verify = %+v, want an error", got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("err = %v, want nil", err)
+			}
+			if got.Client != tt.wantClient || !got.hasRole(adminRole) {
+				t.Errorf("Verify = %+v, want client %s with role %s", got, tt.wantClient, adminRole)
+			}
+		})
+	}
+}
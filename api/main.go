@@ -2,54 +2,368 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"git.neds.sh/matty/entain/api/proto/racing"
 	"git.neds.sh/matty/entain/api/proto/sports"
+	"git.neds.sh/matty/entain/pkg/config"
+	"git.neds.sh/matty/entain/pkg/logging"
+	"git.neds.sh/matty/entain/pkg/metrics"
+	"git.neds.sh/matty/entain/pkg/ratelimit"
+	"git.neds.sh/matty/entain/pkg/tlsconfig"
+	"git.neds.sh/matty/entain/pkg/tracing"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	// Register the error detail types the services attach so the gateway
+	// can render them.
+	_ "google.golang.org/genproto/googleapis/rpc/errdetails"
+	// Enable client side health checking of the backends.
+	_ "google.golang.org/grpc/health"
 )
 
 var (
 	apiEndpoint        = flag.String("api-endpoint", "localhost:8000", "API endpoint")
-	grpcRacingEndpoint = flag.String("grpc-racing-endpoint", "localhost:9000", "gRPC server endpoint")
-	grpcSportsEndpoint = flag.String("grpc-sports-endpoint", "localhost:9001", "gRPC server endpoint")
+	grpcRacingEndpoint = flag.String("grpc-racing-endpoint", "localhost:9000", "gRPC server endpoints, comma separated")
+	grpcSportsEndpoint = flag.String("grpc-sports-endpoint", "localhost:9001", "gRPC server endpoints, comma separated")
+	cacheNextToGo      = flag.Bool("next-to-go-cache", true, "Serve next to go from memory, kept up to date by the changes of the services")
+	backendsFile       = flag.String("backends", "", "JSON file of the endpoints of each backend, reloaded when changed, overriding the endpoint flags")
+	featureFlagFile    = flag.String("feature-flags", "", "JSON file of feature flag states, reloaded when changed")
+	experimentsFile    = flag.String("experiments", "", "JSON file of A/B experiments to bucket clients into")
+	apiKeysFile        = flag.String("api-keys", "", "JSON file of the hashed API keys of clients allowed to make changes, disabled when empty")
+	jwtSecretFile      = flag.String("jwt-hs256-key", "", "File of the secret HS256 bearer tokens are signed with, not accepted when empty")
+	jwtPublicKeyFile   = flag.String("jwt-rs256-key", "", "PEM file of the public key RS256 bearer tokens are verified with, not accepted when empty")
+	jwtAudience        = flag.String("jwt-audience", "", "Audience bearer tokens must be issued for, not checked when empty")
+	rateLimitFile      = flag.String("rate-limits", "", "JSON file of the rate limits of each client by method, disabled when empty")
+	profilesFile       = flag.String("profiles", "", "JSON file of client profiles shaping responses, selected by the client authenticated")
+	logFormat          = flag.String("log-format", logging.FormatJSON, "Format of the log lines, json or console")
+	logLevel           = flag.String("log-level", "info", "Least severe level of the messages logged, one of: debug, info, warn, error")
+	metricsAddr        = flag.String("metrics-addr", "", "Address to serve Prometheus metrics on /metrics, disabled when empty")
+	shutdownTimeout    = flag.Duration("shutdown-timeout", 30*time.Second, "How long in-flight requests are given to finish when shutting down before they are cancelled")
+	tlsCert            = flag.String("tls-cert", "", "PEM certificate to serve HTTPS with, HTTP when empty")
+	tlsKey             = flag.String("tls-key", "", "PEM private key of the TLS certificate")
+	backendTLS         = flag.Bool("backend-tls", false, "Call the services over TLS")
+	backendCA          = flag.String("backend-tls-ca", "", "PEM CA the certificates of the services are verified against, the system roots when empty")
+	backendCert        = flag.String("backend-tls-cert", "", "PEM client certificate presented to the services (mTLS), none when empty")
+	backendKey         = flag.String("backend-tls-key", "", "PEM private key of the client certificate")
+	corsOrigins        = flag.String("cors-origins", "", "Comma separated origins browsers may call the API from, * for any, disabled when empty")
+	corsMethods        = flag.String("cors-methods", "GET,POST,PUT,PATCH,DELETE", "Comma separated methods browsers may call the API with from the CORS origins")
+	corsHeaders        = flag.String("cors-headers", "Authorization,Content-Type,X-Api-Key,If-None-Match", "Comma separated request headers browsers may send from the CORS origins")
+	corsMaxAge         = flag.Duration("cors-max-age", 10*time.Minute, "How long browsers may cache the answer to a CORS preflight request")
+	grpcWeb            = flag.Bool("grpc-web", false, "Serve gRPC-Web calls to the services, including streams, alongside the JSON API")
 )
 
+// featureFlagReloadInterval is how often the feature flags file is checked for
+// changes.
+const featureFlagReloadInterval = 10 * time.Second
+
+// backendsReloadInterval is how often the backends file is checked for
+// changes.
+const backendsReloadInterval = 10 * time.Second
+
+// backendServiceConfig watches the health of the backends so requests fail
+// fast with Unavailable while a backend isn't serving. Health checking isn't
+// supported by the default pick_first balancer.
+const backendServiceConfig = `{
+	"loadBalancingConfig": [{"round_robin": {}}],
+	"healthCheckConfig": {"serviceName": ""}
+}`
+
 func main() {
-	flag.Parse()
+	if err := config.Parse(flag.CommandLine, "API", os.Args[1:]); err != nil {
+		log.Fatalf("failed reading config: %s\n", err)
+	}
+
+	logger, err := logging.New(*logFormat, *logLevel)
+	if err != nil {
+		log.Fatalf("failed creating logger: %s\n", err)
+	}
+	defer logger.Sync()
 
-	if err := run(); err != nil {
-		log.Printf("failed running api server: %s\n", err)
+	if err := run(logger); err != nil {
+		logger.Error("failed running api server", zap.Error(err))
 	}
 }
 
-func run() error {
+func run(logger *zap.Logger) error {
 	ctx := context.Background()
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	mux := runtime.NewServeMux()
-	if err := racing.RegisterRacingHandlerFromEndpoint(
-		ctx,
-		mux,
-		*grpcRacingEndpoint,
-		[]grpc.DialOption{grpc.WithInsecure()},
-	); err != nil {
+	featureFlags := newFeatureFlags()
+	if *featureFlagFile != "" {
+		if err := featureFlags.LoadFile(*featureFlagFile); err != nil {
+			return err
+		}
+		go featureFlags.Watch(ctx, *featureFlagFile, featureFlagReloadInterval)
+	}
+
+	experiments := &Experiments{}
+	if *experimentsFile != "" {
+		loaded, err := loadExperiments(*experimentsFile)
+		if err != nil {
+			return err
+		}
+		experiments = loaded
+	}
+
+	profiles := &Profiles{}
+	if *profilesFile != "" {
+		loaded, err := loadProfiles(*profilesFile)
+		if err != nil {
+			return err
+		}
+		profiles = loaded
+	}
+
+	var apiKeys *KeyStore
+	if *apiKeysFile != "" {
+		loaded, err := loadKeyStore(*apiKeysFile)
+		if err != nil {
+			return err
+		}
+		apiKeys = loaded
+	}
+
+	tokens, err := loadTokenVerifier(*jwtSecretFile, *jwtPublicKeyFile, *jwtAudience)
+	if err != nil {
+		return err
+	}
+
+	// Profiles are selected by the client authenticated, so need a way to
+	// authenticate one.
+	if len(profiles.Profiles) > 0 && apiKeys == nil && tokens == nil {
+		return errors.New("profiles are selected by client, give -api-keys or a jwt key")
+	}
+
+	var rateLimiter *ratelimit.Limiter
+	if *rateLimitFile != "" {
+		loaded, err := ratelimit.Load(*rateLimitFile)
+		if err != nil {
+			return err
+		}
+		rateLimiter = loaded
+	}
+
+	registry := metrics.NewRegistry()
+	rpcMetrics := metrics.NewClientRPC(registry)
+
+	if *metricsAddr != "" {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("/metrics", registry)
+
+		go func() {
+			logger.Info("metrics server listening", zap.String("addr", *metricsAddr))
+			if err := http.ListenAndServe(*metricsAddr, metricsMux); err != nil {
+				logger.Error("failed running metrics server", zap.Error(err))
+			}
+		}()
+	}
+
+	tracer, err := tracing.NewFromEnv("api")
+	if err != nil {
+		return err
+	}
+	go tracer.Run(ctx)
+
+	mux := runtime.NewServeMux(
+		runtime.WithMetadata(experimentsMetadata),
+		runtime.WithMetadata(requestIDMetadata),
+		runtime.WithMetadata(baggageMetadata),
+		runtime.WithMetadata(identityMetadata),
+		runtime.WithForwardResponseOption(shapeResponse),
+		runtime.WithForwardResponseOption(staleWarning),
+		runtime.WithErrorHandler(errorHandler),
+		runtime.WithRoutingErrorHandler(routingErrorHandler),
+		sparseMarshaler(),
+		epochMillisMarshalers(),
+	)
+
+	transportCreds := insecure.NewCredentials()
+	// Giving a CA or client certificate implies TLS, the flag is only needed
+	// to verify the services against the system roots.
+	if *backendTLS || *backendCA != "" || *backendCert != "" {
+		tlsConfig, err := tlsconfig.Client(*backendCA, *backendCert, *backendKey)
+		if err != nil {
+			return err
+		}
+		transportCreds = credentials.NewTLS(tlsConfig)
+	}
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(transportCreds),
+		grpc.WithChainUnaryInterceptor(newUnaryTracingInterceptor(tracer), newUnaryMetricsInterceptor(rpcMetrics), newUnaryRateLimitInterceptor(rateLimiter), capPageSize),
+		grpc.WithChainStreamInterceptor(newStreamTracingInterceptor(tracer), newStreamMetricsInterceptor(rpcMetrics), newStreamRateLimitInterceptor(rateLimiter)),
+		grpc.WithDefaultServiceConfig(backendServiceConfig),
+	}
+
+	backends, err := newBackends(map[string][]string{
+		backendRacing: splitEndpoints(*grpcRacingEndpoint),
+		backendSports: splitEndpoints(*grpcSportsEndpoint),
+	})
+	if err != nil {
+		return err
+	}
+
+	racingConn, err := backends.Dial(ctx, backendRacing, dialOpts...)
+	if err != nil {
+		return err
+	}
+	defer racingConn.Close()
+
+	sportsConn, err := backends.Dial(ctx, backendSports, dialOpts...)
+	if err != nil {
+		return err
+	}
+	defer sportsConn.Close()
+
+	// The file is loaded once dialled as the addresses of a backend can only
+	// be changed after.
+	if *backendsFile != "" {
+		if err := backends.LoadFile(*backendsFile); err != nil {
+			return err
+		}
+		go backends.Watch(ctx, *backendsFile, backendsReloadInterval)
+	}
+
+	if err := racing.RegisterRacingHandler(ctx, mux, racingConn); err != nil {
+		return err
+	}
+	if err := sports.RegisterSportsHandler(ctx, mux, sportsConn); err != nil {
+		return err
+	}
+
+	var grpcWebHandler *grpcweb.WrappedGrpcServer
+	if *grpcWeb {
+		conns := map[string]*grpc.ClientConn{
+			racing.Racing_ServiceDesc.ServiceName: racingConn,
+			sports.Sports_ServiceDesc.ServiceName: sportsConn,
+		}
+		grpcWebHandler = newGRPCWebHandler(conns, experimentsMetadata, requestIDMetadata, baggageMetadata, identityMetadata)
+	}
+
+	racingClient := racing.NewRacingClient(racingConn)
+	sportsClient := sports.NewSportsClient(sportsConn)
+
+	var cache *nextToGoCache
+	if *cacheNextToGo {
+		cache = newNextToGoCache(racingClient, sportsClient, logger)
+		go cache.Run(ctx)
+	}
+
+	if err := mux.HandlePath("GET", "/v1/next-to-go", newNextToGoHandler(mux, racingClient, sportsClient, cache)); err != nil {
 		return err
 	}
-	if err := sports.RegisterSportsHandlerFromEndpoint(
-		ctx,
-		mux,
-		*grpcSportsEndpoint,
-		[]grpc.DialOption{grpc.WithInsecure()},
-	); err != nil {
+
+	if err := mux.HandlePath("GET", "/v1/next-to-go/stream", newNextToGoStreamHandler(mux, racingClient, sportsClient, cache)); err != nil {
 		return err
 	}
 
-	log.Printf("API server listening on: %s\n", *apiEndpoint)
+	if err := mux.HandlePath("GET", "/v1/search", newSearchHandler(mux, racingClient, sportsClient)); err != nil {
+		return err
+	}
+
+	cors := newCORSPolicy(*corsOrigins, *corsMethods, *corsHeaders, *corsMaxAge)
+
+	if err := mux.HandlePath("GET", "/v1/stream", newStreamHandler(mux, racingClient, sportsClient, featureFlags, cors)); err != nil {
+		return err
+	}
+
+	graphQLHandler, err := newGraphQLHandler(mux, racingClient, sportsClient)
+	if err != nil {
+		return err
+	}
+	if err := mux.HandlePath("GET", "/v1/graphql", graphQLHandler); err != nil {
+		return err
+	}
+	if err := mux.HandlePath("POST", "/v1/graphql", graphQLHandler); err != nil {
+		return err
+	}
+
+	catalogueHandler, err := newCatalogueHandler(featureFlags)
+	if err != nil {
+		return err
+	}
+	if err := mux.HandlePath("GET", "/v1/catalogue", catalogueHandler); err != nil {
+		return err
+	}
+	if err := mux.HandlePath("GET", "/v1/errors", newErrorCatalogueHandler()); err != nil {
+		return err
+	}
+	if err := mux.HandlePath("GET", "/v1/admin/api/flags", requireAdmin(newListFeatureFlagsHandler(featureFlags))); err != nil {
+		return err
+	}
+	if err := mux.HandlePath("PUT", "/v1/admin/api/flags/{name}", requireAdmin(newSetFeatureFlagHandler(featureFlags))); err != nil {
+		return err
+	}
+	if err := mux.HandlePath("GET", "/v1/admin/api/backends", requireAdmin(newListBackendsHandler(backends))); err != nil {
+		return err
+	}
+	if err := mux.HandlePath("GET", "/v1/admin/summary", newSummaryHandler(mux, racingClient, sportsClient, rpcMetrics, cache)); err != nil {
+		return err
+	}
+
+	logger.Info("API server listening", zap.String("addr", *apiEndpoint))
+
+	// Experiments are assigned first as they are part of the baggage every
+	// span and log line is annotated with.
+	handler := withExperiments(withBaggage(withRequestLog(withTracing(withAuth(withGRPCWeb(withProfiles(withEnvelope(withConditionalGets(withTimeFormat(withQueryAliases(mux, featureFlags)))), profiles), grpcWebHandler), apiKeys, tokens), tracer), logger)), experiments)
+	// Preflight requests are answered before anything else, as they carry
+	// no credentials.
+	handler = withCORS(handler, cors)
+
+	server := &http.Server{Addr: *apiEndpoint, Handler: handler}
+
+	if *tlsCert != "" {
+		tlsConfig, err := tlsconfig.Server(*tlsCert, *tlsKey, "")
+		if err != nil {
+			return err
+		}
+		server.TLSConfig = tlsConfig
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		if server.TLSConfig != nil {
+			serveErr <- server.ListenAndServeTLS("", "")
+		} else {
+			serveErr <- server.ListenAndServe()
+		}
+	}()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+
+	select {
+	case err := <-serveErr:
+		return err
+	case sig := <-signals:
+		logger.Info("shutting down", zap.String("signal", sig.String()), zap.Duration("timeout", *shutdownTimeout))
+	}
+
+	// Stops accepting connections and waits for the requests in flight, the
+	// connections to the services are closed after as run returns.
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), *shutdownTimeout)
+	defer cancelShutdown()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		logger.Warn("timed out draining in-flight requests, cancelling them", zap.Error(err))
+		server.Close()
+		return nil
+	}
+
+	logger.Info("drained in-flight requests")
 
-	return http.ListenAndServe(*apiEndpoint, mux)
+	return nil
 }
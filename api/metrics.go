@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"git.neds.sh/matty/entain/pkg/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// newUnaryMetricsInterceptor records the count and latency of the unary
+// requests made to the backends by method and status.
+func newUnaryMetricsInterceptor(rpc *metrics.RPC) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		rpc.Observe(method, status.Code(err).String(), time.Since(start))
+
+		return err
+	}
+}
+
+// newStreamMetricsInterceptor records the count and duration of the streams
+// opened to the backends by method and status, once they end.
+func newStreamMetricsInterceptor(rpc *metrics.RPC) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		start := time.Now()
+
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			rpc.Observe(method, status.Code(err).String(), time.Since(start))
+			return nil, err
+		}
+
+		return &endedClientStream{ClientStream: stream, onEnd: func(err error) {
+			rpc.Observe(method, status.Code(err).String(), time.Since(start))
+		}}, nil
+	}
+}
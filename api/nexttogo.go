@@ -0,0 +1,252 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"git.neds.sh/matty/entain/api/proto/racing"
+	"git.neds.sh/matty/entain/api/proto/sports"
+	"git.neds.sh/matty/entain/pkg/errorreason"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// nextToGoLimitParam is how many races and events to return.
+	nextToGoLimitParam = "limit"
+	// nextToGoCategoryParam restricts next to go to races, given as racing,
+	// or the events of a sport.
+	nextToGoCategoryParam = "category"
+
+	defaultNextToGoLimit = 10
+	maxNextToGoLimit     = 100
+
+	// categoryRacing is the category of races, any other is a sport.
+	categoryRacing = "racing"
+
+	// orderByStartTime lists races and events soonest first.
+	orderByStartTime = "advertised_start_time"
+)
+
+// raceOrEvent is a race or an event, only one of which is set.
+type raceOrEvent struct {
+	Race  *racing.Race
+	Event *sports.Event
+}
+
+func (e raceOrEvent) startTime() time.Time {
+	if e.Race != nil {
+		return e.Race.GetAdvertisedStartTime().AsTime()
+	}
+
+	return e.Event.GetAdvertisedStartTime().AsTime()
+}
+
+func (e raceOrEvent) id() int64 {
+	if e.Race != nil {
+		return e.Race.GetId()
+	}
+
+	return e.Event.GetId()
+}
+
+// raceOrEventItem is the JSON representation of a race or event.
+type raceOrEventItem struct {
+	Race  json.RawMessage `json:"race,omitempty"`
+	Event json.RawMessage `json:"event,omitempty"`
+}
+
+// newNextToGoHandler serves the visible races and sports events starting next,
+// soonest first, so clients don't have to list and merge both themselves. They
+// are served from the cache while it is following the changes of the
+// services, or else listed from them. The cache can be nil.
+func newNextToGoHandler(mux *runtime.ServeMux, racingClient racing.RacingClient, sportsClient sports.SportsClient, cache *nextToGoCache) func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+
+		query := r.URL.Query()
+		category := query.Get(nextToGoCategoryParam)
+
+		limit, err := parseNextToGoLimit(query.Get(nextToGoLimitParam))
+		if err != nil {
+			writeStatus(w, errorreason.InvalidValue.Status(err.Error(), nil))
+			return
+		}
+
+		entries, ok := cache.Next(category, limit)
+		if !ok {
+			entries, err = listNextToGo(r, mux, racingClient, sportsClient, category, limit)
+			if err != nil {
+				runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+				return
+			}
+		}
+
+		writeRaceOrEvents(w, r, mux, outbound, entries)
+	}
+}
+
+// parseNextToGoLimit parses the limit of next to go, the default when it
+// isn't given.
+func parseNextToGoLimit(value string) (int, error) {
+	if value == "" {
+		return defaultNextToGoLimit, nil
+	}
+
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 1 || limit > maxNextToGoLimit {
+		return 0, fmt.Errorf("limit must be between 1 and %d", maxNextToGoLimit)
+	}
+
+	return limit, nil
+}
+
+// raceOrEventItems is the JSON representation of a list of races and events.
+type raceOrEventItems struct {
+	Items []raceOrEventItem `json:"items"`
+}
+
+// writeRaceOrEvents writes the races and events as items of a race or an
+// event, marshalled as the mux would.
+func writeRaceOrEvents(w http.ResponseWriter, r *http.Request, mux *runtime.ServeMux, marshaler runtime.Marshaler, entries []raceOrEvent) {
+	response, err := marshalRaceOrEvents(r.Context(), marshaler, entries)
+	if err != nil {
+		runtime.HTTPError(r.Context(), mux, marshaler, w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// marshalRaceOrEvents marshals the races and events as items of a race or an
+// event, as the mux would.
+func marshalRaceOrEvents(ctx context.Context, marshaler runtime.Marshaler, entries []raceOrEvent) (raceOrEventItems, error) {
+	response := raceOrEventItems{Items: []raceOrEventItem{}}
+
+	for _, entry := range entries {
+		var (
+			item raceOrEventItem
+			err  error
+		)
+
+		if entry.Race != nil {
+			item.Race, err = marshalItem(ctx, marshaler, entry.Race)
+		} else {
+			item.Event, err = marshalItem(ctx, marshaler, entry.Event)
+		}
+
+		if err != nil {
+			return raceOrEventItems{}, err
+		}
+
+		response.Items = append(response.Items, item)
+	}
+
+	return response, nil
+}
+
+// listNextToGo lists the races and events of the category starting next from
+// the services in parallel, failing if either fails.
+func listNextToGo(r *http.Request, mux *runtime.ServeMux, racingClient racing.RacingClient, sportsClient sports.SportsClient, category string, limit int) ([]raceOrEvent, error) {
+	group, ctx := errgroup.WithContext(r.Context())
+
+	now := timestamppb.New(time.Now())
+	orderBy := orderByStartTime
+	visible := true
+
+	var (
+		races  []*racing.Race
+		events []*sports.Event
+	)
+
+	if category == "" || category == categoryRacing {
+		group.Go(func() error {
+			// The calls carry the metadata of the request like those the
+			// mux makes, such as the request ID and identity.
+			callCtx, err := runtime.AnnotateContext(ctx, mux, r, "/racing.Racing/ListRaces")
+			if err != nil {
+				return err
+			}
+
+			response, err := racingClient.ListRaces(callCtx, &racing.ListRacesRequest{
+				Filter:   &racing.ListRacesRequestFilter{Visible: &visible, AdvertisedStartAfter: now},
+				OrderBy:  &orderBy,
+				PageSize: int32(limit),
+			})
+			if err != nil {
+				return err
+			}
+
+			races = response.GetRaces()
+			return nil
+		})
+	}
+
+	if category != categoryRacing {
+		group.Go(func() error {
+			callCtx, err := runtime.AnnotateContext(ctx, mux, r, "/sports.Sports/ListEvents")
+			if err != nil {
+				return err
+			}
+
+			filter := &sports.ListEventsRequestFilter{Visible: &visible, AdvertisedStartAfter: now}
+			if category != "" {
+				filter.Sports = []string{category}
+			}
+
+			response, err := sportsClient.ListEvents(callCtx, &sports.ListEventsRequest{
+				Filter:   filter,
+				OrderBy:  &orderBy,
+				PageSize: int32(limit),
+			})
+			if err != nil {
+				return err
+			}
+
+			events = response.GetEvents()
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+
+	return mergeByStartTime(races, events, limit), nil
+}
+
+// mergeByStartTime merges races and events, each ordered by start time, into
+// at most limit entries ordered by start time.
+func mergeByStartTime(races []*racing.Race, events []*sports.Event, limit int) []raceOrEvent {
+	var entries []raceOrEvent
+
+	for len(entries) < limit && (len(races) > 0 || len(events) > 0) {
+		if len(events) == 0 || (len(races) > 0 && !races[0].GetAdvertisedStartTime().AsTime().After(events[0].GetAdvertisedStartTime().AsTime())) {
+			entries = append(entries, raceOrEvent{Race: races[0]})
+			races = races[1:]
+		} else {
+			entries = append(entries, raceOrEvent{Event: events[0]})
+			events = events[1:]
+		}
+	}
+
+	return entries
+}
+
+// marshalItem marshals a race or event as the mux would, shaped by the
+// profile of the request. The message is copied first as it may be cached.
+func marshalItem(ctx context.Context, marshaler runtime.Marshaler, msg proto.Message) (json.RawMessage, error) {
+	msg = proto.Clone(msg)
+
+	if err := shapeResponse(ctx, nil, msg); err != nil {
+		return nil, err
+	}
+
+	return marshaler.Marshal(msg)
+}
@@ -0,0 +1,436 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"git.neds.sh/matty/entain/api/proto/racing"
+	"git.neds.sh/matty/entain/api/proto/sports"
+	"git.neds.sh/matty/entain/pkg/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// nextToGoRetryInterval is how long the cache waits before following the
+	// changes of a service again after failing.
+	nextToGoRetryInterval = 5 * time.Second
+	// nextToGoPruneInterval is how often races and events which have started
+	// are removed from the cache.
+	nextToGoPruneInterval = time.Minute
+	// nextToGoPageSize is the page size the cache lists and reads changes in,
+	// the most the services return.
+	nextToGoPageSize = 1000
+)
+
+// Keys of the recent window of the cache.
+const (
+	cacheHits   = "hits"
+	cacheMisses = "misses"
+	// The lag and count of the changes applied are suffixed with the
+	// service.
+	cacheLagSeconds = "lag_seconds/"
+	cacheChanges    = "changes/"
+)
+
+// errChangesEnded is returned when a service ends a change stream.
+var errChangesEnded = errors.New("change stream ended")
+
+// nextToGoCache keeps the visible races and events yet to start in memory,
+// soonest first by category, so next to go is served without calling the
+// services. It loads them once then applies the changes streamed by the
+// services, fetching the current state of each race or event changed.
+//
+// Until it has loaded the races or events of a service, and while it isn't
+// following its changes, the cache doesn't serve the categories of the
+// service so they are listed from it instead.
+type nextToGoCache struct {
+	racingClient racing.RacingClient
+	sportsClient sports.SportsClient
+	logger       *zap.Logger
+
+	mu sync.RWMutex
+	// races and events are nil while not following the service's changes.
+	races  map[int64]*racing.Race
+	events map[int64]*sports.Event
+	// views are the entries of each category soonest first, the empty
+	// category having every entry.
+	views map[string][]raceOrEvent
+	// rebuilt is closed once the views are next rebuilt, then replaced.
+	rebuilt chan struct{}
+
+	// recent counts the hits and misses of the cache and the lag of the
+	// changes applied, over metrics.RecentSpan.
+	recent *metrics.Window
+}
+
+func newNextToGoCache(racingClient racing.RacingClient, sportsClient sports.SportsClient, logger *zap.Logger) *nextToGoCache {
+	return &nextToGoCache{
+		racingClient: racingClient,
+		sportsClient: sportsClient,
+		logger:       logger,
+		views:        make(map[string][]raceOrEvent),
+		rebuilt:      make(chan struct{}),
+		recent:       metrics.NewWindow(metrics.RecentSpan, 10*time.Second),
+	}
+}
+
+// Next returns up to limit entries of the category yet to start, soonest
+// first, or false when the cache can't serve the category.
+func (c *nextToGoCache) Next(category string, limit int) ([]raceOrEvent, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if (category != categoryRacing && c.events == nil) || ((category == "" || category == categoryRacing) && c.races == nil) {
+		c.recent.Add(cacheMisses, 1)
+		return nil, false
+	}
+	c.recent.Add(cacheHits, 1)
+
+	view := c.views[category]
+
+	// Entries which have started since the last prune are skipped.
+	now := time.Now()
+	start := sort.Search(len(view), func(i int) bool {
+		return view[i].startTime().After(now)
+	})
+	view = view[start:]
+
+	if len(view) > limit {
+		view = view[:limit]
+	}
+
+	return append([]raceOrEvent(nil), view...), true
+}
+
+// Run follows the changes of both services until the context is cancelled.
+func (c *nextToGoCache) Run(ctx context.Context) {
+	go c.follow(ctx, categoryRacing, c.followRaces)
+	go c.follow(ctx, "sports", c.followEvents)
+
+	ticker := time.NewTicker(nextToGoPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		c.mu.Lock()
+		c.rebuild()
+		c.mu.Unlock()
+	}
+}
+
+// follow runs fn, which follows the changes of a service until failing, again
+// after each failure. Services without change capture aren't followed.
+func (c *nextToGoCache) follow(ctx context.Context, service string, fn func(ctx context.Context) error) {
+	for {
+		err := fn(ctx)
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		if status.Code(err) == codes.Unimplemented {
+			c.logger.Info("next to go not cached as the service doesn't capture changes", zap.String("service", service))
+			return
+		}
+
+		c.logger.Warn("next to go cache stopped following changes, retrying", zap.String("service", service), zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(nextToGoRetryInterval):
+		}
+	}
+}
+
+// followRaces loads the races yet to start then applies their changes. The
+// changes made while loading are applied again, which is harmless as the
+// current state of the race is fetched for each.
+func (c *nextToGoCache) followRaces(ctx context.Context) error {
+	defer c.setRaces(nil)
+
+	var afterSeq int64
+	for {
+		response, err := c.racingClient.ListChanges(ctx, &racing.ListChangesRequest{AfterSeq: afterSeq, Limit: nextToGoPageSize})
+		if err != nil {
+			return err
+		}
+
+		afterSeq = response.GetLastSeq()
+
+		if len(response.GetChanges()) < nextToGoPageSize {
+			break
+		}
+	}
+
+	visible := true
+	request := &racing.ListRacesRequest{
+		Filter:   &racing.ListRacesRequestFilter{Visible: &visible, AdvertisedStartAfter: timestamppb.Now()},
+		PageSize: nextToGoPageSize,
+	}
+
+	races := make(map[int64]*racing.Race)
+	for {
+		response, err := c.racingClient.ListRaces(ctx, request)
+		if err != nil {
+			return err
+		}
+
+		for _, race := range response.GetRaces() {
+			races[race.GetId()] = race
+		}
+
+		if response.GetNextPageToken() == "" {
+			break
+		}
+		request.PageToken = response.GetNextPageToken()
+	}
+
+	stream, err := c.racingClient.WatchChanges(ctx, &racing.WatchChangesRequest{AfterSeq: afterSeq})
+	if err != nil {
+		return err
+	}
+
+	c.setRaces(races)
+
+	for {
+		change, err := stream.Recv()
+		if err == io.EOF {
+			return errChangesEnded
+		}
+		if err != nil {
+			return err
+		}
+
+		race, err := c.racingClient.GetRace(ctx, &racing.GetRaceRequest{Id: change.GetRaceId()})
+		if status.Code(err) == codes.NotFound {
+			race = nil
+		} else if err != nil {
+			return err
+		}
+
+		c.observeLag(categoryRacing, change.GetChangedAt().AsTime())
+
+		c.mu.Lock()
+		if race != nil && race.GetVisible() && race.GetAdvertisedStartTime().AsTime().After(time.Now()) {
+			c.races[change.GetRaceId()] = race
+		} else {
+			delete(c.races, change.GetRaceId())
+		}
+		c.rebuild()
+		c.mu.Unlock()
+	}
+}
+
+// followEvents loads the events yet to start then applies their changes, like
+// followRaces.
+func (c *nextToGoCache) followEvents(ctx context.Context) error {
+	defer c.setEvents(nil)
+
+	var afterSeq int64
+	for {
+		response, err := c.sportsClient.ListChanges(ctx, &sports.ListChangesRequest{AfterSeq: afterSeq, Limit: nextToGoPageSize})
+		if err != nil {
+			return err
+		}
+
+		afterSeq = response.GetLastSeq()
+
+		if len(response.GetChanges()) < nextToGoPageSize {
+			break
+		}
+	}
+
+	visible := true
+	request := &sports.ListEventsRequest{
+		Filter:   &sports.ListEventsRequestFilter{Visible: &visible, AdvertisedStartAfter: timestamppb.Now()},
+		PageSize: nextToGoPageSize,
+	}
+
+	events := make(map[int64]*sports.Event)
+	for {
+		response, err := c.sportsClient.ListEvents(ctx, request)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range response.GetEvents() {
+			events[event.GetId()] = event
+		}
+
+		if response.GetNextPageToken() == "" {
+			break
+		}
+		request.PageToken = response.GetNextPageToken()
+	}
+
+	stream, err := c.sportsClient.WatchChanges(ctx, &sports.WatchChangesRequest{AfterSeq: afterSeq})
+	if err != nil {
+		return err
+	}
+
+	c.setEvents(events)
+
+	for {
+		change, err := stream.Recv()
+		if err == io.EOF {
+			return errChangesEnded
+		}
+		if err != nil {
+			return err
+		}
+
+		event, err := c.sportsClient.GetEvent(ctx, &sports.GetEventRequest{Id: change.GetEventId()})
+		if status.Code(err) == codes.NotFound {
+			event = nil
+		} else if err != nil {
+			return err
+		}
+
+		c.observeLag("sports", change.GetChangedAt().AsTime())
+
+		c.mu.Lock()
+		if event != nil && event.GetVisible() && event.GetAdvertisedStartTime().AsTime().After(time.Now()) {
+			c.events[change.GetEventId()] = event
+		} else {
+			delete(c.events, change.GetEventId())
+		}
+		c.rebuild()
+		c.mu.Unlock()
+	}
+}
+
+// observeLag records how long after it was made a change of the service was
+// applied.
+func (c *nextToGoCache) observeLag(service string, changedAt time.Time) {
+	c.recent.Add(cacheLagSeconds+service, time.Since(changedAt).Seconds())
+	c.recent.Add(cacheChanges+service, 1)
+}
+
+// cacheStats are the recent hits and misses of the cache, and the mean lag in
+// seconds of the changes of each service applied.
+type cacheStats struct {
+	Hits       int64              `json:"hits"`
+	Misses     int64              `json:"misses"`
+	HitRate    float64            `json:"hitRate"`
+	IngestLags map[string]float64 `json:"ingestLagSeconds"`
+}
+
+// Stats returns the hits, misses and ingest lag of the cache over
+// metrics.RecentSpan, the lag of services without changes applied is left
+// out.
+func (c *nextToGoCache) Stats() cacheStats {
+	stats := cacheStats{
+		Hits:       int64(c.recent.Sum(cacheHits)),
+		Misses:     int64(c.recent.Sum(cacheMisses)),
+		IngestLags: make(map[string]float64),
+	}
+
+	if total := stats.Hits + stats.Misses; total > 0 {
+		stats.HitRate = float64(stats.Hits) / float64(total)
+	}
+
+	for _, service := range []string{categoryRacing, "sports"} {
+		if changes := c.recent.Sum(cacheChanges + service); changes > 0 {
+			stats.IngestLags[service] = c.recent.Sum(cacheLagSeconds+service) / changes
+		}
+	}
+
+	return stats
+}
+
+func (c *nextToGoCache) setRaces(races map[int64]*racing.Race) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.races = races
+	c.rebuild()
+}
+
+func (c *nextToGoCache) setEvents(events map[int64]*sports.Event) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.events = events
+	c.rebuild()
+}
+
+// rebuild removes the races and events which have started and sorts those
+// left into the views. It must be called holding the lock.
+func (c *nextToGoCache) rebuild() {
+	now := time.Now()
+
+	var all []raceOrEvent
+
+	for id, race := range c.races {
+		if !race.GetAdvertisedStartTime().AsTime().After(now) {
+			delete(c.races, id)
+			continue
+		}
+		all = append(all, raceOrEvent{Race: race})
+	}
+
+	for id, event := range c.events {
+		if !event.GetAdvertisedStartTime().AsTime().After(now) {
+			delete(c.events, id)
+			continue
+		}
+		all = append(all, raceOrEvent{Event: event})
+	}
+
+	// Ties are broken by ID, races first, so the order is stable.
+	sort.Slice(all, func(i, j int) bool {
+		a, b := all[i], all[j]
+		if !a.startTime().Equal(b.startTime()) {
+			return a.startTime().Before(b.startTime())
+		}
+		if (a.Race != nil) != (b.Race != nil) {
+			return a.Race != nil
+		}
+		return a.id() < b.id()
+	})
+
+	views := map[string][]raceOrEvent{"": all}
+	for _, entry := range all {
+		category := categoryRacing
+		if entry.Event != nil {
+			category = entry.Event.GetSport()
+		}
+		views[category] = append(views[category], entry)
+	}
+
+	c.views = views
+
+	close(c.rebuilt)
+	c.rebuilt = make(chan struct{})
+}
+
+// Rebuilt returns a channel closed once the races and events of the cache
+// next change, or when those which have started are pruned. It is nil, and so
+// never closed, for a nil cache.
+func (c *nextToGoCache) Rebuilt() <-chan struct{} {
+	if c == nil {
+		return nil
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.rebuilt
+}
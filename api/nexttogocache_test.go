@@ -0,0 +1,415 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"git.neds.sh/matty/entain/api/proto/racing"
+	"git.neds.sh/matty/entain/api/proto/sports"
+	"git.neds.sh/matty/entain/api/testutil"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TestNextToGoCacheMatchesListing applies changes through the change streams
+// and checks after each that the cache serves what listing the services
+// directly does, for every category and for short and long limits.
+func TestNextToGoCacheMatchesListing(t *testing.T) {
+	racingClient := newFakeRacingClient(
+		testutil.NewRace(1).StartsIn(time.Hour).Build(),
+		testutil.NewRace(2).Hidden().StartsIn(2*time.Hour).Build(),
+		testutil.NewRace(3).StartsIn(-time.Hour).Build(),
+	)
+	sportsClient := newFakeSportsClient(
+		testutil.NewEvent(1).Sport("tennis").StartsIn(30*time.Minute).Build(),
+		testutil.NewEvent(2).Sport("soccer").StartsIn(90*time.Minute).Build(),
+		testutil.NewEvent(3).Sport("tennis").StartsIn(3*time.Hour).Build(),
+	)
+
+	cache := newNextToGoCache(racingClient, sportsClient, zap.NewNop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go cache.Run(ctx)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if _, ok := cache.Next("", 1); ok {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("cache didn't load the races and events")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	steps := []struct {
+		name  string
+		apply func()
+	}{
+		{name: "loaded", apply: nil},
+		{name: "race inserted", apply: func() {
+			racingClient.Put(testutil.NewRace(4).StartsIn(10 * time.Minute).Build())
+		}},
+		{name: "race hidden", apply: func() {
+			racingClient.Put(testutil.NewRace(1).Hidden().StartsIn(time.Hour).Build())
+		}},
+		{name: "race shown", apply: func() {
+			racingClient.Put(testutil.NewRace(2).StartsIn(2 * time.Hour).Build())
+		}},
+		{name: "event moved sooner", apply: func() {
+			sportsClient.Put(testutil.NewEvent(3).Sport("tennis").StartsIn(5 * time.Minute).Build())
+		}},
+		{name: "event sport changed", apply: func() {
+			sportsClient.Put(testutil.NewEvent(2).Sport("tennis").StartsIn(90 * time.Minute).Build())
+		}},
+		{name: "event renamed", apply: func() {
+			sportsClient.Put(testutil.NewEvent(1).Name("Event 1 renamed").Sport("tennis").StartsIn(30 * time.Minute).Build())
+		}},
+		{name: "race deleted", apply: func() {
+			racingClient.Delete(4)
+		}},
+		{name: "race moved to the past", apply: func() {
+			racingClient.Put(testutil.NewRace(2).StartsIn(-time.Minute).Build())
+		}},
+		{name: "race starting with an event", apply: func() {
+			racingClient.Put(testutil.NewRace(5).StartsAt(sportsClient.Get(1).GetAdvertisedStartTime().AsTime()).Build())
+		}},
+		{name: "event deleted", apply: func() {
+			sportsClient.Delete(3)
+		}},
+	}
+
+	mux := runtime.NewServeMux()
+	categories := []string{"", categoryRacing, "tennis", "soccer"}
+	limits := []int{2, maxNextToGoLimit}
+
+	for _, step := range steps {
+		if step.apply != nil {
+			rebuilt := cache.Rebuilt()
+			step.apply()
+
+			select {
+			case <-rebuilt:
+			case <-time.After(5 * time.Second):
+				t.Fatalf("%s: cache wasn't rebuilt", step.name)
+			}
+		}
+
+		for _, category := range categories {
+			for _, limit := range limits {
+				got, ok := cache.Next(category, limit)
+				if !ok {
+					t.Fatalf("%s: cache didn't serve category %q", step.name, category)
+				}
+
+				want, err := listNextToGo(httptest.NewRequest("GET", "/v1/next-to-go", nil), mux, racingClient, sportsClient, category, limit)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !sameRaceOrEvents(got, want) {
+					t.Errorf("%s: category %q limit %d: got %v, want %v", step.name, category, limit, raceOrEventKeys(got), raceOrEventKeys(want))
+				}
+			}
+		}
+	}
+}
+
+func sameRaceOrEvents(a, b []raceOrEvent) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !proto.Equal(a[i].Race, b[i].Race) || !proto.Equal(a[i].Event, b[i].Event) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func raceOrEventKeys(entries []raceOrEvent) []string {
+	keys := make([]string, len(entries))
+	for i, entry := range entries {
+		if entry.Race != nil {
+			keys[i] = fmt.Sprintf("race %d", entry.id())
+		} else {
+			keys[i] = fmt.Sprintf("%s event %d", entry.Event.GetSport(), entry.id())
+		}
+	}
+
+	return keys
+}
+
+// fakeRacingClient serves races from memory, streaming a change whenever one
+// is put or deleted.
+type fakeRacingClient struct {
+	racing.RacingClient
+
+	mu      sync.Mutex
+	races   map[int64]*racing.Race
+	seq     int64
+	changes chan *racing.Change
+}
+
+func newFakeRacingClient(races ...*racing.Race) *fakeRacingClient {
+	c := &fakeRacingClient{
+		races:   make(map[int64]*racing.Race),
+		changes: make(chan *racing.Change, 1),
+	}
+	for _, race := range races {
+		c.races[race.GetId()] = race
+	}
+
+	return c
+}
+
+func (c *fakeRacingClient) Put(race *racing.Race) {
+	c.mu.Lock()
+	c.races[race.GetId()] = race
+	c.mu.Unlock()
+
+	c.changed(race.GetId())
+}
+
+func (c *fakeRacingClient) Delete(id int64) {
+	c.mu.Lock()
+	delete(c.races, id)
+	c.mu.Unlock()
+
+	c.changed(id)
+}
+
+func (c *fakeRacingClient) changed(id int64) {
+	c.mu.Lock()
+	c.seq++
+	change := &racing.Change{Seq: c.seq, RaceId: id, ChangedAt: timestamppb.Now()}
+	c.mu.Unlock()
+
+	c.changes <- change
+}
+
+func (c *fakeRacingClient) ListRaces(ctx context.Context, in *racing.ListRacesRequest, opts ...grpc.CallOption) (*racing.ListRacesResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	filter := in.GetFilter()
+
+	var races []*racing.Race
+	for _, race := range c.races {
+		if filter.Visible != nil && race.GetVisible() != filter.GetVisible() {
+			continue
+		}
+		if after := filter.GetAdvertisedStartAfter(); after != nil && !race.GetAdvertisedStartTime().AsTime().After(after.AsTime()) {
+			continue
+		}
+		races = append(races, race)
+	}
+
+	sort.Slice(races, func(i, j int) bool {
+		a, b := races[i].GetAdvertisedStartTime().AsTime(), races[j].GetAdvertisedStartTime().AsTime()
+		if !a.Equal(b) {
+			return a.Before(b)
+		}
+		return races[i].GetId() < races[j].GetId()
+	})
+
+	if size := int(in.GetPageSize()); size > 0 && len(races) > size {
+		races = races[:size]
+	}
+
+	return &racing.ListRacesResponse{Races: races}, nil
+}
+
+func (c *fakeRacingClient) GetRace(ctx context.Context, in *racing.GetRaceRequest, opts ...grpc.CallOption) (*racing.Race, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	race, ok := c.races[in.GetId()]
+	if !ok {
+		return nil, errorNotFound("race", in.GetId())
+	}
+
+	return race, nil
+}
+
+func (c *fakeRacingClient) ListChanges(ctx context.Context, in *racing.ListChangesRequest, opts ...grpc.CallOption) (*racing.ListChangesResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return &racing.ListChangesResponse{LastSeq: c.seq}, nil
+}
+
+func (c *fakeRacingClient) WatchChanges(ctx context.Context, in *racing.WatchChangesRequest, opts ...grpc.CallOption) (racing.Racing_WatchChangesClient, error) {
+	return &fakeRaceChanges{ctx: ctx, changes: c.changes}, nil
+}
+
+type fakeRaceChanges struct {
+	grpc.ClientStream
+
+	ctx     context.Context
+	changes <-chan *racing.Change
+}
+
+func (s *fakeRaceChanges) Recv() (*racing.Change, error) {
+	select {
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	case change := <-s.changes:
+		return change, nil
+	}
+}
+
+// fakeSportsClient serves events from memory like fakeRacingClient.
+type fakeSportsClient struct {
+	sports.SportsClient
+
+	mu      sync.Mutex
+	events  map[int64]*sports.Event
+	seq     int64
+	changes chan *sports.Change
+}
+
+func newFakeSportsClient(events ...*sports.Event) *fakeSportsClient {
+	c := &fakeSportsClient{
+		events:  make(map[int64]*sports.Event),
+		changes: make(chan *sports.Change, 1),
+	}
+	for _, event := range events {
+		c.events[event.GetId()] = event
+	}
+
+	return c
+}
+
+func (c *fakeSportsClient) Get(id int64) *sports.Event {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.events[id]
+}
+
+func (c *fakeSportsClient) Put(event *sports.Event) {
+	c.mu.Lock()
+	c.events[event.GetId()] = event
+	c.mu.Unlock()
+
+	c.changed(event.GetId())
+}
+
+func (c *fakeSportsClient) Delete(id int64) {
+	c.mu.Lock()
+	delete(c.events, id)
+	c.mu.Unlock()
+
+	c.changed(id)
+}
+
+func (c *fakeSportsClient) changed(id int64) {
+	c.mu.Lock()
+	c.seq++
+	change := &sports.Change{Seq: c.seq, EventId: id, ChangedAt: timestamppb.Now()}
+	c.mu.Unlock()
+
+	c.changes <- change
+}
+
+func (c *fakeSportsClient) ListEvents(ctx context.Context, in *sports.ListEventsRequest, opts ...grpc.CallOption) (*sports.ListEventsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	filter := in.GetFilter()
+
+	var events []*sports.Event
+	for _, event := range c.events {
+		if filter.Visible != nil && event.GetVisible() != filter.GetVisible() {
+			continue
+		}
+		if after := filter.GetAdvertisedStartAfter(); after != nil && !event.GetAdvertisedStartTime().AsTime().After(after.AsTime()) {
+			continue
+		}
+		if len(filter.GetSports()) > 0 && !containsString(filter.GetSports(), event.GetSport()) {
+			continue
+		}
+		events = append(events, event)
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		a, b := events[i].GetAdvertisedStartTime().AsTime(), events[j].GetAdvertisedStartTime().AsTime()
+		if !a.Equal(b) {
+			return a.Before(b)
+		}
+		return events[i].GetId() < events[j].GetId()
+	})
+
+	if size := int(in.GetPageSize()); size > 0 && len(events) > size {
+		events = events[:size]
+	}
+
+	return &sports.ListEventsResponse{Events: events}, nil
+}
+
+func (c *fakeSportsClient) GetEvent(ctx context.Context, in *sports.GetEventRequest, opts ...grpc.CallOption) (*sports.Event, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	event, ok := c.events[in.GetId()]
+	if !ok {
+		return nil, errorNotFound("event", in.GetId())
+	}
+
+	return event, nil
+}
+
+func (c *fakeSportsClient) ListChanges(ctx context.Context, in *sports.ListChangesRequest, opts ...grpc.CallOption) (*sports.ListChangesResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return &sports.ListChangesResponse{LastSeq: c.seq}, nil
+}
+
+func (c *fakeSportsClient) WatchChanges(ctx context.Context, in *sports.WatchChangesRequest, opts ...grpc.CallOption) (sports.Sports_WatchChangesClient, error) {
+	return &fakeEventChanges{ctx: ctx, changes: c.changes}, nil
+}
+
+type fakeEventChanges struct {
+	grpc.ClientStream
+
+	ctx     context.Context
+	changes <-chan *sports.Change
+}
+
+func (s *fakeEventChanges) Recv() (*sports.Change, error) {
+	select {
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	case change := <-s.changes:
+		return change, nil
+	}
+}
+
+func errorNotFound(resource string, id int64) error {
+	return status.Errorf(codes.NotFound, "%s %d not found", resource, id)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"git.neds.sh/matty/entain/api/proto/racing"
+	"git.neds.sh/matty/entain/api/proto/sports"
+	"git.neds.sh/matty/entain/pkg/errorreason"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// nextToGoStreamInterval is how often a next to go stream is checked for
+	// changes besides those of the cache, as when it is listed from the
+	// services.
+	nextToGoStreamInterval = 5 * time.Second
+	// nextToGoKeepAlive is how long a next to go stream goes quiet before a
+	// comment is sent, so proxies don't time it out.
+	nextToGoKeepAlive = 30 * time.Second
+)
+
+// Names of the Server-Sent Events of a next to go stream.
+const (
+	sseNextToGo = "next-to-go"
+	sseError    = "error"
+)
+
+// newNextToGoStreamHandler streams next to go as Server-Sent Events, sending
+// the races and events starting next, as /v1/next-to-go serves them, first
+// and then whenever they change. Changes are sent as the cache applies them,
+// or found by checking every nextToGoStreamInterval while next to go is
+// listed from the services. A failure to list them is sent as an error event
+// and retried. The cache can be nil.
+func newNextToGoStreamHandler(mux *runtime.ServeMux, racingClient racing.RacingClient, sportsClient sports.SportsClient, cache *nextToGoCache) func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+
+		query := r.URL.Query()
+		category := query.Get(nextToGoCategoryParam)
+
+		limit, err := parseNextToGoLimit(query.Get(nextToGoLimitParam))
+		if err != nil {
+			writeStatus(w, errorreason.InvalidValue.Status(err.Error(), nil))
+			return
+		}
+
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			writeStatus(w, errorreason.InvalidValue.Status("streaming is not supported", nil))
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		ticker := time.NewTicker(nextToGoStreamInterval)
+		defer ticker.Stop()
+
+		var (
+			last     []byte
+			lastSent = time.Now()
+		)
+
+		for {
+			// The channel is taken before reading the cache so no change
+			// made in between is missed.
+			rebuilt := cache.Rebuilt()
+
+			data, err := nextToGoData(r, mux, outbound, racingClient, sportsClient, cache, category, limit)
+
+			sent := true
+			switch {
+			case err != nil:
+				st, _ := json.Marshal(status.Convert(err).Proto())
+				writeSSE(w, sseError, st)
+				last = nil
+			case !bytes.Equal(data, last):
+				writeSSE(w, sseNextToGo, data)
+				last = data
+			case time.Since(lastSent) >= nextToGoKeepAlive:
+				fmt.Fprint(w, ": keep-alive\n\n")
+			default:
+				sent = false
+			}
+
+			if sent {
+				flusher.Flush()
+				lastSent = time.Now()
+			}
+
+			select {
+			case <-r.Context().Done():
+				return
+			case <-rebuilt:
+			case <-ticker.C:
+			}
+		}
+	}
+}
+
+// nextToGoData is next to go as the JSON data of an event, from the cache or
+// else listed from the services.
+func nextToGoData(r *http.Request, mux *runtime.ServeMux, marshaler runtime.Marshaler, racingClient racing.RacingClient, sportsClient sports.SportsClient, cache *nextToGoCache, category string, limit int) ([]byte, error) {
+	entries, ok := cache.Next(category, limit)
+	if !ok {
+		var err error
+		if entries, err = listNextToGo(r, mux, racingClient, sportsClient, category, limit); err != nil {
+			return nil, err
+		}
+	}
+
+	response, err := marshalRaceOrEvents(r.Context(), marshaler, entries)
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshalling compacts the items, so the data is a single line.
+	return json.Marshal(response)
+}
+
+func writeSSE(w http.ResponseWriter, event string, data []byte) {
+	fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data)
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	// profileHeader echoes the profile applied to the response.
+	profileHeader = "X-Profile"
+	// sparseMIME selects the marshaler which omits unpopulated fields, so
+	// fields removed by a profile are left out of the response entirely.
+	sparseMIME = "application/x-sparse+json"
+)
+
+type profileContextKey struct{}
+
+// Profiles shape the responses of classes of clients, e.g. mobile clients
+// get fewer fields and smaller pages.
+type Profiles struct {
+	Profiles []*Profile `json:"profiles"`
+
+	byClient map[string]*Profile
+}
+
+// Profile is the response shape for its clients.
+type Profile struct {
+	Name string `json:"name"`
+	// Clients are the clients authenticated by withAuth the profile
+	// applies to, by the client of their API key or the subject of their
+	// bearer token.
+	Clients []string `json:"clients"`
+	// Fields are the fields returned, by message full name e.g.
+	// sports.Event. Messages not listed return every field.
+	Fields map[string][]string `json:"fields"`
+	// MaxPageSize caps the page_size of list requests, unset is uncapped.
+	MaxPageSize int32 `json:"max_page_size"`
+	// TimeFormat is how timestamps are rendered, rfc3339 unless set.
+	TimeFormat string `json:"time_format"`
+	// Envelope wraps responses with the request ID, timing and pagination,
+	// see withEnvelope.
+	Envelope bool `json:"envelope"`
+
+	fields map[protoreflect.FullName]map[protoreflect.Name]bool
+}
+
+// loadProfiles reads profiles from a JSON file, checking the fields exist.
+func loadProfiles(path string) (*Profiles, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles Profiles
+	if err := json.Unmarshal(data, &profiles); err != nil {
+		return nil, fmt.Errorf("invalid profiles file %s: %w", path, err)
+	}
+
+	profiles.byClient = make(map[string]*Profile)
+
+	for _, profile := range profiles.Profiles {
+		if err := profile.resolveFields(); err != nil {
+			return nil, fmt.Errorf("invalid profile %s: %w", profile.Name, err)
+		}
+
+		if err := checkTimeFormat(profile.TimeFormat); err != nil {
+			return nil, fmt.Errorf("invalid profile %s: %w", profile.Name, err)
+		}
+
+		for _, client := range profile.Clients {
+			if _, ok := profiles.byClient[client]; ok {
+				return nil, fmt.Errorf("client %s of profile %s is in another profile", client, profile.Name)
+			}
+			profiles.byClient[client] = profile
+		}
+	}
+
+	return &profiles, nil
+}
+
+func (p *Profile) resolveFields() error {
+	p.fields = make(map[protoreflect.FullName]map[protoreflect.Name]bool)
+
+	for messageName, fieldNames := range p.Fields {
+		messageType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(messageName))
+		if err != nil {
+			return fmt.Errorf("unknown message %s", messageName)
+		}
+
+		descriptor := messageType.Descriptor()
+		allowed := make(map[protoreflect.Name]bool)
+
+		for _, fieldName := range fieldNames {
+			if descriptor.Fields().ByName(protoreflect.Name(fieldName)) == nil {
+				return fmt.Errorf("unknown field %s of %s", fieldName, messageName)
+			}
+			allowed[protoreflect.Name(fieldName)] = true
+		}
+
+		p.fields[descriptor.FullName()] = allowed
+	}
+
+	return nil
+}
+
+// withProfiles selects the profile of requests by the client authenticated by
+// withAuth, which must run first. Anonymous requests and clients without a
+// profile are not shaped.
+func withProfiles(next http.Handler, profiles *Profiles) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		client, ok := r.Context().Value(identityContextKey{}).(*identity)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		profile, ok := profiles.byClient[client.Client]
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set(profileHeader, profile.Name)
+
+		if len(profile.fields) > 0 {
+			r.Header.Set("Accept", sparseMIME)
+		}
+
+		ctx := context.WithValue(r.Context(), profileContextKey{}, profile)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func profileFromContext(ctx context.Context) (*Profile, bool) {
+	profile, ok := ctx.Value(profileContextKey{}).(*Profile)
+	return profile, ok
+}
+
+// sparseMarshaler is the gateway's default marshaler without emitting
+// unpopulated fields.
+func sparseMarshaler() runtime.ServeMuxOption {
+	return runtime.WithMarshalerOption(sparseMIME, jsonMarshaler(false))
+}
+
+// jsonMarshaler is configured as the gateway's default marshaler, optionally
+// emitting unpopulated fields.
+func jsonMarshaler(emitUnpopulated bool) runtime.Marshaler {
+	return &runtime.HTTPBodyMarshaler{
+		Marshaler: &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				EmitUnpopulated: emitUnpopulated,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		},
+	}
+}
+
+// shapeResponse removes the fields of a response not in the profile of the
+// request.
+func shapeResponse(ctx context.Context, _ http.ResponseWriter, resp proto.Message) error {
+	profile, ok := profileFromContext(ctx)
+	if !ok || len(profile.fields) == 0 {
+		return nil
+	}
+
+	prune(resp.ProtoReflect(), profile.fields)
+
+	return nil
+}
+
+// prune clears the fields of msg, and any messages within it, which aren't
+// allowed.
+func prune(msg protoreflect.Message, fields map[protoreflect.FullName]map[protoreflect.Name]bool) {
+	allowed, shaped := fields[msg.Descriptor().FullName()]
+
+	var cleared []protoreflect.FieldDescriptor
+
+	msg.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case shaped && !allowed[fd.Name()]:
+			cleared = append(cleared, fd)
+		case fd.IsList() && fd.Message() != nil:
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				prune(list.Get(i).Message(), fields)
+			}
+		case fd.Message() != nil && !fd.IsMap():
+			prune(value.Message(), fields)
+		}
+		return true
+	})
+
+	for _, fd := range cleared {
+		msg.Clear(fd)
+	}
+}
+
+// capPageSize limits the page_size of list requests to the maximum of the
+// profile of the request, unpaged requests get a page of the maximum size.
+func capPageSize(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	profile, ok := profileFromContext(ctx)
+	if ok && profile.MaxPageSize > 0 {
+		if msg, ok := req.(proto.Message); ok {
+			request := msg.ProtoReflect()
+			if fd := request.Descriptor().Fields().ByName("page_size"); fd != nil && fd.Kind() == protoreflect.Int32Kind {
+				if size := int32(request.Get(fd).Int()); size <= 0 || size > profile.MaxPageSize {
+					request.Set(fd, protoreflect.ValueOfInt32(profile.MaxPageSize))
+				}
+			}
+		}
+	}
+
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
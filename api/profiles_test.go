@@ -0,0 +1,55 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestProfileSelectedByClient(t *testing.T) {
+	profiles, err := loadProfiles("config/profiles.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys := writeKeyStore(t, map[string]APIKey{
+		"mobile-key":  {Client: "mobile-app"},
+		"unknown-key": {Client: "no-profile"},
+	})
+
+	handler := withAuth(withProfiles(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}), profiles), keys, nil)
+
+	tests := []struct {
+		name    string
+		key     string
+		code    int
+		profile string
+	}{
+		{name: "anonymous", code: http.StatusOK},
+		{name: "client with profile", key: "mobile-key", code: http.StatusOK, profile: "mobile"},
+		{name: "client without profile", key: "unknown-key", code: http.StatusOK},
+		// Profiles aren't selected by the key itself.
+		{name: "key of no client", key: "mobile-app", code: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/v1/events", nil)
+			if tt.key != "" {
+				r.Header.Set(apiKeyHeader, tt.key)
+			}
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, r)
+
+			if w.Code != tt.code {
+				t.Errorf("status = %d, want %d", w.Code, tt.code)
+			}
+			if got := w.Header().Get(profileHeader); got != tt.profile {
+				t.Errorf("profile = %q, want %q", got, tt.profile)
+			}
+		})
+	}
+}
@@ -1,3 +1,15 @@
+// Package proto holds the api copies of the racing and sports protos. The
+// code is generated by the gen module at the root of the repository, run go
+// generate from there.
 package proto
 
-//go:generate protoc -I . --go_out . --go_opt paths=source_relative --go-grpc_out . --go-grpc_opt paths=source_relative --grpc-gateway_out . --grpc-gateway_opt paths=source_relative racing/racing.proto sports/sports.proto
+import (
+	// Required for go:embed.
+	_ "embed"
+)
+
+// Descriptors is a serialised FileDescriptorSet of the API protos including
+// their comments, which are stripped from the generated code.
+//
+//go:embed descriptors.pb
+var Descriptors []byte
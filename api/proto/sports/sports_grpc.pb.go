@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.2.0
-// - protoc             v3.19.1
+// - protoc             v3.13.0
 // source: sports/sports.proto
 
 package sports
@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -26,6 +27,83 @@ type SportsClient interface {
 	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
 	// GetEvent will return an event by id.
 	GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*Event, error)
+	// CreateEvent will create a new event.
+	CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*Event, error)
+	// UpdateEvent will replace an existing event.
+	UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*Event, error)
+	// PatchEvent will update only the given fields of an existing event.
+	PatchEvent(ctx context.Context, in *PatchEventRequest, opts ...grpc.CallOption) (*Event, error)
+	// DeleteEvent will delete an event by id.
+	DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SetEventResult will record the result of an event, settling it.
+	SetEventResult(ctx context.Context, in *SetEventResultRequest, opts ...grpc.CallOption) (*Event, error)
+	// GetEventResult will return the result of a settled event.
+	GetEventResult(ctx context.Context, in *GetEventResultRequest, opts ...grpc.CallOption) (*EventResult, error)
+	// UpdateScore will set the current score of an event in play.
+	UpdateScore(ctx context.Context, in *UpdateScoreRequest, opts ...grpc.CallOption) (*Event, error)
+	// CheckSchema will verify the database schema against the columns the
+	// service requires.
+	CheckSchema(ctx context.Context, in *CheckSchemaRequest, opts ...grpc.CallOption) (*CheckSchemaResponse, error)
+	// WatchEvents will stream events matching the filter as their status,
+	// visibility, score or result changes.
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (Sports_WatchEventsClient, error)
+	// ListParlayRules will return the rules deciding multi eligibility.
+	ListParlayRules(ctx context.Context, in *ListParlayRulesRequest, opts ...grpc.CallOption) (*ListParlayRulesResponse, error)
+	// SetParlayRules will replace the rules deciding multi eligibility.
+	SetParlayRules(ctx context.Context, in *SetParlayRulesRequest, opts ...grpc.CallOption) (*ListParlayRulesResponse, error)
+	// ListFeatureFlags will return the feature flags of the service.
+	ListFeatureFlags(ctx context.Context, in *ListFeatureFlagsRequest, opts ...grpc.CallOption) (*ListFeatureFlagsResponse, error)
+	// SetFeatureFlag will turn a feature flag of the service on or off.
+	SetFeatureFlag(ctx context.Context, in *SetFeatureFlagRequest, opts ...grpc.CallOption) (*FeatureFlag, error)
+	// ListLogSampling will return the fraction of requests logged by method.
+	ListLogSampling(ctx context.Context, in *ListLogSamplingRequest, opts ...grpc.CallOption) (*ListLogSamplingResponse, error)
+	// SetLogSampling will change the fraction of requests to a method which
+	// are logged.
+	SetLogSampling(ctx context.Context, in *SetLogSamplingRequest, opts ...grpc.CallOption) (*LogSamplingRule, error)
+	// ListChanges will return the changes to events after a sequence number,
+	// oldest first.
+	ListChanges(ctx context.Context, in *ListChangesRequest, opts ...grpc.CallOption) (*ListChangesResponse, error)
+	// WatchChanges will stream changes to events after a sequence number as they
+	// are made.
+	WatchChanges(ctx context.Context, in *WatchChangesRequest, opts ...grpc.CallOption) (Sports_WatchChangesClient, error)
+	// ListPayloadLogging will return the methods whose request payloads are
+	// logged.
+	ListPayloadLogging(ctx context.Context, in *ListPayloadLoggingRequest, opts ...grpc.CallOption) (*ListPayloadLoggingResponse, error)
+	// SetPayloadLogging will start or stop logging the request payloads of a
+	// method.
+	SetPayloadLogging(ctx context.Context, in *SetPayloadLoggingRequest, opts ...grpc.CallOption) (*PayloadLoggingRule, error)
+	// SearchEvents will return the events whose name, or the name of either
+	// side, contains the query.
+	SearchEvents(ctx context.Context, in *SearchEventsRequest, opts ...grpc.CallOption) (*SearchEventsResponse, error)
+	// ListLeagues will return the leagues, by name.
+	ListLeagues(ctx context.Context, in *ListLeaguesRequest, opts ...grpc.CallOption) (*ListLeaguesResponse, error)
+	// GetLeague will return a league by id.
+	GetLeague(ctx context.Context, in *GetLeagueRequest, opts ...grpc.CallOption) (*League, error)
+	// GetOperationalSummary will return counts of the events and the recent
+	// request and error rates of the service, for operations dashboards.
+	GetOperationalSummary(ctx context.Context, in *GetOperationalSummaryRequest, opts ...grpc.CallOption) (*OperationalSummary, error)
+	// ListRecentRequests will return the last requests completed by the
+	// service, newest first, to inspect recent traffic.
+	ListRecentRequests(ctx context.Context, in *ListRecentRequestsRequest, opts ...grpc.CallOption) (*ListRecentRequestsResponse, error)
+	// ListSports will return the sports with events and how many each has.
+	ListSports(ctx context.Context, in *ListSportsRequest, opts ...grpc.CallOption) (*ListSportsResponse, error)
+	// ListTeams will return the teams and players playing events, by name.
+	ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error)
+	// GetTeam will return a team by id.
+	GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*Team, error)
+	// ListTeamAliases will return the aliases of teams matched by the sides
+	// filter and searches, by team.
+	ListTeamAliases(ctx context.Context, in *ListTeamAliasesRequest, opts ...grpc.CallOption) (*ListTeamAliasesResponse, error)
+	// SetTeamAliases will replace the aliases of a team.
+	SetTeamAliases(ctx context.Context, in *SetTeamAliasesRequest, opts ...grpc.CallOption) (*TeamAliases, error)
+	// GetSportPriority will return the order sports are listed in by default.
+	GetSportPriority(ctx context.Context, in *GetSportPriorityRequest, opts ...grpc.CallOption) (*SportPriority, error)
+	// SetSportPriority will replace the order sports are listed in by default.
+	SetSportPriority(ctx context.Context, in *SetSportPriorityRequest, opts ...grpc.CallOption) (*SportPriority, error)
+	// GetMarkets will return the markets of an event with their prices.
+	GetMarkets(ctx context.Context, in *GetMarketsRequest, opts ...grpc.CallOption) (*GetMarketsResponse, error)
+	// UpdatePrice will set the head to head price of a side of an event.
+	UpdatePrice(ctx context.Context, in *UpdatePriceRequest, opts ...grpc.CallOption) (*Price, error)
 }
 
 type sportsClient struct {
@@ -54,6 +132,349 @@ func (c *sportsClient) GetEvent(ctx context.Context, in *GetEventRequest, opts .
 	return out, nil
 }
 
+func (c *sportsClient) CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	out := new(Event)
+	err := c.cc.Invoke(ctx, "/sports.Sports/CreateEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	out := new(Event)
+	err := c.cc.Invoke(ctx, "/sports.Sports/UpdateEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) PatchEvent(ctx context.Context, in *PatchEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	out := new(Event)
+	err := c.cc.Invoke(ctx, "/sports.Sports/PatchEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/sports.Sports/DeleteEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) SetEventResult(ctx context.Context, in *SetEventResultRequest, opts ...grpc.CallOption) (*Event, error) {
+	out := new(Event)
+	err := c.cc.Invoke(ctx, "/sports.Sports/SetEventResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) GetEventResult(ctx context.Context, in *GetEventResultRequest, opts ...grpc.CallOption) (*EventResult, error) {
+	out := new(EventResult)
+	err := c.cc.Invoke(ctx, "/sports.Sports/GetEventResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) UpdateScore(ctx context.Context, in *UpdateScoreRequest, opts ...grpc.CallOption) (*Event, error) {
+	out := new(Event)
+	err := c.cc.Invoke(ctx, "/sports.Sports/UpdateScore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) CheckSchema(ctx context.Context, in *CheckSchemaRequest, opts ...grpc.CallOption) (*CheckSchemaResponse, error) {
+	out := new(CheckSchemaResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/CheckSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (Sports_WatchEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Sports_ServiceDesc.Streams[0], "/sports.Sports/WatchEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sportsWatchEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Sports_WatchEventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type sportsWatchEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *sportsWatchEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sportsClient) ListParlayRules(ctx context.Context, in *ListParlayRulesRequest, opts ...grpc.CallOption) (*ListParlayRulesResponse, error) {
+	out := new(ListParlayRulesResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/ListParlayRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) SetParlayRules(ctx context.Context, in *SetParlayRulesRequest, opts ...grpc.CallOption) (*ListParlayRulesResponse, error) {
+	out := new(ListParlayRulesResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/SetParlayRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) ListFeatureFlags(ctx context.Context, in *ListFeatureFlagsRequest, opts ...grpc.CallOption) (*ListFeatureFlagsResponse, error) {
+	out := new(ListFeatureFlagsResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/ListFeatureFlags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) SetFeatureFlag(ctx context.Context, in *SetFeatureFlagRequest, opts ...grpc.CallOption) (*FeatureFlag, error) {
+	out := new(FeatureFlag)
+	err := c.cc.Invoke(ctx, "/sports.Sports/SetFeatureFlag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) ListLogSampling(ctx context.Context, in *ListLogSamplingRequest, opts ...grpc.CallOption) (*ListLogSamplingResponse, error) {
+	out := new(ListLogSamplingResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/ListLogSampling", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) SetLogSampling(ctx context.Context, in *SetLogSamplingRequest, opts ...grpc.CallOption) (*LogSamplingRule, error) {
+	out := new(LogSamplingRule)
+	err := c.cc.Invoke(ctx, "/sports.Sports/SetLogSampling", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) ListChanges(ctx context.Context, in *ListChangesRequest, opts ...grpc.CallOption) (*ListChangesResponse, error) {
+	out := new(ListChangesResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/ListChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) WatchChanges(ctx context.Context, in *WatchChangesRequest, opts ...grpc.CallOption) (Sports_WatchChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Sports_ServiceDesc.Streams[1], "/sports.Sports/WatchChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sportsWatchChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Sports_WatchChangesClient interface {
+	Recv() (*Change, error)
+	grpc.ClientStream
+}
+
+type sportsWatchChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *sportsWatchChangesClient) Recv() (*Change, error) {
+	m := new(Change)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sportsClient) ListPayloadLogging(ctx context.Context, in *ListPayloadLoggingRequest, opts ...grpc.CallOption) (*ListPayloadLoggingResponse, error) {
+	out := new(ListPayloadLoggingResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/ListPayloadLogging", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) SetPayloadLogging(ctx context.Context, in *SetPayloadLoggingRequest, opts ...grpc.CallOption) (*PayloadLoggingRule, error) {
+	out := new(PayloadLoggingRule)
+	err := c.cc.Invoke(ctx, "/sports.Sports/SetPayloadLogging", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) SearchEvents(ctx context.Context, in *SearchEventsRequest, opts ...grpc.CallOption) (*SearchEventsResponse, error) {
+	out := new(SearchEventsResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/SearchEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) ListLeagues(ctx context.Context, in *ListLeaguesRequest, opts ...grpc.CallOption) (*ListLeaguesResponse, error) {
+	out := new(ListLeaguesResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/ListLeagues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) GetLeague(ctx context.Context, in *GetLeagueRequest, opts ...grpc.CallOption) (*League, error) {
+	out := new(League)
+	err := c.cc.Invoke(ctx, "/sports.Sports/GetLeague", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) GetOperationalSummary(ctx context.Context, in *GetOperationalSummaryRequest, opts ...grpc.CallOption) (*OperationalSummary, error) {
+	out := new(OperationalSummary)
+	err := c.cc.Invoke(ctx, "/sports.Sports/GetOperationalSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) ListRecentRequests(ctx context.Context, in *ListRecentRequestsRequest, opts ...grpc.CallOption) (*ListRecentRequestsResponse, error) {
+	out := new(ListRecentRequestsResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/ListRecentRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) ListSports(ctx context.Context, in *ListSportsRequest, opts ...grpc.CallOption) (*ListSportsResponse, error) {
+	out := new(ListSportsResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/ListSports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error) {
+	out := new(ListTeamsResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/ListTeams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*Team, error) {
+	out := new(Team)
+	err := c.cc.Invoke(ctx, "/sports.Sports/GetTeam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) ListTeamAliases(ctx context.Context, in *ListTeamAliasesRequest, opts ...grpc.CallOption) (*ListTeamAliasesResponse, error) {
+	out := new(ListTeamAliasesResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/ListTeamAliases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) SetTeamAliases(ctx context.Context, in *SetTeamAliasesRequest, opts ...grpc.CallOption) (*TeamAliases, error) {
+	out := new(TeamAliases)
+	err := c.cc.Invoke(ctx, "/sports.Sports/SetTeamAliases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) GetSportPriority(ctx context.Context, in *GetSportPriorityRequest, opts ...grpc.CallOption) (*SportPriority, error) {
+	out := new(SportPriority)
+	err := c.cc.Invoke(ctx, "/sports.Sports/GetSportPriority", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) SetSportPriority(ctx context.Context, in *SetSportPriorityRequest, opts ...grpc.CallOption) (*SportPriority, error) {
+	out := new(SportPriority)
+	err := c.cc.Invoke(ctx, "/sports.Sports/SetSportPriority", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) GetMarkets(ctx context.Context, in *GetMarketsRequest, opts ...grpc.CallOption) (*GetMarketsResponse, error) {
+	out := new(GetMarketsResponse)
+	err := c.cc.Invoke(ctx, "/sports.Sports/GetMarkets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsClient) UpdatePrice(ctx context.Context, in *UpdatePriceRequest, opts ...grpc.CallOption) (*Price, error) {
+	out := new(Price)
+	err := c.cc.Invoke(ctx, "/sports.Sports/UpdatePrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SportsServer is the server API for Sports service.
 // All implementations must embed UnimplementedSportsServer
 // for forward compatibility
@@ -62,64 +483,840 @@ type SportsServer interface {
 	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
 	// GetEvent will return an event by id.
 	GetEvent(context.Context, *GetEventRequest) (*Event, error)
+	// CreateEvent will create a new event.
+	CreateEvent(context.Context, *CreateEventRequest) (*Event, error)
+	// UpdateEvent will replace an existing event.
+	UpdateEvent(context.Context, *UpdateEventRequest) (*Event, error)
+	// PatchEvent will update only the given fields of an existing event.
+	PatchEvent(context.Context, *PatchEventRequest) (*Event, error)
+	// DeleteEvent will delete an event by id.
+	DeleteEvent(context.Context, *DeleteEventRequest) (*emptypb.Empty, error)
+	// SetEventResult will record the result of an event, settling it.
+	SetEventResult(context.Context, *SetEventResultRequest) (*Event, error)
+	// GetEventResult will return the result of a settled event.
+	GetEventResult(context.Context, *GetEventResultRequest) (*EventResult, error)
+	// UpdateScore will set the current score of an event in play.
+	UpdateScore(context.Context, *UpdateScoreRequest) (*Event, error)
+	// CheckSchema will verify the database schema against the columns the
+	// service requires.
+	CheckSchema(context.Context, *CheckSchemaRequest) (*CheckSchemaResponse, error)
+	// WatchEvents will stream events matching the filter as their status,
+	// visibility, score or result changes.
+	WatchEvents(*WatchEventsRequest, Sports_WatchEventsServer) error
+	// ListParlayRules will return the rules deciding multi eligibility.
+	ListParlayRules(context.Context, *ListParlayRulesRequest) (*ListParlayRulesResponse, error)
+	// SetParlayRules will replace the rules deciding multi eligibility.
+	SetParlayRules(context.Context, *SetParlayRulesRequest) (*ListParlayRulesResponse, error)
+	// ListFeatureFlags will return the feature flags of the service.
+	ListFeatureFlags(context.Context, *ListFeatureFlagsRequest) (*ListFeatureFlagsResponse, error)
+	// SetFeatureFlag will turn a feature flag of the service on or off.
+	SetFeatureFlag(context.Context, *SetFeatureFlagRequest) (*FeatureFlag, error)
+	// ListLogSampling will return the fraction of requests logged by method.
+	ListLogSampling(context.Context, *ListLogSamplingRequest) (*ListLogSamplingResponse, error)
+	// SetLogSampling will change the fraction of requests to a method which
+	// are logged.
+	SetLogSampling(context.Context, *SetLogSamplingRequest) (*LogSamplingRule, error)
+	// ListChanges will return the changes to events after a sequence number,
+	// oldest first.
+	ListChanges(context.Context, *ListChangesRequest) (*ListChangesResponse, error)
+	// WatchChanges will stream changes to events after a sequence number as they
+	// are made.
+	WatchChanges(*WatchChangesRequest, Sports_WatchChangesServer) error
+	// ListPayloadLogging will return the methods whose request payloads are
+	// logged.
+	ListPayloadLogging(context.Context, *ListPayloadLoggingRequest) (*ListPayloadLoggingResponse, error)
+	// SetPayloadLogging will start or stop logging the request payloads of a
+	// method.
+	SetPayloadLogging(context.Context, *SetPayloadLoggingRequest) (*PayloadLoggingRule, error)
+	// SearchEvents will return the events whose name, or the name of either
+	// side, contains the query.
+	SearchEvents(context.Context, *SearchEventsRequest) (*SearchEventsResponse, error)
+	// ListLeagues will return the leagues, by name.
+	ListLeagues(context.Context, *ListLeaguesRequest) (*ListLeaguesResponse, error)
+	// GetLeague will return a league by id.
+	GetLeague(context.Context, *GetLeagueRequest) (*League, error)
+	// GetOperationalSummary will return counts of the events and the recent
+	// request and error rates of the service, for operations dashboards.
+	GetOperationalSummary(context.Context, *GetOperationalSummaryRequest) (*OperationalSummary, error)
+	// ListRecentRequests will return the last requests completed by the
+	// service, newest first, to inspect recent traffic.
+	ListRecentRequests(context.Context, *ListRecentRequestsRequest) (*ListRecentRequestsResponse, error)
+	// ListSports will return the sports with events and how many each has.
+	ListSports(context.Context, *ListSportsRequest) (*ListSportsResponse, error)
+	// ListTeams will return the teams and players playing events, by name.
+	ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error)
+	// GetTeam will return a team by id.
+	GetTeam(context.Context, *GetTeamRequest) (*Team, error)
+	// ListTeamAliases will return the aliases of teams matched by the sides
+	// filter and searches, by team.
+	ListTeamAliases(context.Context, *ListTeamAliasesRequest) (*ListTeamAliasesResponse, error)
+	// SetTeamAliases will replace the aliases of a team.
+	SetTeamAliases(context.Context, *SetTeamAliasesRequest) (*TeamAliases, error)
+	// GetSportPriority will return the order sports are listed in by default.
+	GetSportPriority(context.Context, *GetSportPriorityRequest) (*SportPriority, error)
+	// SetSportPriority will replace the order sports are listed in by default.
+	SetSportPriority(context.Context, *SetSportPriorityRequest) (*SportPriority, error)
+	// GetMarkets will return the markets of an event with their prices.
+	GetMarkets(context.Context, *GetMarketsRequest) (*GetMarketsResponse, error)
+	// UpdatePrice will set the head to head price of a side of an event.
+	UpdatePrice(context.Context, *UpdatePriceRequest) (*Price, error)
 	mustEmbedUnimplementedSportsServer()
 }
 
-// UnimplementedSportsServer must be embedded to have forward compatible implementations.
-type UnimplementedSportsServer struct {
+// UnimplementedSportsServer must be embedded to have forward compatible implementations.
+type UnimplementedSportsServer struct {
+}
+
+func (UnimplementedSportsServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
+}
+func (UnimplementedSportsServer) GetEvent(context.Context, *GetEventRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvent not implemented")
+}
+func (UnimplementedSportsServer) CreateEvent(context.Context, *CreateEventRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEvent not implemented")
+}
+func (UnimplementedSportsServer) UpdateEvent(context.Context, *UpdateEventRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEvent not implemented")
+}
+func (UnimplementedSportsServer) PatchEvent(context.Context, *PatchEventRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchEvent not implemented")
+}
+func (UnimplementedSportsServer) DeleteEvent(context.Context, *DeleteEventRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEvent not implemented")
+}
+func (UnimplementedSportsServer) SetEventResult(context.Context, *SetEventResultRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEventResult not implemented")
+}
+func (UnimplementedSportsServer) GetEventResult(context.Context, *GetEventResultRequest) (*EventResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventResult not implemented")
+}
+func (UnimplementedSportsServer) UpdateScore(context.Context, *UpdateScoreRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScore not implemented")
+}
+func (UnimplementedSportsServer) CheckSchema(context.Context, *CheckSchemaRequest) (*CheckSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckSchema not implemented")
+}
+func (UnimplementedSportsServer) WatchEvents(*WatchEventsRequest, Sports_WatchEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
+func (UnimplementedSportsServer) ListParlayRules(context.Context, *ListParlayRulesRequest) (*ListParlayRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListParlayRules not implemented")
+}
+func (UnimplementedSportsServer) SetParlayRules(context.Context, *SetParlayRulesRequest) (*ListParlayRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetParlayRules not implemented")
+}
+func (UnimplementedSportsServer) ListFeatureFlags(context.Context, *ListFeatureFlagsRequest) (*ListFeatureFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeatureFlags not implemented")
+}
+func (UnimplementedSportsServer) SetFeatureFlag(context.Context, *SetFeatureFlagRequest) (*FeatureFlag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeatureFlag not implemented")
+}
+func (UnimplementedSportsServer) ListLogSampling(context.Context, *ListLogSamplingRequest) (*ListLogSamplingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLogSampling not implemented")
+}
+func (UnimplementedSportsServer) SetLogSampling(context.Context, *SetLogSamplingRequest) (*LogSamplingRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogSampling not implemented")
+}
+func (UnimplementedSportsServer) ListChanges(context.Context, *ListChangesRequest) (*ListChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChanges not implemented")
+}
+func (UnimplementedSportsServer) WatchChanges(*WatchChangesRequest, Sports_WatchChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchChanges not implemented")
+}
+func (UnimplementedSportsServer) ListPayloadLogging(context.Context, *ListPayloadLoggingRequest) (*ListPayloadLoggingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPayloadLogging not implemented")
+}
+func (UnimplementedSportsServer) SetPayloadLogging(context.Context, *SetPayloadLoggingRequest) (*PayloadLoggingRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPayloadLogging not implemented")
+}
+func (UnimplementedSportsServer) SearchEvents(context.Context, *SearchEventsRequest) (*SearchEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchEvents not implemented")
+}
+func (UnimplementedSportsServer) ListLeagues(context.Context, *ListLeaguesRequest) (*ListLeaguesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLeagues not implemented")
+}
+func (UnimplementedSportsServer) GetLeague(context.Context, *GetLeagueRequest) (*League, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeague not implemented")
+}
+func (UnimplementedSportsServer) GetOperationalSummary(context.Context, *GetOperationalSummaryRequest) (*OperationalSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperationalSummary not implemented")
+}
+func (UnimplementedSportsServer) ListRecentRequests(context.Context, *ListRecentRequestsRequest) (*ListRecentRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecentRequests not implemented")
+}
+func (UnimplementedSportsServer) ListSports(context.Context, *ListSportsRequest) (*ListSportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSports not implemented")
+}
+func (UnimplementedSportsServer) ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTeams not implemented")
+}
+func (UnimplementedSportsServer) GetTeam(context.Context, *GetTeamRequest) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeam not implemented")
+}
+func (UnimplementedSportsServer) ListTeamAliases(context.Context, *ListTeamAliasesRequest) (*ListTeamAliasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTeamAliases not implemented")
+}
+func (UnimplementedSportsServer) SetTeamAliases(context.Context, *SetTeamAliasesRequest) (*TeamAliases, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTeamAliases not implemented")
+}
+func (UnimplementedSportsServer) GetSportPriority(context.Context, *GetSportPriorityRequest) (*SportPriority, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSportPriority not implemented")
+}
+func (UnimplementedSportsServer) SetSportPriority(context.Context, *SetSportPriorityRequest) (*SportPriority, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSportPriority not implemented")
+}
+func (UnimplementedSportsServer) GetMarkets(context.Context, *GetMarketsRequest) (*GetMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarkets not implemented")
+}
+func (UnimplementedSportsServer) UpdatePrice(context.Context, *UpdatePriceRequest) (*Price, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePrice not implemented")
+}
+func (UnimplementedSportsServer) mustEmbedUnimplementedSportsServer() {}
+
+// UnsafeSportsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SportsServer will
+// result in compilation errors.
+type UnsafeSportsServer interface {
+	mustEmbedUnimplementedSportsServer()
+}
+
+func RegisterSportsServer(s grpc.ServiceRegistrar, srv SportsServer) {
+	s.RegisterService(&Sports_ServiceDesc, srv)
+}
+
+func _Sports_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/ListEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_GetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).GetEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/GetEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).GetEvent(ctx, req.(*GetEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_CreateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).CreateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/CreateEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).CreateEvent(ctx, req.(*CreateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_UpdateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).UpdateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/UpdateEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).UpdateEvent(ctx, req.(*UpdateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_PatchEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).PatchEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/PatchEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).PatchEvent(ctx, req.(*PatchEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_DeleteEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).DeleteEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/DeleteEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).DeleteEvent(ctx, req.(*DeleteEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_SetEventResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEventResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).SetEventResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/SetEventResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).SetEventResult(ctx, req.(*SetEventResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_GetEventResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).GetEventResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/GetEventResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).GetEventResult(ctx, req.(*GetEventResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_UpdateScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).UpdateScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/UpdateScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).UpdateScore(ctx, req.(*UpdateScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_CheckSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).CheckSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/CheckSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).CheckSchema(ctx, req.(*CheckSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SportsServer).WatchEvents(m, &sportsWatchEventsServer{stream})
+}
+
+type Sports_WatchEventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type sportsWatchEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *sportsWatchEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Sports_ListParlayRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListParlayRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).ListParlayRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/ListParlayRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).ListParlayRules(ctx, req.(*ListParlayRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_SetParlayRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetParlayRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).SetParlayRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/SetParlayRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).SetParlayRules(ctx, req.(*SetParlayRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_ListFeatureFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeatureFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).ListFeatureFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/ListFeatureFlags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).ListFeatureFlags(ctx, req.(*ListFeatureFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_SetFeatureFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFeatureFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).SetFeatureFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/SetFeatureFlag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).SetFeatureFlag(ctx, req.(*SetFeatureFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_ListLogSampling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLogSamplingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).ListLogSampling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/ListLogSampling",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).ListLogSampling(ctx, req.(*ListLogSamplingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_SetLogSampling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogSamplingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).SetLogSampling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/SetLogSampling",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).SetLogSampling(ctx, req.(*SetLogSamplingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_ListChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).ListChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/ListChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).ListChanges(ctx, req.(*ListChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_WatchChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SportsServer).WatchChanges(m, &sportsWatchChangesServer{stream})
+}
+
+type Sports_WatchChangesServer interface {
+	Send(*Change) error
+	grpc.ServerStream
+}
+
+type sportsWatchChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *sportsWatchChangesServer) Send(m *Change) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Sports_ListPayloadLogging_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPayloadLoggingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).ListPayloadLogging(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/ListPayloadLogging",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).ListPayloadLogging(ctx, req.(*ListPayloadLoggingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_SetPayloadLogging_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPayloadLoggingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).SetPayloadLogging(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/SetPayloadLogging",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).SetPayloadLogging(ctx, req.(*SetPayloadLoggingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedSportsServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
+func _Sports_SearchEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).SearchEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/SearchEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).SearchEvents(ctx, req.(*SearchEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSportsServer) GetEvent(context.Context, *GetEventRequest) (*Event, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEvent not implemented")
+
+func _Sports_ListLeagues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLeaguesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).ListLeagues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/ListLeagues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).ListLeagues(ctx, req.(*ListLeaguesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSportsServer) mustEmbedUnimplementedSportsServer() {}
 
-// UnsafeSportsServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to SportsServer will
-// result in compilation errors.
-type UnsafeSportsServer interface {
-	mustEmbedUnimplementedSportsServer()
+func _Sports_GetLeague_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeagueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).GetLeague(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/GetLeague",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).GetLeague(ctx, req.(*GetLeagueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterSportsServer(s grpc.ServiceRegistrar, srv SportsServer) {
-	s.RegisterService(&Sports_ServiceDesc, srv)
+func _Sports_GetOperationalSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperationalSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).GetOperationalSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/GetOperationalSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).GetOperationalSummary(ctx, req.(*GetOperationalSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Sports_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListEventsRequest)
+func _Sports_ListRecentRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecentRequestsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SportsServer).ListEvents(ctx, in)
+		return srv.(SportsServer).ListRecentRequests(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sports.Sports/ListEvents",
+		FullMethod: "/sports.Sports/ListRecentRequests",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SportsServer).ListEvents(ctx, req.(*ListEventsRequest))
+		return srv.(SportsServer).ListRecentRequests(ctx, req.(*ListRecentRequestsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Sports_GetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEventRequest)
+func _Sports_ListSports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSportsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SportsServer).GetEvent(ctx, in)
+		return srv.(SportsServer).ListSports(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sports.Sports/GetEvent",
+		FullMethod: "/sports.Sports/ListSports",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SportsServer).GetEvent(ctx, req.(*GetEventRequest))
+		return srv.(SportsServer).ListSports(ctx, req.(*ListSportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_ListTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).ListTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/ListTeams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).ListTeams(ctx, req.(*ListTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_GetTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).GetTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/GetTeam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).GetTeam(ctx, req.(*GetTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_ListTeamAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTeamAliasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).ListTeamAliases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/ListTeamAliases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).ListTeamAliases(ctx, req.(*ListTeamAliasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_SetTeamAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTeamAliasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).SetTeamAliases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/SetTeamAliases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).SetTeamAliases(ctx, req.(*SetTeamAliasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_GetSportPriority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSportPriorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).GetSportPriority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/GetSportPriority",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).GetSportPriority(ctx, req.(*GetSportPriorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_SetSportPriority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSportPriorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).SetSportPriority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/SetSportPriority",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).SetSportPriority(ctx, req.(*SetSportPriorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_GetMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).GetMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/GetMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).GetMarkets(ctx, req.(*GetMarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sports_UpdatePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsServer).UpdatePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sports.Sports/UpdatePrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsServer).UpdatePrice(ctx, req.(*UpdatePriceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -139,7 +1336,142 @@ var Sports_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEvent",
 			Handler:    _Sports_GetEvent_Handler,
 		},
+		{
+			MethodName: "CreateEvent",
+			Handler:    _Sports_CreateEvent_Handler,
+		},
+		{
+			MethodName: "UpdateEvent",
+			Handler:    _Sports_UpdateEvent_Handler,
+		},
+		{
+			MethodName: "PatchEvent",
+			Handler:    _Sports_PatchEvent_Handler,
+		},
+		{
+			MethodName: "DeleteEvent",
+			Handler:    _Sports_DeleteEvent_Handler,
+		},
+		{
+			MethodName: "SetEventResult",
+			Handler:    _Sports_SetEventResult_Handler,
+		},
+		{
+			MethodName: "GetEventResult",
+			Handler:    _Sports_GetEventResult_Handler,
+		},
+		{
+			MethodName: "UpdateScore",
+			Handler:    _Sports_UpdateScore_Handler,
+		},
+		{
+			MethodName: "CheckSchema",
+			Handler:    _Sports_CheckSchema_Handler,
+		},
+		{
+			MethodName: "ListParlayRules",
+			Handler:    _Sports_ListParlayRules_Handler,
+		},
+		{
+			MethodName: "SetParlayRules",
+			Handler:    _Sports_SetParlayRules_Handler,
+		},
+		{
+			MethodName: "ListFeatureFlags",
+			Handler:    _Sports_ListFeatureFlags_Handler,
+		},
+		{
+			MethodName: "SetFeatureFlag",
+			Handler:    _Sports_SetFeatureFlag_Handler,
+		},
+		{
+			MethodName: "ListLogSampling",
+			Handler:    _Sports_ListLogSampling_Handler,
+		},
+		{
+			MethodName: "SetLogSampling",
+			Handler:    _Sports_SetLogSampling_Handler,
+		},
+		{
+			MethodName: "ListChanges",
+			Handler:    _Sports_ListChanges_Handler,
+		},
+		{
+			MethodName: "ListPayloadLogging",
+			Handler:    _Sports_ListPayloadLogging_Handler,
+		},
+		{
+			MethodName: "SetPayloadLogging",
+			Handler:    _Sports_SetPayloadLogging_Handler,
+		},
+		{
+			MethodName: "SearchEvents",
+			Handler:    _Sports_SearchEvents_Handler,
+		},
+		{
+			MethodName: "ListLeagues",
+			Handler:    _Sports_ListLeagues_Handler,
+		},
+		{
+			MethodName: "GetLeague",
+			Handler:    _Sports_GetLeague_Handler,
+		},
+		{
+			MethodName: "GetOperationalSummary",
+			Handler:    _Sports_GetOperationalSummary_Handler,
+		},
+		{
+			MethodName: "ListRecentRequests",
+			Handler:    _Sports_ListRecentRequests_Handler,
+		},
+		{
+			MethodName: "ListSports",
+			Handler:    _Sports_ListSports_Handler,
+		},
+		{
+			MethodName: "ListTeams",
+			Handler:    _Sports_ListTeams_Handler,
+		},
+		{
+			MethodName: "GetTeam",
+			Handler:    _Sports_GetTeam_Handler,
+		},
+		{
+			MethodName: "ListTeamAliases",
+			Handler:    _Sports_ListTeamAliases_Handler,
+		},
+		{
+			MethodName: "SetTeamAliases",
+			Handler:    _Sports_SetTeamAliases_Handler,
+		},
+		{
+			MethodName: "GetSportPriority",
+			Handler:    _Sports_GetSportPriority_Handler,
+		},
+		{
+			MethodName: "SetSportPriority",
+			Handler:    _Sports_SetSportPriority_Handler,
+		},
+		{
+			MethodName: "GetMarkets",
+			Handler:    _Sports_GetMarkets_Handler,
+		},
+		{
+			MethodName: "UpdatePrice",
+			Handler:    _Sports_UpdatePrice_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _Sports_WatchEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchChanges",
+			Handler:       _Sports_WatchChanges_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "sports/sports.proto",
 }
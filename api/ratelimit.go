@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"git.neds.sh/matty/entain/pkg/errorreason"
+	"git.neds.sh/matty/entain/pkg/ratelimit"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// forwardedForMetadataKey carries the addresses a request was forwarded for,
+// the gateway appends the address it was called from.
+const forwardedForMetadataKey = "x-forwarded-for"
+
+// newUnaryRateLimitInterceptor refuses requests over the limit of their client
+// for the method with ResourceExhausted before they reach the backend,
+// detailing when to retry. A nil limiter doesn't limit.
+func newUnaryRateLimitInterceptor(limiter *ratelimit.Limiter) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if err := rateLimit(ctx, limiter, method); err != nil {
+			return err
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// newStreamRateLimitInterceptor limits how often streams are opened, like
+// newUnaryRateLimitInterceptor.
+func newStreamRateLimitInterceptor(limiter *ratelimit.Limiter) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if err := rateLimit(ctx, limiter, method); err != nil {
+			return nil, err
+		}
+
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
+func rateLimit(ctx context.Context, limiter *ratelimit.Limiter, method string) error {
+	if limiter == nil {
+		return nil
+	}
+
+	// Calls the gateway makes itself, such as those keeping the next to go
+	// cache up to date, have no client and aren't limited.
+	client := rateLimitClient(ctx)
+	if client == "" {
+		return nil
+	}
+
+	retryAfter, ok := limiter.Allow(method, client)
+	if ok {
+		return nil
+	}
+
+	message := fmt.Sprintf("rate limit exceeded, retry in %s", retryAfter.Round(time.Millisecond))
+
+	return errorreason.RateLimited.Error(message, nil, &errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryAfter),
+	})
+}
+
+// rateLimitClient identifies the client of a request for rate limiting, by the
+// identity authenticated by its API key or bearer token, or else the address
+// the gateway was called from. Earlier forwarded addresses are given by the
+// caller so aren't trusted. It is empty for calls not made for a request.
+func rateLimitClient(ctx context.Context) string {
+	if client, ok := ctx.Value(identityContextKey{}).(*identity); ok {
+		return "client:" + client.Client
+	}
+
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		if values := md.Get(forwardedForMetadataKey); len(values) > 0 {
+			addrs := strings.Split(values[len(values)-1], ",")
+			return "ip:" + strings.TrimSpace(addrs[len(addrs)-1])
+		}
+	}
+
+	return ""
+}
+
+// setRetryAfter sets the Retry-After header of errors detailing when to
+// retry, such as those of rate limits in the gateway or services, in whole
+// seconds rounded up.
+func setRetryAfter(w http.ResponseWriter, err error) {
+	if st, ok := status.FromError(err); ok {
+		for _, detail := range st.Details() {
+			if info, ok := detail.(*errdetails.RetryInfo); ok {
+				seconds := math.Ceil(info.GetRetryDelay().AsDuration().Seconds())
+				w.Header().Set("Retry-After", strconv.Itoa(int(seconds)))
+			}
+		}
+	}
+}
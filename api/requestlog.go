@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"git.neds.sh/matty/entain/pkg/logging"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+)
+
+// withRequestLog tags each request with an ID, given in the X-Request-Id
+// header or generated, which is echoed in the response and passed to the
+// services by requestIDMetadata so their lines for the request can be found
+// with it. Each request is logged once it has been served, with its baggage.
+func withRequestLog(next http.Handler, logger *zap.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(logging.RequestIDHeader)
+		if !logging.ValidRequestID(id) {
+			id = logging.NewRequestID()
+		}
+		w.Header().Set(logging.RequestIDHeader, id)
+
+		ctx := logging.ContextWithRequestID(r.Context(), id)
+
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r.WithContext(ctx))
+
+		logging.ForRequest(ctx, logger).Info("handled request",
+			zap.String("http_method", r.Method),
+			zap.String("path", r.URL.Path),
+			zap.Int("status", recorder.status),
+			zap.Duration("latency", time.Since(start)),
+		)
+	})
+}
+
+// requestIDMetadata forwards the ID of a request to the services as gRPC
+// metadata.
+func requestIDMetadata(ctx context.Context, r *http.Request) metadata.MD {
+	id, ok := logging.RequestIDFromContext(r.Context())
+	if !ok {
+		return nil
+	}
+
+	return metadata.Pairs(logging.RequestIDKey, id)
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"git.neds.sh/matty/entain/api/proto/racing"
+	"git.neds.sh/matty/entain/api/proto/sports"
+	"git.neds.sh/matty/entain/pkg/errorreason"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// searchQueryParam is the text searched for.
+	searchQueryParam = "q"
+	// searchLimitParam is how many races and events to return.
+	searchLimitParam = "limit"
+
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+	maxSearchQueryLen  = 256
+)
+
+// newSearchHandler serves the visible races and sports events whose names, or
+// the names of their sides, contain the query, by advertised start time. Both
+// services are searched in parallel, and each result has either a race or an
+// event.
+func newSearchHandler(mux *runtime.ServeMux, racingClient racing.RacingClient, sportsClient sports.SportsClient) func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+
+		query := r.URL.Query()
+
+		q := query.Get(searchQueryParam)
+		if q == "" || len(q) > maxSearchQueryLen {
+			writeStatus(w, errorreason.InvalidValue.Status(fmt.Sprintf("q must be between 1 and %d characters", maxSearchQueryLen), nil))
+			return
+		}
+
+		limit := defaultSearchLimit
+		if value := query.Get(searchLimitParam); value != "" {
+			parsed, err := strconv.Atoi(value)
+			if err != nil || parsed < 1 || parsed > maxSearchLimit {
+				writeStatus(w, errorreason.InvalidValue.Status(fmt.Sprintf("limit must be between 1 and %d", maxSearchLimit), nil))
+				return
+			}
+			limit = parsed
+		}
+
+		group, ctx := errgroup.WithContext(r.Context())
+		visible := true
+
+		var (
+			races  []*racing.Race
+			events []*sports.Event
+		)
+
+		group.Go(func() error {
+			callCtx, err := runtime.AnnotateContext(ctx, mux, r, "/racing.Racing/SearchRaces")
+			if err != nil {
+				return err
+			}
+
+			response, err := racingClient.SearchRaces(callCtx, &racing.SearchRacesRequest{Query: q, Visible: &visible, Limit: int32(limit)})
+			if err != nil {
+				return err
+			}
+
+			races = response.GetRaces()
+			return nil
+		})
+
+		group.Go(func() error {
+			callCtx, err := runtime.AnnotateContext(ctx, mux, r, "/sports.Sports/SearchEvents")
+			if err != nil {
+				return err
+			}
+
+			response, err := sportsClient.SearchEvents(callCtx, &sports.SearchEventsRequest{Query: q, Visible: &visible, Limit: int32(limit)})
+			if err != nil {
+				return err
+			}
+
+			events = response.GetEvents()
+			return nil
+		})
+
+		if err := group.Wait(); err != nil {
+			runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+			return
+		}
+
+		writeRaceOrEvents(w, r, mux, outbound, mergeByStartTime(races, events, limit))
+	}
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"git.neds.sh/matty/entain/api/epochmillis"
+	"git.neds.sh/matty/entain/api/proto/racing"
+	"git.neds.sh/matty/entain/api/proto/sports"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// updateSnapshots rewrites the golden files instead of comparing against
+// them, after an intended change to the JSON of responses:
+//
+//	go test -run TestSnapshots -update
+var updateSnapshots = flag.Bool("update", false, "Rewrite the golden files of TestSnapshots instead of comparing")
+
+const snapshotDir = "testdata/snapshots"
+
+// snapshots are the representative responses checked. Empty and zero values
+// are included deliberately as the gateway emits unpopulated fields.
+var snapshots = map[string]proto.Message{
+	"list_events.json": &sports.ListEventsResponse{
+		Events: []*sports.Event{
+			{
+				Id:                  1,
+				Sport:               "football",
+				League:              3,
+				HomeSideName:        "Adelaide Crows",
+				AwaySideName:        "Brisbane Lions",
+				Name:                "Adelaide Crows vs Brisbane Lions",
+				Visible:             true,
+				AdvertisedStartTime: snapshotTimestamp("2022-01-02T03:04:05Z"),
+				Status:              "OPEN",
+			},
+			{
+				Id:                  2,
+				Sport:               "tennis",
+				HomeSideName:        "Ash Barty",
+				AwaySideName:        "Naomi Osaka",
+				Name:                "Ash Barty vs Naomi Osaka",
+				AdvertisedStartTime: snapshotTimestamp("2021-12-31T23:59:59.5Z"),
+				Status:              "CLOSED",
+			},
+		},
+		NextPageToken: "Mg",
+		TotalSize:     3,
+	},
+	"list_events_empty.json": &sports.ListEventsResponse{},
+	"get_event.json": &sports.Event{
+		Id:                  1,
+		Sport:               "hockey",
+		League:              21,
+		HomeSideName:        "Melbourne Ice",
+		AwaySideName:        "Sydney Bears",
+		Name:                "Melbourne Ice vs Sydney Bears",
+		Visible:             true,
+		AdvertisedStartTime: snapshotTimestamp("2022-01-02T03:04:05Z"),
+		Status:              "OPEN",
+	},
+	"list_races.json": &racing.ListRacesResponse{
+		Races: []*racing.Race{
+			{
+				Id:                  1,
+				MeetingId:           5,
+				Name:                "North Dakota foes",
+				Number:              3,
+				Visible:             true,
+				AdvertisedStartTime: snapshotTimestamp("2022-01-02T03:04:05Z"),
+				Status:              "OPEN",
+			},
+			{
+				Id:        2,
+				MeetingId: 5,
+				Name:      "Missing start time",
+				Status:    "CLOSED",
+			},
+		},
+		TotalSize: 2,
+	},
+}
+
+// epochMillisSnapshots maps golden files of responses rendered with
+// timestamps as epoch millis to the snapshot rendered.
+var epochMillisSnapshots = map[string]string{
+	"list_events_epoch_millis.json": "list_events.json",
+	"list_races_epoch_millis.json":  "list_races.json",
+}
+
+// TestSnapshots checks the JSON rendered by the gateway for representative
+// responses against golden files, so any change affecting serialisation is
+// reviewed deliberately.
+func TestSnapshots(t *testing.T) {
+	// Use the marshaler the gateway would pick for a request.
+	_, marshaler := runtime.MarshalerForRequest(runtime.NewServeMux(), httptest.NewRequest("GET", "/", nil))
+	epochMillisMarshaler := &epochmillis.Marshaler{Marshaler: marshaler}
+
+	for name, msg := range snapshots {
+		checkSnapshot(t, name, marshaler, msg)
+	}
+
+	for name, source := range epochMillisSnapshots {
+		checkSnapshot(t, name, epochMillisMarshaler, snapshots[source])
+	}
+}
+
+func checkSnapshot(t *testing.T, name string, marshaler runtime.Marshaler, msg proto.Message) {
+	t.Run(name, func(t *testing.T) {
+		got, err := renderSnapshot(marshaler, msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		path := filepath.Join(snapshotDir, name)
+
+		if *updateSnapshots {
+			if err := ioutil.WriteFile(path, got, 0644); err != nil {
+				t.Fatal(err)
+			}
+			return
+		}
+
+		want, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(got, want) {
+			t.Errorf("differs from golden file, re-run with -update if the change is intended:\n--- want\n%s--- got\n%s", want, got)
+		}
+	})
+}
+
+// renderSnapshot marshals a message and normalises the whitespace, protojson
+// output is deliberately unstable between builds.
+func renderSnapshot(marshaler runtime.Marshaler, msg proto.Message) ([]byte, error) {
+	raw, err := marshaler.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	if err := json.Indent(&out, raw, "", "  "); err != nil {
+		return nil, err
+	}
+	out.WriteByte('\n')
+
+	return out.Bytes(), nil
+}
+
+func snapshotTimestamp(value string) *timestamppb.Timestamp {
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		panic(err)
+	}
+	return timestamppb.New(t)
+}
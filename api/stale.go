@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/proto"
+)
+
+// staleAgeMetadataKey is the header the services give the age in seconds of a
+// stale response with, served in place of an error.
+const staleAgeMetadataKey = "x-stale-age"
+
+// staleWarning flags stale responses with a Warning header, as in RFC 7234,
+// and their Age, so clients can tell them apart from fresh ones.
+func staleWarning(ctx context.Context, w http.ResponseWriter, _ proto.Message) error {
+	md, ok := runtime.ServerMetadataFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	if age := md.HeaderMD.Get(staleAgeMetadataKey); len(age) > 0 {
+		w.Header().Set("Warning", `110 - "Response is Stale"`)
+		w.Header().Set("Age", age[0])
+	}
+
+	return nil
+}
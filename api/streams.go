@@ -0,0 +1,45 @@
+package main
+
+import (
+	"io"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// endedClientStream calls onEnd once receiving from the stream fails, with nil
+// when it fails with io.EOF as the stream ended successfully. The gateway
+// waits for the headers before receiving, so a stream can also end there.
+type endedClientStream struct {
+	grpc.ClientStream
+
+	onEnd func(err error)
+	once  sync.Once
+}
+
+func (s *endedClientStream) Header() (metadata.MD, error) {
+	header, err := s.ClientStream.Header()
+	if err != nil {
+		s.end(err)
+	}
+
+	return header, err
+}
+
+func (s *endedClientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.end(err)
+	}
+
+	return err
+}
+
+func (s *endedClientStream) end(err error) {
+	if err == io.EOF {
+		err = nil
+	}
+
+	s.once.Do(func() { s.onEnd(err) })
+}
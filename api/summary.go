@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"git.neds.sh/matty/entain/api/proto/racing"
+	"git.neds.sh/matty/entain/api/proto/sports"
+	"git.neds.sh/matty/entain/pkg/metrics"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// serviceSummary is the operational summary of a service, or why it couldn't
+// be fetched.
+type serviceSummary struct {
+	Summary json.RawMessage `json:"summary,omitempty"`
+	Error   string          `json:"error,omitempty"`
+}
+
+// gatewaySummary is the recent requests the gateway made to the services, and
+// how many failed with a server error.
+type gatewaySummary struct {
+	Requests  int64   `json:"requests"`
+	Errors    int64   `json:"errors"`
+	ErrorRate float64 `json:"errorRate"`
+}
+
+// newSummaryHandler serves the operational summaries of both services along
+// with the recent requests of the gateway and the stats of the next to go
+// cache, for a dashboard needing no metrics server. A service which fails is
+// reported with its error rather than failing the whole summary. The cache
+// can be nil.
+func newSummaryHandler(mux *runtime.ServeMux, racingClient racing.RacingClient, sportsClient sports.SportsClient, rpcMetrics *metrics.RPC, cache *nextToGoCache) func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+
+		var (
+			wg                   sync.WaitGroup
+			racingSum, sportsSum serviceSummary
+		)
+
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			racingSum = fetchSummary(r, mux, outbound, "/racing.Racing/GetOperationalSummary", func(ctx context.Context) (proto.Message, error) {
+				return racingClient.GetOperationalSummary(ctx, &racing.GetOperationalSummaryRequest{})
+			})
+		}()
+		go func() {
+			defer wg.Done()
+			sportsSum = fetchSummary(r, mux, outbound, "/sports.Sports/GetOperationalSummary", func(ctx context.Context) (proto.Message, error) {
+				return sportsClient.GetOperationalSummary(ctx, &sports.GetOperationalSummaryRequest{})
+			})
+		}()
+		wg.Wait()
+
+		requests, errors := rpcMetrics.Recent("")
+		gateway := gatewaySummary{Requests: requests, Errors: errors}
+		if requests > 0 {
+			gateway.ErrorRate = float64(errors) / float64(requests)
+		}
+
+		response := struct {
+			WindowSeconds float64        `json:"windowSeconds"`
+			Gateway       gatewaySummary `json:"gateway"`
+			NextToGoCache *cacheStats    `json:"nextToGoCache,omitempty"`
+			Racing        serviceSummary `json:"racing"`
+			Sports        serviceSummary `json:"sports"`
+		}{
+			WindowSeconds: metrics.RecentSpan.Seconds(),
+			Gateway:       gateway,
+			Racing:        racingSum,
+			Sports:        sportsSum,
+		}
+
+		if cache != nil {
+			stats := cache.Stats()
+			response.NextToGoCache = &stats
+		}
+
+		writeJSON(w, http.StatusOK, response)
+	}
+}
+
+// fetchSummary calls a service for its summary with the metadata of the
+// request, marshalled as the mux would.
+func fetchSummary(r *http.Request, mux *runtime.ServeMux, marshaler runtime.Marshaler, method string, call func(ctx context.Context) (proto.Message, error)) serviceSummary {
+	ctx, err := runtime.AnnotateContext(r.Context(), mux, r, method)
+	if err != nil {
+		return serviceSummary{Error: err.Error()}
+	}
+
+	summary, err := call(ctx)
+	if err != nil {
+		return serviceSummary{Error: status.Convert(err).Message()}
+	}
+
+	data, err := marshaler.Marshal(summary)
+	if err != nil {
+		return serviceSummary{Error: err.Error()}
+	}
+
+	return serviceSummary{Summary: data}
+}
@@ -0,0 +1,111 @@
+// Package testutil builds the races and events the tests of the gateway serve,
+// e.g.
+//
+//	testutil.NewEvent(1).Sport("tennis").StartsIn(time.Hour).Build()
+//
+// The scaffolding shared with the services lives in pkg/testutil.
+package testutil
+
+import (
+	"fmt"
+	"time"
+
+	"git.neds.sh/matty/entain/api/proto/racing"
+	"git.neds.sh/matty/entain/api/proto/sports"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RaceBuilder builds a race, visible and starting in an hour unless set
+// otherwise.
+type RaceBuilder struct {
+	race *racing.Race
+}
+
+// NewRace starts building the race with the ID, named after it.
+func NewRace(id int64) *RaceBuilder {
+	return &RaceBuilder{race: &racing.Race{
+		Id:                  id,
+		Name:                fmt.Sprintf("Race %d", id),
+		Visible:             true,
+		AdvertisedStartTime: timestamppb.New(time.Now().Add(time.Hour)),
+	}}
+}
+
+func (b *RaceBuilder) Name(name string) *RaceBuilder {
+	b.race.Name = name
+	return b
+}
+
+func (b *RaceBuilder) MeetingID(meetingID int64) *RaceBuilder {
+	b.race.MeetingId = meetingID
+	return b
+}
+
+func (b *RaceBuilder) Hidden() *RaceBuilder {
+	b.race.Visible = false
+	return b
+}
+
+func (b *RaceBuilder) StartsAt(t time.Time) *RaceBuilder {
+	b.race.AdvertisedStartTime = timestamppb.New(t)
+	return b
+}
+
+// StartsIn starts the race d from now, in the past when negative.
+func (b *RaceBuilder) StartsIn(d time.Duration) *RaceBuilder {
+	return b.StartsAt(time.Now().Add(d))
+}
+
+// Build returns a copy of the race built, so the builder can go on to build
+// others from it.
+func (b *RaceBuilder) Build() *racing.Race {
+	return proto.Clone(b.race).(*racing.Race)
+}
+
+// EventBuilder builds an event, visible and starting in an hour unless set
+// otherwise.
+type EventBuilder struct {
+	event *sports.Event
+}
+
+// NewEvent starts building the event with the ID, named after it.
+func NewEvent(id int64) *EventBuilder {
+	return &EventBuilder{event: &sports.Event{
+		Id:                  id,
+		Name:                fmt.Sprintf("Event %d", id),
+		Visible:             true,
+		AdvertisedStartTime: timestamppb.New(time.Now().Add(time.Hour)),
+	}}
+}
+
+func (b *EventBuilder) Name(name string) *EventBuilder {
+	b.event.Name = name
+	return b
+}
+
+func (b *EventBuilder) Sport(sport string) *EventBuilder {
+	b.event.Sport = sport
+	return b
+}
+
+func (b *EventBuilder) Hidden() *EventBuilder {
+	b.event.Visible = false
+	return b
+}
+
+func (b *EventBuilder) StartsAt(t time.Time) *EventBuilder {
+	b.event.AdvertisedStartTime = timestamppb.New(t)
+	return b
+}
+
+// StartsIn starts the event d from now, in the past when negative.
+func (b *EventBuilder) StartsIn(d time.Duration) *EventBuilder {
+	return b.StartsAt(time.Now().Add(d))
+}
+
+// Build returns a copy of the event built, so the builder can go on to build
+// others from it.
+func (b *EventBuilder) Build() *sports.Event {
+	return proto.Clone(b.event).(*sports.Event)
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	"git.neds.sh/matty/entain/api/epochmillis"
+	"git.neds.sh/matty/entain/pkg/errorreason"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+const (
+	// timeFormatParam selects the time format of a response, overriding
+	// that of the client's profile.
+	timeFormatParam = "time_format"
+
+	timeFormatRFC3339     = "rfc3339"
+	timeFormatEpochMillis = "epoch_millis"
+
+	// epochMillisMIME and sparseEpochMillisMIME select the marshalers
+	// rendering timestamps as epoch millis, with and without unpopulated
+	// fields.
+	epochMillisMIME       = "application/x-epoch-millis+json"
+	sparseEpochMillisMIME = "application/x-sparse-epoch-millis+json"
+)
+
+func checkTimeFormat(format string) error {
+	switch format {
+	case "", timeFormatRFC3339, timeFormatEpochMillis:
+		return nil
+	}
+
+	return fmt.Errorf("unknown time format %q, expected %s or %s", format, timeFormatRFC3339, timeFormatEpochMillis)
+}
+
+// withTimeFormat selects the marshaler for the time format of the request,
+// given by the time_format query parameter or the profile of the client. It
+// must run after withProfiles.
+func withTimeFormat(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var format string
+		if profile, ok := profileFromContext(r.Context()); ok {
+			format = profile.TimeFormat
+		}
+
+		query := r.URL.Query()
+		if _, ok := query[timeFormatParam]; ok {
+			format = query.Get(timeFormatParam)
+
+			// The parameter isn't a field of any request.
+			query.Del(timeFormatParam)
+			r.URL.RawQuery = query.Encode()
+		}
+
+		if err := checkTimeFormat(format); err != nil {
+			writeStatus(w, errorreason.InvalidValue.Status(err.Error(), nil))
+			return
+		}
+
+		if format == timeFormatEpochMillis {
+			if r.Header.Get("Accept") == sparseMIME {
+				r.Header.Set("Accept", sparseEpochMillisMIME)
+			} else {
+				r.Header.Set("Accept", epochMillisMIME)
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// epochMillisMarshalers registers the marshalers selected by withTimeFormat.
+func epochMillisMarshalers() runtime.ServeMuxOption {
+	return func(mux *runtime.ServeMux) {
+		runtime.WithMarshalerOption(epochMillisMIME, &epochmillis.Marshaler{Marshaler: jsonMarshaler(true)})(mux)
+		runtime.WithMarshalerOption(sparseEpochMillisMIME, &epochmillis.Marshaler{Marshaler: jsonMarshaler(false)})(mux)
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"git.neds.sh/matty/entain/api/proto/racing"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TestTimeFormat checks the timestamps of responses are rendered as RFC 3339
+// or epoch millis as the time_format parameter asks.
+func TestTimeFormat(t *testing.T) {
+	mux := runtime.NewServeMux(sparseMarshaler(), epochMillisMarshalers())
+
+	race := &racing.Race{Id: 1, AdvertisedStartTime: timestamppb.New(time.Date(2022, 1, 2, 3, 4, 5, 500000000, time.UTC))}
+	err := mux.HandlePath("GET", "/v1/race/1", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+		runtime.ForwardResponseMessage(r.Context(), mux, outbound, w, r, race)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handler := withTimeFormat(mux)
+
+	tests := []struct {
+		name   string
+		target string
+		accept string
+		want   interface{}
+		// sparse is set when unpopulated fields are left out.
+		sparse bool
+	}{
+		{name: "default", target: "/v1/race/1", want: "2022-01-02T03:04:05.500Z"},
+		{name: "rfc3339", target: "/v1/race/1?time_format=rfc3339", want: "2022-01-02T03:04:05.500Z"},
+		{name: "epoch millis", target: "/v1/race/1?time_format=epoch_millis", want: json.Number("1641092645500")},
+		{name: "sparse epoch millis", target: "/v1/race/1?time_format=epoch_millis", accept: sparseMIME, want: json.Number("1641092645500"), sparse: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", tt.target, nil)
+			if tt.accept != "" {
+				r.Header.Set("Accept", tt.accept)
+			}
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, r)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("status = %d, want 200: %s", w.Code, w.Body)
+			}
+
+			var got map[string]interface{}
+			decoder := json.NewDecoder(w.Body)
+			decoder.UseNumber()
+			if err := decoder.Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+
+			if got["advertisedStartTime"] != tt.want {
+				t.Errorf("advertisedStartTime = %#v, want %#v", got["advertisedStartTime"], tt.want)
+			}
+			if _, ok := got["visible"]; ok == tt.sparse {
+				t.Errorf("visible rendered = %t, want %t", ok, !tt.sparse)
+			}
+		})
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("GET", "/v1/race/1?time_format=unix", nil))
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("status = %d, want 400", w.Code)
+		}
+	})
+}
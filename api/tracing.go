@@ -0,0 +1,126 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"path"
+	"strconv"
+
+	"git.neds.sh/matty/entain/pkg/tracing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// withTracing records a span for each request, continuing the trace of the
+// caller given in the traceparent header. The requests made to the backends
+// are recorded as its children.
+func withTracing(next http.Handler, tracer *tracing.Tracer) http.Handler {
+	if tracer == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		if remote, ok := tracing.ParseTraceparent(r.Header.Get(tracing.TraceparentKey)); ok {
+			ctx = tracing.ContextWithRemote(ctx, remote)
+		}
+
+		ctx, span := tracer.Start(ctx, r.Method+" "+r.URL.Path, tracing.KindServer)
+		span.SetAttribute("http.method", r.Method)
+		span.SetAttribute("http.target", r.URL.RequestURI())
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r.WithContext(ctx))
+
+		span.SetAttribute("http.status_code", strconv.Itoa(recorder.status))
+		if recorder.status >= http.StatusInternalServerError {
+			span.SetStatus(tracing.StatusError, http.StatusText(recorder.status))
+		}
+		span.End()
+	})
+}
+
+// statusRecorder records the status code written to a response. It flushes
+// like the response it wraps so streams are still delivered as they arrive.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack hands over the connection of the response, to upgrade it to a
+// WebSocket.
+func (r *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := r.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response can't be hijacked")
+	}
+
+	return hijacker.Hijack()
+}
+
+// newUnaryTracingInterceptor records a span for each unary request made to
+// the backends and propagates the trace to them in the traceparent metadata.
+func newUnaryTracingInterceptor(tracer *tracing.Tracer) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, span := startClientSpan(ctx, tracer, method)
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		endClientSpan(span, err)
+
+		return err
+	}
+}
+
+// newStreamTracingInterceptor records a span for each stream opened to the
+// backends until it ends, like newUnaryTracingInterceptor.
+func newStreamTracingInterceptor(tracer *tracing.Tracer) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, span := startClientSpan(ctx, tracer, method)
+
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			endClientSpan(span, err)
+			return nil, err
+		}
+
+		return &endedClientStream{ClientStream: stream, onEnd: func(err error) {
+			endClientSpan(span, err)
+		}}, nil
+	}
+}
+
+func startClientSpan(ctx context.Context, tracer *tracing.Tracer, method string) (context.Context, *tracing.Span) {
+	ctx, span := tracer.Start(ctx, method[1:], tracing.KindClient)
+	span.SetAttribute("rpc.system", "grpc")
+	span.SetAttribute("rpc.service", path.Dir(method)[1:])
+	span.SetAttribute("rpc.method", path.Base(method))
+
+	if sc, ok := tracing.SpanContextFromContext(ctx); ok {
+		ctx = metadata.AppendToOutgoingContext(ctx, tracing.TraceparentKey, tracing.FormatTraceparent(sc))
+	}
+
+	return ctx, span
+}
+
+func endClientSpan(span *tracing.Span, err error) {
+	span.SetAttribute("rpc.grpc.status_code", strconv.Itoa(int(status.Code(err))))
+	if err != nil {
+		span.SetStatus(tracing.StatusError, status.Convert(err).Message())
+	}
+
+	span.End()
+}
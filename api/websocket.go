@@ -0,0 +1,188 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"unicode/utf8"
+
+	"git.neds.sh/matty/entain/api/proto/racing"
+	"git.neds.sh/matty/entain/api/proto/sports"
+	"git.neds.sh/matty/entain/pkg/errorreason"
+	"git.neds.sh/matty/entain/pkg/featureflag"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"nhooyr.io/websocket"
+)
+
+const (
+	// streamWatchParam is what a WebSocket stream watches, races or events.
+	streamWatchParam = "watch"
+
+	streamWatchRaces  = "races"
+	streamWatchEvents = "events"
+
+	// maxCloseReasonLen is the most bytes the reason of a WebSocket close
+	// frame can have.
+	maxCloseReasonLen = 123
+)
+
+// watchRecv receives the next race or event of a watch.
+type watchRecv func() (proto.Message, error)
+
+// newStreamHandler upgrades to a WebSocket relaying the races or events of a
+// watch as they change, given by the watch query parameter, each as a JSON
+// text message. The other query parameters are the filter of the watch, as
+// for /v1/watch-races and /v1/watch-events, aliases included. The socket is
+// closed with the error of the watch when it ends.
+//
+// Browsers may open it from the origins allowed by the CORS policy, or the
+// gateway's own origin.
+func newStreamHandler(mux *runtime.ServeMux, racingClient racing.RacingClient, sportsClient sports.SportsClient, flags *featureflag.Flags, cors *corsPolicy) func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+
+		query := r.URL.Query()
+		watch := query.Get(streamWatchParam)
+		query.Del(streamWatchParam)
+
+		var (
+			method string
+			open   func(ctx context.Context) (watchRecv, error)
+		)
+
+		switch watch {
+		case streamWatchRaces:
+			in := &racing.WatchRacesRequest{}
+			if err := populateWatch(in, query, "/v1/watch-races", flags); err != nil {
+				writeStatus(w, errorreason.InvalidValue.Status(err.Error(), nil))
+				return
+			}
+
+			method = "/racing.Racing/WatchRaces"
+			open = func(ctx context.Context) (watchRecv, error) {
+				stream, err := racingClient.WatchRaces(ctx, in)
+				if err != nil {
+					return nil, err
+				}
+				return func() (proto.Message, error) { return stream.Recv() }, nil
+			}
+		case streamWatchEvents:
+			in := &sports.WatchEventsRequest{}
+			if err := populateWatch(in, query, "/v1/watch-events", flags); err != nil {
+				writeStatus(w, errorreason.InvalidValue.Status(err.Error(), nil))
+				return
+			}
+
+			method = "/sports.Sports/WatchEvents"
+			open = func(ctx context.Context) (watchRecv, error) {
+				stream, err := sportsClient.WatchEvents(ctx, in)
+				if err != nil {
+					return nil, err
+				}
+				return func() (proto.Message, error) { return stream.Recv() }, nil
+			}
+		default:
+			writeStatus(w, errorreason.InvalidValue.Status(fmt.Sprintf("watch must be %s or %s", streamWatchRaces, streamWatchEvents), nil))
+			return
+		}
+
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, method)
+		if err != nil {
+			writeStatus(w, status.Convert(err))
+			return
+		}
+
+		// The origin is checked by the CORS policy when there is one,
+		// otherwise it must be the gateway's own.
+		conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{
+			InsecureSkipVerify: cors != nil && cors.allows(r.Header.Get("Origin")),
+		})
+		if err != nil {
+			return
+		}
+		defer conn.Close(websocket.StatusInternalError, "")
+
+		// Nothing is read from clients, but their close frames end the
+		// watch.
+		ctx = conn.CloseRead(ctx)
+
+		recv, err := open(ctx)
+		if err != nil {
+			closeWithError(conn, err)
+			return
+		}
+
+		for {
+			msg, err := recv()
+			if err != nil {
+				if ctx.Err() == nil {
+					closeWithError(conn, err)
+				}
+				return
+			}
+
+			data, err := outbound.Marshal(msg)
+			if err != nil {
+				closeWithError(conn, err)
+				return
+			}
+
+			if err := conn.Write(ctx, websocket.MessageText, data); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// populateWatch sets the fields of a watch request from query parameters,
+// translating the aliases of the path.
+func populateWatch(in proto.Message, query url.Values, path string, flags *featureflag.Flags) error {
+	if flags.Enabled(flagQueryAliases) {
+		query = translateQuery(query, queryAliases[path])
+	}
+
+	return runtime.PopulateQueryParameters(in, query, utilities.NewDoubleArray(nil))
+}
+
+// closeWithError closes a WebSocket as a watch ended, normally when it ended
+// without an error, or with its status.
+func closeWithError(conn *websocket.Conn, err error) {
+	if errors.Is(err, io.EOF) {
+		conn.Close(websocket.StatusNormalClosure, "")
+		return
+	}
+
+	st := status.Convert(err)
+
+	closeStatus := websocket.StatusInternalError
+	switch st.Code() {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.PermissionDenied, codes.Unauthenticated, codes.Unimplemented:
+		closeStatus = websocket.StatusPolicyViolation
+	case codes.Unavailable, codes.Aborted, codes.ResourceExhausted:
+		closeStatus = websocket.StatusTryAgainLater
+	}
+
+	conn.Close(closeStatus, closeReason(st.Code().String()+": "+st.Message()))
+}
+
+// closeReason truncates a reason to fit in a close frame, on a rune boundary
+// as the reason must be valid UTF-8.
+func closeReason(reason string) string {
+	if len(reason) <= maxCloseReasonLen {
+		return reason
+	}
+
+	end := maxCloseReasonLen
+	for end > 0 && !utf8.RuneStart(reason[end]) {
+		end--
+	}
+
+	return reason[:end]
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestCloseReason(t *testing.T) {
+	tests := []struct {
+		name   string
+		reason string
+		want   string
+	}{
+		{name: "short", reason: "NotFound: race 1 not found", want: "NotFound: race 1 not found"},
+		{name: "exactly the limit", reason: strings.Repeat("a", maxCloseReasonLen), want: strings.Repeat("a", maxCloseReasonLen)},
+		{name: "ascii", reason: strings.Repeat("a", maxCloseReasonLen+10), want: strings.Repeat("a", maxCloseReasonLen)},
+		// é is two bytes, the limit falls in the middle of the last one.
+		{name: "split rune", reason: strings.Repeat("é", maxCloseReasonLen), want: strings.Repeat("é", maxCloseReasonLen/2)},
+		// 🏇 is four bytes, the limit falls after its first.
+		{name: "split emoji", reason: strings.Repeat("a", maxCloseReasonLen-1) + "🏇", want: strings.Repeat("a", maxCloseReasonLen-1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := closeReason(tt.reason)
+
+			if got != tt.want {
+				t.Errorf("closeReason = %q, want %q", got, tt.want)
+			}
+			if len(got) > maxCloseReasonLen || !utf8.ValidString(got) {
+				t.Errorf("closeReason = %q, want valid UTF-8 of at most %d bytes", got, maxCloseReasonLen)
+			}
+		})
+	}
+}
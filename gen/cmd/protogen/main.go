@@ -0,0 +1,193 @@
+// Command protogen regenerates the code for the racing, sports and api protos.
+// buf and the protoc plugins are built from the versions pinned in this
+// module's go.mod, so everyone generates the same code and protoc does not
+// need to be installed.
+//
+// Before generating, the protos are checked for changes breaking wire or JSON
+// compatibility with those at a git ref, per the buf.yaml of each directory.
+// Nothing is generated if there are any, pass -against= once a breaking
+// change has been agreed.
+//
+//	go run ./cmd/protogen -root .. -against origin/main
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var (
+	root    = flag.String("root", "..", "Root of the repository")
+	against = flag.String("against", "HEAD", "Git ref the protos are checked for breaking changes against, skipped when empty")
+)
+
+// tools are built into a temporary directory which is searched for plugins
+// before the PATH, so any plugins installed locally are never used.
+var tools = []string{
+	"github.com/bufbuild/buf/cmd/buf",
+	"github.com/envoyproxy/protoc-gen-validate",
+	"github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-grpc-gateway",
+	"github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2",
+	"google.golang.org/grpc/cmd/protoc-gen-go-grpc",
+	"google.golang.org/protobuf/cmd/protoc-gen-go",
+}
+
+// wellKnownTypes maps the well known types to their Go packages. The copies
+// bundled with buf still name the deprecated packages.
+var wellKnownTypes = map[string]string{
+	"google/protobuf/any.proto":        "google.golang.org/protobuf/types/known/anypb",
+	"google/protobuf/descriptor.proto": "google.golang.org/protobuf/types/descriptorpb",
+	"google/protobuf/duration.proto":   "google.golang.org/protobuf/types/known/durationpb",
+	"google/protobuf/empty.proto":      "google.golang.org/protobuf/types/known/emptypb",
+	"google/protobuf/field_mask.proto": "google.golang.org/protobuf/types/known/fieldmaskpb",
+	"google/protobuf/struct.proto":     "google.golang.org/protobuf/types/known/structpb",
+	"google/protobuf/timestamp.proto":  "google.golang.org/protobuf/types/known/timestamppb",
+	"google/protobuf/wrappers.proto":   "google.golang.org/protobuf/types/known/wrapperspb",
+}
+
+// target is a set of protos and the code generated for them.
+type target struct {
+	// dir is the import root of the protos, relative to the repository root.
+	dir   string
+	files []string
+	// args are the protoc output flags.
+	args []string
+}
+
+var targets = []target{
+	{
+		dir:   "racing/proto",
+		files: []string{"racing/racing.proto"},
+		args: []string{
+			goOut("go", "paths=source_relative"),
+			goOut("go-grpc", "paths=source_relative", "require_unimplemented_servers=false"),
+			"--validate_out=lang=go,paths=source_relative:.",
+		},
+	},
+	{
+		dir:   "sports/proto",
+		files: []string{"sports/sports.proto"},
+		args: []string{
+			goOut("go", "paths=source_relative"),
+			goOut("go-grpc", "paths=source_relative", "require_unimplemented_servers=false"),
+			"--validate_out=lang=go,paths=source_relative:.",
+		},
+	},
+	{
+		dir:   "api/proto",
+		files: []string{"racing/racing.proto", "sports/sports.proto"},
+		args: []string{
+			goOut("go", "paths=source_relative"),
+			goOut("go-grpc", "paths=source_relative"),
+			goOut("grpc-gateway", "paths=source_relative"),
+			"--openapiv2_out=.",
+		},
+	},
+	{
+		// The api catalogue is built from the comments, which are stripped
+		// from the generated code.
+		dir:   "api/proto",
+		files: []string{"racing/racing.proto", "sports/sports.proto"},
+		args: []string{
+			"--include_source_info",
+			"--descriptor_set_out=descriptors.pb",
+		},
+	},
+}
+
+func main() {
+	flag.Parse()
+
+	if err := run(); err != nil {
+		log.Fatalf("failed generating protos: %s\n", err)
+	}
+}
+
+func run() error {
+	bin, err := ioutil.TempDir("", "protogen")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(bin)
+
+	// A trailing separator has go build write every tool into the directory.
+	build := append([]string{"build", "-o", bin + string(filepath.Separator)}, tools...)
+	if err := runCommand(exec.Command("go", build...)); err != nil {
+		return fmt.Errorf("building tools: %w", err)
+	}
+
+	if *against != "" {
+		if err := checkBreaking(bin); err != nil {
+			return err
+		}
+	}
+
+	for _, t := range targets {
+		log.Printf("generating %s %v\n", t.dir, t.files)
+
+		args := append([]string{"protoc", "-I", "."}, t.args...)
+		cmd := exec.Command(filepath.Join(bin, "buf"), append(args, t.files...)...)
+		cmd.Dir = filepath.Join(*root, t.dir)
+		cmd.Env = append(os.Environ(), "PATH="+bin+string(filepath.ListSeparator)+os.Getenv("PATH"))
+
+		if err := runCommand(cmd); err != nil {
+			return fmt.Errorf("generating %s: %w", t.dir, err)
+		}
+	}
+
+	return nil
+}
+
+// checkBreaking runs buf breaking on the directory of each target against the
+// same directory at the git ref, buf lists any breaking changes found.
+func checkBreaking(bin string) error {
+	repo, err := filepath.Abs(filepath.Join(*root, ".git"))
+	if err != nil {
+		return err
+	}
+
+	checked := make(map[string]bool)
+
+	for _, t := range targets {
+		if checked[t.dir] {
+			continue
+		}
+		checked[t.dir] = true
+
+		log.Printf("checking %s for breaking changes against %s\n", t.dir, *against)
+
+		cmd := exec.Command(filepath.Join(bin, "buf"), "breaking", "--against", repo+"#ref="+*against+",subdir="+t.dir)
+		cmd.Dir = filepath.Join(*root, t.dir)
+
+		if err := runCommand(cmd); err != nil {
+			return fmt.Errorf("breaking changes in %s, run with -against= if they are intended: %w", t.dir, err)
+		}
+	}
+
+	return nil
+}
+
+// goOut returns the output flag of a plugin generating Go, mapping the well
+// known types to their packages.
+func goOut(plugin string, opts ...string) string {
+	for file, pkg := range wellKnownTypes {
+		opts = append(opts, "M"+file+"="+pkg)
+	}
+	sort.Strings(opts)
+
+	return "--" + plugin + "_out=" + strings.Join(opts, ",") + ":."
+}
+
+func runCommand(cmd *exec.Cmd) error {
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	return cmd.Run()
+}
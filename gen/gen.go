@@ -0,0 +1,8 @@
+// Package gen regenerates the code for the protos of every module in the
+// tree. Run the following from this directory after changing any .proto file,
+// remembering the api copies of the racing and sports protos.
+//
+//	go generate
+package gen
+
+//go:generate go run ./cmd/protogen -root ..
@@ -0,0 +1,16 @@
+module git.neds.sh/matty/entain/gen
+
+go 1.16
+
+require (
+	github.com/bufbuild/buf v0.37.0
+	github.com/envoyproxy/protoc-gen-validate v0.6.3
+	github.com/grpc-ecosystem/grpc-gateway/v2 v2.7.2
+	golang.org/x/net v0.0.0-20211216030914-fe4d6282115f // indirect
+	golang.org/x/sys v0.0.0-20211216021012-1d35b9e2eb4e // indirect
+	golang.org/x/text v0.3.7 // indirect
+	google.golang.org/genproto v0.0.0-20211223182754-3ac035c7e7cb // indirect
+	google.golang.org/grpc v1.43.0 // indirect
+	google.golang.org/grpc/cmd/protoc-gen-go-grpc v1.2.0
+	google.golang.org/protobuf v1.27.1
+)
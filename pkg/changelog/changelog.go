@@ -0,0 +1,161 @@
+// Package changelog captures inserts, updates and deletes of tables into a
+// changes table with triggers, each change numbered by an increasing
+// sequence, so consumers can follow changes without polling whole tables.
+package changelog
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"git.neds.sh/matty/entain/pkg/sqldialect"
+)
+
+// ErrUnsupported is returned when the database has no change capture.
+var ErrUnsupported = errors.New("change capture is not supported for this database")
+
+// Operations recorded for a change.
+const (
+	Insert = "INSERT"
+	Update = "UPDATE"
+	Delete = "DELETE"
+
+	// Visibility is recorded as well as the update when an update changes
+	// the visibility of a row.
+	Visibility = "VISIBILITY"
+)
+
+const changesTable = "changes"
+
+// Change is a single captured write to a row.
+type Change struct {
+	Seq       int64     `json:"seq"`
+	Table     string    `json:"table"`
+	RowID     int64     `json:"rowId"`
+	Operation string    `json:"operation"`
+	ChangedAt time.Time `json:"changedAt"`
+	// Partition is the value of the partition column of the row, see
+	// EnablePartition, empty when the table has none.
+	Partition string `json:"partition,omitempty"`
+}
+
+// Enable creates the changes table and the triggers recording changes to the
+// id keyed table. It is safe to call on every start up.
+func Enable(ctx context.Context, db *sqldialect.DB, table string) error {
+	if _, ok := db.Dialect.(sqldialect.SQLite); !ok {
+		return ErrUnsupported
+	}
+
+	statements := []string{
+		`CREATE TABLE IF NOT EXISTS ` + changesTable + ` (
+			seq INTEGER PRIMARY KEY AUTOINCREMENT,
+			table_name TEXT NOT NULL,
+			row_id INTEGER NOT NULL,
+			operation TEXT NOT NULL,
+			changed_at DATETIME NOT NULL DEFAULT (strftime('%Y-%m-%dT%H:%M:%SZ', 'now')),
+			partition_key TEXT
+		)`,
+		trigger(table, Insert, "NEW"),
+		trigger(table, Update, "NEW"),
+		trigger(table, Delete, "OLD"),
+	}
+
+	for _, statement := range statements {
+		if _, err := db.ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+
+	// Changes tables created before partitions need the column adding.
+	columns, err := db.TableColumns(ctx, changesTable)
+	if err != nil {
+		return err
+	}
+	if !columns["partition_key"] {
+		if _, err := db.ExecContext(ctx, `ALTER TABLE `+changesTable+` ADD COLUMN partition_key TEXT`); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EnableRelated records a change of the operation to a row of the id keyed
+// table whenever a row of the related table is inserted or updated, column of
+// the related table giving the ID of the row, so writes to a table of details
+// of the rows are followed as changes to the rows themselves. Enable must be
+// called for the table first.
+func EnableRelated(ctx context.Context, db *sqldialect.DB, table, related, column, operation string) error {
+	if _, ok := db.Dialect.(sqldialect.SQLite); !ok {
+		return ErrUnsupported
+	}
+
+	for _, on := range []string{Insert, Update} {
+		statement := `CREATE TRIGGER IF NOT EXISTS ` + related + `_` + on + `_` + operation + `_changes AFTER ` + on + ` ON ` + related + ` BEGIN
+			INSERT INTO ` + changesTable + ` (table_name, row_id, operation) VALUES ('` + table + `', NEW.` + column + `, '` + operation + `');
+		END`
+
+		if _, err := db.ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EnableColumn records a change of the operation to a row of the id keyed
+// table whenever an update changes the value of its column, so changes to it
+// can be followed apart from other updates. Enable must be called for the
+// table first.
+func EnableColumn(ctx context.Context, db *sqldialect.DB, table, column, operation string) error {
+	if _, ok := db.Dialect.(sqldialect.SQLite); !ok {
+		return ErrUnsupported
+	}
+
+	statement := `CREATE TRIGGER IF NOT EXISTS ` + table + `_` + column + `_` + operation + `_changes AFTER UPDATE OF ` + column + ` ON ` + table + `
+		WHEN OLD.` + column + ` IS NOT NEW.` + column + ` BEGIN
+			INSERT INTO ` + changesTable + ` (table_name, row_id, operation) VALUES ('` + table + `', NEW.id, '` + operation + `');
+		END`
+
+	_, err := db.ExecContext(ctx, statement)
+	return err
+}
+
+// trigger records the operation on table, row is NEW or OLD.
+func trigger(table, operation, row string) string {
+	return `CREATE TRIGGER IF NOT EXISTS ` + table + `_` + operation + `_changes AFTER ` + operation + ` ON ` + table + ` BEGIN
+		INSERT INTO ` + changesTable + ` (table_name, row_id, operation) VALUES ('` + table + `', ` + row + `.id, '` + operation + `');
+	END`
+}
+
+// List returns up to limit changes to the table after the sequence number
+// afterSeq, oldest first.
+func List(ctx context.Context, db *sqldialect.DB, table string, afterSeq int64, limit int) ([]Change, error) {
+	if _, ok := db.Dialect.(sqldialect.SQLite); !ok {
+		return nil, ErrUnsupported
+	}
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT seq, table_name, row_id, operation, changed_at, COALESCE(partition_key, '')
+		FROM `+changesTable+`
+		WHERE table_name = ? AND seq > ?
+		ORDER BY seq
+		LIMIT ?
+	`, table, afterSeq, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var changes []Change
+
+	for rows.Next() {
+		var change Change
+		if err := rows.Scan(&change.Seq, &change.Table, &change.RowID, &change.Operation, &change.ChangedAt, &change.Partition); err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, rows.Err()
+}
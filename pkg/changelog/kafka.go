@@ -0,0 +1,82 @@
+package changelog
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+)
+
+// Encoder converts a change into the protobuf message of the service it was
+// captured by, for sinks producing protobuf.
+type Encoder func(change Change) (proto.Message, error)
+
+// KafkaSink produces each change as a message to a Kafka topic. Messages are
+// keyed by the ID of the row, so the changes to a row stay in order on one
+// partition, with the change encoded as protobuf as the value and its
+// operation as a header for consumers to filter on without decoding.
+type KafkaSink struct {
+	writer *kafka.Writer
+	encode Encoder
+}
+
+// NewKafkaSink creates a sink producing changes to the topic on the brokers,
+// encoded by encode. Each batch is published once all in sync replicas have
+// it.
+func NewKafkaSink(brokers []string, topic string, encode Encoder) *KafkaSink {
+	return &KafkaSink{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Topic:        topic,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			WriteTimeout: sinkTimeout,
+		},
+		encode: encode,
+	}
+}
+
+func (s *KafkaSink) Publish(ctx context.Context, changes []Change) error {
+	messages := make([]kafka.Message, 0, len(changes))
+
+	for _, change := range changes {
+		message, err := s.encode(change)
+		if err != nil {
+			return err
+		}
+
+		value, err := proto.Marshal(message)
+		if err != nil {
+			return err
+		}
+
+		messages = append(messages, kafka.Message{
+			Key:     []byte(strconv.FormatInt(change.RowID, 10)),
+			Value:   value,
+			Headers: []kafka.Header{{Key: "operation", Value: []byte(change.Operation)}},
+			Time:    change.ChangedAt,
+		})
+	}
+
+	return s.writer.WriteMessages(ctx, messages...)
+}
+
+// Close flushes and closes the connections to the brokers.
+func (s *KafkaSink) Close() error {
+	return s.writer.Close()
+}
+
+// parseKafka parses the brokers and topic of a kafka://host:port,.../topic
+// sink.
+func parseKafka(s string) ([]string, string, bool) {
+	rest := strings.TrimPrefix(s, "kafka://")
+
+	slash := strings.Index(rest, "/")
+	if slash <= 0 || slash == len(rest)-1 {
+		return nil, "", false
+	}
+
+	return strings.Split(rest[:slash], ","), rest[slash+1:], true
+}
@@ -0,0 +1,173 @@
+package changelog
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/proto"
+)
+
+// NATSSink publishes each change to NATS JetStream as protobuf, on a subject
+// and stream of its own for each partition, e.g. events.football in the
+// EVENTS_FOOTBALL stream, so the changes of one partition can be replayed
+// apart. Changes without a partition are published on the prefix alone.
+// Each publish waits for JetStream to acknowledge it, and the sequence number
+// of the change is its message ID so JetStream drops changes published again
+// within its duplicate window.
+type NATSSink struct {
+	url    string
+	prefix string
+	encode Encoder
+
+	mu sync.Mutex
+	// conn and js are connected on the first publish, so the service starts
+	// while the servers are unreachable.
+	conn *nats.Conn
+	js   nats.JetStreamContext
+	// streams are the streams known to exist, by name.
+	streams map[string]bool
+}
+
+// NewNATSSink creates a sink publishing changes, encoded by encode, on
+// subjects starting with prefix to the servers at url, separated by commas.
+func NewNATSSink(url, prefix string, encode Encoder) *NATSSink {
+	return &NATSSink{url: url, prefix: prefix, encode: encode, streams: make(map[string]bool)}
+}
+
+func (s *NATSSink) Publish(ctx context.Context, changes []Change) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.connect(); err != nil {
+		return err
+	}
+
+	for _, change := range changes {
+		message, err := s.encode(change)
+		if err != nil {
+			return err
+		}
+
+		data, err := proto.Marshal(message)
+		if err != nil {
+			return err
+		}
+
+		subject, stream := s.subject(change.Partition)
+		if err := s.ensureStream(ctx, stream, subject); err != nil {
+			return err
+		}
+
+		msg := nats.NewMsg(subject)
+		msg.Data = data
+		msg.Header.Set("operation", change.Operation)
+		msg.Header.Set("row-id", strconv.FormatInt(change.RowID, 10))
+
+		if _, err := s.js.PublishMsg(msg, nats.MsgId(strconv.FormatInt(change.Seq, 10)), nats.Context(ctx)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close drains and closes the connection to the servers.
+func (s *NATSSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		return nil
+	}
+
+	return s.conn.Drain()
+}
+
+func (s *NATSSink) connect() error {
+	if s.js != nil {
+		return nil
+	}
+
+	conn, err := nats.Connect(s.url, nats.Name("changelog"))
+	if err != nil {
+		return err
+	}
+
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return err
+	}
+
+	s.conn, s.js = conn, js
+
+	return nil
+}
+
+// ensureStream creates the stream of a subject unless it exists, keeping its
+// messages to be replayed.
+func (s *NATSSink) ensureStream(ctx context.Context, stream, subject string) error {
+	if s.streams[stream] {
+		return nil
+	}
+
+	_, err := s.js.StreamInfo(stream, nats.Context(ctx))
+	if errors.Is(err, nats.ErrStreamNotFound) {
+		_, err = s.js.AddStream(&nats.StreamConfig{Name: stream, Subjects: []string{subject}}, nats.Context(ctx))
+	}
+	if err != nil {
+		return err
+	}
+
+	s.streams[stream] = true
+
+	return nil
+}
+
+// subject returns the subject and stream of the changes of a partition.
+func (s *NATSSink) subject(partition string) (string, string) {
+	if partition == "" {
+		return s.prefix, streamName(s.prefix)
+	}
+
+	token := subjectToken(partition)
+
+	return s.prefix + "." + token, streamName(s.prefix + "_" + token)
+}
+
+// subjectToken replaces the characters of a partition which can't be in a
+// subject token, or a stream name, with underscores.
+func subjectToken(partition string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' {
+			return r
+		}
+		return '_'
+	}, partition)
+}
+
+func streamName(name string) string {
+	return strings.ToUpper(strings.ReplaceAll(name, ".", "_"))
+}
+
+// parseNATS parses the servers and subject prefix of a
+// nats://host:port,.../prefix sink.
+func parseNATS(s string) (string, string, bool) {
+	rest := strings.TrimPrefix(s, "nats://")
+
+	slash := strings.Index(rest, "/")
+	if slash <= 0 || slash == len(rest)-1 {
+		return "", "", false
+	}
+
+	servers := strings.Split(rest[:slash], ",")
+	for i, server := range servers {
+		servers[i] = "nats://" + server
+	}
+
+	return strings.Join(servers, ","), rest[slash+1:], true
+}
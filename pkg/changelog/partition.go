@@ -0,0 +1,50 @@
+package changelog
+
+import (
+	"context"
+
+	"git.neds.sh/matty/entain/pkg/sqldialect"
+)
+
+const tombstonesTable = "change_tombstones"
+
+// EnablePartition records the value of the column of a row as the partition
+// of every change to it, e.g. the sport of an event, so the changes can be
+// published apart by partition. The value of a deleted row is kept by a
+// tombstone until its change is recorded. Enable must be called for the
+// table first, changes recorded before are left without a partition.
+func EnablePartition(ctx context.Context, db *sqldialect.DB, table, column string) error {
+	if _, ok := db.Dialect.(sqldialect.SQLite); !ok {
+		return ErrUnsupported
+	}
+
+	statements := []string{
+		`CREATE TABLE IF NOT EXISTS ` + tombstonesTable + ` (
+			table_name TEXT NOT NULL,
+			row_id INTEGER NOT NULL,
+			partition_key TEXT,
+			PRIMARY KEY (table_name, row_id)
+		)`,
+		// The row is gone by the time its delete is recorded, so its
+		// partition is kept before it is deleted.
+		`CREATE TRIGGER IF NOT EXISTS ` + table + `_tombstone_changes BEFORE DELETE ON ` + table + ` BEGIN
+			INSERT OR REPLACE INTO ` + tombstonesTable + ` (table_name, row_id, partition_key) VALUES ('` + table + `', OLD.id, OLD.` + column + `);
+		END`,
+		`CREATE TRIGGER IF NOT EXISTS ` + table + `_partition_changes AFTER INSERT ON ` + changesTable + `
+			WHEN NEW.table_name = '` + table + `' AND NEW.partition_key IS NULL BEGIN
+			UPDATE ` + changesTable + ` SET partition_key = COALESCE(
+				(SELECT ` + column + ` FROM ` + table + ` WHERE id = NEW.row_id),
+				(SELECT partition_key FROM ` + tombstonesTable + ` WHERE table_name = '` + table + `' AND row_id = NEW.row_id)
+			) WHERE seq = NEW.seq;
+			DELETE FROM ` + tombstonesTable + ` WHERE table_name = '` + table + `' AND row_id = NEW.row_id AND NEW.operation = '` + Delete + `';
+		END`,
+	}
+
+	for _, statement := range statements {
+		if _, err := db.ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
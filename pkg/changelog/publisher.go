@@ -0,0 +1,135 @@
+package changelog
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"time"
+
+	"git.neds.sh/matty/entain/pkg/sqldialect"
+	"go.uber.org/zap"
+)
+
+const publishersTable = "change_publishers"
+
+// publishBatchSize is the most changes given to a sink at once.
+const publishBatchSize = 100
+
+// Sink receives the changes published, e.g. a message queue or a webhook.
+type Sink interface {
+	// Publish delivers the changes, oldest first. The changes are given
+	// again when it fails.
+	Publish(ctx context.Context, changes []Change) error
+}
+
+// Publisher delivers the changes to a table to a sink as they are captured,
+// so the changes table is an outbox downstream consumers are sent changes
+// from rather than polling. Changes are delivered at least once, those
+// delivered before a restart was recorded can be delivered again, so
+// consumers should skip sequence numbers they have seen.
+type Publisher struct {
+	db       *sqldialect.DB
+	table    string
+	sink     Sink
+	interval time.Duration
+	logger   *zap.Logger
+}
+
+// NewPublisher creates a publisher delivering the changes to the table to the
+// sink, checking for new changes every interval.
+func NewPublisher(db *sqldialect.DB, table string, sink Sink, interval time.Duration, logger *zap.Logger) *Publisher {
+	return &Publisher{db: db, table: table, sink: sink, interval: interval, logger: logger}
+}
+
+// Run publishes changes until the context is cancelled, carrying on from the
+// last change published by a previous run, and closes the sink when it is
+// an io.Closer. Nothing is published without a sink or change capture.
+func (p *Publisher) Run(ctx context.Context) {
+	if p == nil || p.sink == nil {
+		return
+	}
+
+	if _, ok := p.db.Dialect.(sqldialect.SQLite); !ok {
+		p.logger.Warn("changes are not published", zap.String("table", p.table), zap.Error(ErrUnsupported))
+		return
+	}
+
+	if _, err := p.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS `+publishersTable+` (
+		table_name TEXT PRIMARY KEY,
+		last_seq INTEGER NOT NULL
+	)`); err != nil {
+		p.logger.Error("failed creating change publishers table", zap.Error(err))
+		return
+	}
+
+	if closer, ok := p.sink.(io.Closer); ok {
+		defer closer.Close()
+	}
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.publish(ctx); err != nil && ctx.Err() == nil {
+			p.logger.Error("failed publishing changes", zap.String("table", p.table), zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// publish delivers the changes captured since the last published, a batch at
+// a time, recording the last of each batch once delivered.
+func (p *Publisher) publish(ctx context.Context) error {
+	lastSeq, err := p.lastSeq(ctx)
+	if err != nil {
+		return err
+	}
+
+	for {
+		changes, err := List(ctx, p.db, p.table, lastSeq, publishBatchSize)
+		if err != nil || len(changes) == 0 {
+			return err
+		}
+
+		if err := p.sink.Publish(ctx, changes); err != nil {
+			return err
+		}
+
+		lastSeq = changes[len(changes)-1].Seq
+		if err := p.setLastSeq(ctx, lastSeq); err != nil {
+			return err
+		}
+
+		p.logger.Debug("published changes", zap.String("table", p.table), zap.Int("count", len(changes)), zap.Int64("last_seq", lastSeq))
+
+		if len(changes) < publishBatchSize {
+			return nil
+		}
+	}
+}
+
+func (p *Publisher) lastSeq(ctx context.Context) (int64, error) {
+	var lastSeq int64
+
+	err := p.db.QueryRowContext(ctx, `SELECT last_seq FROM `+publishersTable+` WHERE table_name = ?`, p.table).Scan(&lastSeq)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+
+	return lastSeq, err
+}
+
+func (p *Publisher) setLastSeq(ctx context.Context, lastSeq int64) error {
+	_, err := p.db.ExecContext(ctx, `
+		INSERT INTO `+publishersTable+` (table_name, last_seq) VALUES (?, ?)
+		ON CONFLICT (table_name) DO UPDATE SET last_seq = excluded.last_seq
+	`, p.table, lastSeq)
+
+	return err
+}
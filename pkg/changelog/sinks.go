@@ -0,0 +1,109 @@
+package changelog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// sinkTimeout bounds each delivery of changes to a webhook or Kafka.
+const sinkTimeout = 10 * time.Second
+
+// ParseSink returns the sink described, nil when it is empty. encode gives
+// the protobuf message of a change for the sinks producing protobuf.
+//
+//	stdout                       each change as a line of JSON on standard output
+//	http(s)://host/...           each batch of changes POSTed as a JSON array
+//	kafka://host:port,.../topic  each change produced to the topic as protobuf
+//	nats://host:port,.../prefix  each change published to JetStream as protobuf
+func ParseSink(s string, encode Encoder) (Sink, error) {
+	switch {
+	case s == "":
+		return nil, nil
+	case s == "stdout":
+		return NewWriterSink(os.Stdout), nil
+	case strings.HasPrefix(s, "http://"), strings.HasPrefix(s, "https://"):
+		return NewWebhookSink(s, &http.Client{Timeout: sinkTimeout}), nil
+	case strings.HasPrefix(s, "kafka://"):
+		brokers, topic, ok := parseKafka(s)
+		if !ok {
+			return nil, fmt.Errorf("invalid change sink %q, expected kafka://host:port,.../topic", s)
+		}
+		return NewKafkaSink(brokers, topic, encode), nil
+	case strings.HasPrefix(s, "nats://"):
+		url, prefix, ok := parseNATS(s)
+		if !ok {
+			return nil, fmt.Errorf("invalid change sink %q, expected nats://host:port,.../prefix", s)
+		}
+		return NewNATSSink(url, prefix, encode), nil
+	default:
+		return nil, fmt.Errorf("invalid change sink %q, expected stdout, an http(s) URL, a kafka:// URL or a nats:// URL", s)
+	}
+}
+
+// WriterSink writes each change as a line of JSON, e.g. for a log shipper to
+// forward.
+type WriterSink struct {
+	w io.Writer
+}
+
+// NewWriterSink creates a sink writing changes to w.
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{w: w}
+}
+
+func (s *WriterSink) Publish(ctx context.Context, changes []Change) error {
+	encoder := json.NewEncoder(s.w)
+
+	for _, change := range changes {
+		if err := encoder.Encode(change); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WebhookSink POSTs each batch of changes to a URL as a JSON array, failing
+// unless it responds with a 2xx status.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSink creates a sink POSTing changes to the URL with the client.
+func NewWebhookSink(url string, client *http.Client) *WebhookSink {
+	return &WebhookSink{url: url, client: client}
+}
+
+func (s *WebhookSink) Publish(ctx context.Context, changes []Change) error {
+	body, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("change sink responded %s", resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+// Package config sets the flags of the gateway and services from, in order of
+// precedence, the command line, environment variables and a YAML file, before
+// falling back to their defaults. Every flag can be given each way, so
+// deployments can keep settings in a file and override a few, such as
+// secrets, in the environment.
+//
+// A flag such as -log-level is read from the LOG_LEVEL environment variable,
+// with the prefix of the binary, e.g. RACING_LOG_LEVEL, and from the
+// log-level key of the file given by -config.
+package config
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// configFlag is the flag giving the YAML file, it can also be given by the
+// environment but not the file itself.
+const configFlag = "config"
+
+// Parse parses the command line arguments into the flags, then sets those not
+// given on it from the environment variables with the prefix, e.g. RACING,
+// and then the YAML file of the -config flag, which Parse adds.
+func Parse(fs *flag.FlagSet, prefix string, args []string) error {
+	path := fs.String(configFlag, "", "YAML file of flag values by name, overridden by "+prefix+"_* environment variables and flags")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	given := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) {
+		given[f.Name] = true
+	})
+
+	if err := setFromEnv(fs, prefix, given); err != nil {
+		return err
+	}
+
+	if *path == "" {
+		return nil
+	}
+
+	return setFromFile(fs, *path, given)
+}
+
+// setFromEnv sets the flags not yet given from their environment variables,
+// marking them given.
+func setFromEnv(fs *flag.FlagSet, prefix string, given map[string]bool) error {
+	var err error
+
+	fs.VisitAll(func(f *flag.Flag) {
+		if err != nil || given[f.Name] {
+			return
+		}
+
+		name := envName(prefix, f.Name)
+
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			return
+		}
+
+		if setErr := fs.Set(f.Name, value); setErr != nil {
+			err = fmt.Errorf("invalid value %q for %s: %w", value, name, setErr)
+			return
+		}
+		given[f.Name] = true
+	})
+
+	return err
+}
+
+// setFromFile sets the flags not yet given from a YAML file of values by flag
+// name. Keys which aren't flags are rejected so typos don't go unnoticed.
+func setFromFile(fs *flag.FlagSet, path string, given map[string]bool) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var values map[string]interface{}
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+
+	for name, value := range values {
+		if fs.Lookup(name) == nil || name == configFlag {
+			return fmt.Errorf("unknown setting %s in config file %s", name, path)
+		}
+
+		switch value.(type) {
+		case map[interface{}]interface{}, []interface{}:
+			return fmt.Errorf("setting %s in config file %s must be a single value", name, path)
+		}
+
+		if given[name] {
+			continue
+		}
+
+		if err := fs.Set(name, fmt.Sprint(value)); err != nil {
+			return fmt.Errorf("invalid value %v for %s in config file %s: %w", value, name, path, err)
+		}
+	}
+
+	return nil
+}
+
+// envName returns the environment variable of a flag, e.g. RACING_LOG_LEVEL
+// for log-level.
+func envName(prefix, name string) string {
+	return prefix + "_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+}
@@ -0,0 +1,138 @@
+// Package errorreason catalogues the reasons of the errors the API returns.
+// Every error carries an ErrorInfo detail with its reason, a stable machine
+// readable code integrators can program against rather than the message,
+// which can change.
+//
+// Reasons are never renamed or removed once added, as clients match on them.
+package errorreason
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+)
+
+// Domain is the ErrorInfo domain of every reason.
+const Domain = "entain"
+
+// Reason is a cause of errors, always returned with the same code.
+type Reason struct {
+	Reason      string
+	Code        codes.Code
+	Description string
+}
+
+// Reasons of the errors returned.
+var (
+	NotFound = Reason{
+		Reason:      "NOT_FOUND",
+		Code:        codes.NotFound,
+		Description: "The requested race, event, result or feature flag doesn't exist. A ResourceInfo detail names a missing race, event or result.",
+	}
+	UnknownMethod = Reason{
+		Reason:      "UNKNOWN_METHOD",
+		Code:        codes.NotFound,
+		Description: "The method given for log sampling or payload logging isn't an RPC of the service.",
+	}
+	InvalidRequest = Reason{
+		Reason:      "INVALID_REQUEST",
+		Code:        codes.InvalidArgument,
+		Description: "The request breaks the validation rules of its message. The field metadata names the offending field.",
+	}
+	InvalidValue = Reason{
+		Reason:      "INVALID_VALUE",
+		Code:        codes.InvalidArgument,
+		Description: "A value was rejected by the service, e.g. a missing required field, an unknown order_by field, a page_token not from a previous page or a name over its maximum length.",
+	}
+	FeatureDisabled = Reason{
+		Reason:      "FEATURE_DISABLED",
+		Code:        codes.Unimplemented,
+		Description: "The RPC is turned off by a feature flag. The flag metadata names it.",
+	}
+	ChangesUnsupported = Reason{
+		Reason:      "CHANGES_UNSUPPORTED",
+		Code:        codes.Unimplemented,
+		Description: "Change capture isn't supported on the database of the service.",
+	}
+	WatchClosed = Reason{
+		Reason:      "WATCH_CLOSED",
+		Code:        codes.Unavailable,
+		Description: "A watch stream was closed as the client fell behind or the server is shutting down, watch again.",
+	}
+	AuthenticationRequired = Reason{
+		Reason:      "AUTHENTICATION_REQUIRED",
+		Code:        codes.Unauthenticated,
+		Description: "The change needs an API key or bearer token.",
+	}
+	InvalidCredentials = Reason{
+		Reason:      "INVALID_CREDENTIALS",
+		Code:        codes.Unauthenticated,
+		Description: "The API key is unknown, or the bearer token is invalid or expired.",
+	}
+	RoleRequired = Reason{
+		Reason:      "ROLE_REQUIRED",
+		Code:        codes.PermissionDenied,
+		Description: "The change needs a role the client hasn't been granted. The role metadata names it.",
+	}
+	RateLimited = Reason{
+		Reason:      "RATE_LIMITED",
+		Code:        codes.ResourceExhausted,
+		Description: "The client is over its rate limit for the RPC. A RetryInfo detail, and the Retry-After header, give when to retry.",
+	}
+	Cancelled = Reason{
+		Reason:      "CANCELLED",
+		Code:        codes.Canceled,
+		Description: "The request was cancelled by the client.",
+	}
+	DeadlineExceeded = Reason{
+		Reason:      "DEADLINE_EXCEEDED",
+		Code:        codes.DeadlineExceeded,
+		Description: "The request didn't complete before its deadline.",
+	}
+	Internal = Reason{
+		Reason:      "INTERNAL",
+		Code:        codes.Internal,
+		Description: "The request failed unexpectedly, the cause is logged by the service. Retrying may succeed.",
+	}
+)
+
+// Catalogue lists every reason.
+var Catalogue = []Reason{
+	NotFound,
+	UnknownMethod,
+	InvalidRequest,
+	InvalidValue,
+	FeatureDisabled,
+	ChangesUnsupported,
+	WatchClosed,
+	AuthenticationRequired,
+	InvalidCredentials,
+	RoleRequired,
+	RateLimited,
+	Cancelled,
+	DeadlineExceeded,
+	Internal,
+}
+
+// Status returns a status of the reason with the message, detailing the
+// reason and metadata, which can be nil, followed by any other details.
+func (r Reason) Status(message string, metadata map[string]string, details ...protoiface.MessageV1) *status.Status {
+	details = append([]protoiface.MessageV1{&errdetails.ErrorInfo{
+		Reason:   r.Reason,
+		Domain:   Domain,
+		Metadata: metadata,
+	}}, details...)
+
+	st, err := status.New(r.Code, message).WithDetails(details...)
+	if err != nil {
+		return status.New(r.Code, message)
+	}
+
+	return st
+}
+
+// Error returns an error of the reason, see Status.
+func (r Reason) Error(message string, metadata map[string]string, details ...protoiface.MessageV1) error {
+	return r.Status(message, metadata, details...).Err()
+}
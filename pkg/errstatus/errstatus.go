@@ -0,0 +1,80 @@
+// Package errstatus converts the errors returned by the RPCs of a service into
+// gRPC statuses with an errorreason, for the errors every service returns, and
+// those particular to a service by its own Mapping.
+package errstatus
+
+import (
+	"context"
+	"errors"
+
+	"git.neds.sh/matty/entain/pkg/changelog"
+	"git.neds.sh/matty/entain/pkg/errorreason"
+	"git.neds.sh/matty/entain/pkg/featureflag"
+	"git.neds.sh/matty/entain/pkg/logging"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Mapping maps the errors particular to a service, such as those of its
+// repositories, onto their statuses. It returns nil for the errors it doesn't
+// know.
+type Mapping func(err error) error
+
+// NewUnaryInterceptor converts errors returned by unary RPCs into gRPC
+// statuses, see ToStatus.
+func NewUnaryInterceptor(logger *zap.Logger, mapping Mapping) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, ToStatus(logging.ForRequest(ctx, logger), info.FullMethod, err, mapping)
+		}
+
+		return resp, nil
+	}
+}
+
+// NewStreamInterceptor converts errors returned by streaming RPCs into gRPC
+// statuses, see ToStatus.
+func NewStreamInterceptor(logger *zap.Logger, mapping Mapping) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return ToStatus(logging.ForRequest(ss.Context(), logger), info.FullMethod, err, mapping)
+		}
+
+		return nil
+	}
+}
+
+// ToStatus maps an error onto the matching gRPC status code and reason.
+// Without this every error is reported as Unknown. Errors not known to be
+// safe to show the caller, such as those from the database, are logged and
+// replaced with a generic message. Errors are matched with errors.Is and
+// errors.As so repositories can wrap them with the operation which failed,
+// the context wrapped around them only being logged.
+func ToStatus(logger *zap.Logger, method string, err error, mapping Mapping) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	if mapping != nil {
+		if st := mapping(err); st != nil {
+			return st
+		}
+	}
+
+	switch {
+	case errors.Is(err, featureflag.ErrUnknownFlag):
+		return errorreason.NotFound.Error(err.Error(), nil)
+	case errors.Is(err, changelog.ErrUnsupported):
+		return errorreason.ChangesUnsupported.Error(changelog.ErrUnsupported.Error(), nil)
+	case errors.Is(err, context.Canceled):
+		return errorreason.Cancelled.Error("request cancelled", nil)
+	case errors.Is(err, context.DeadlineExceeded):
+		return errorreason.DeadlineExceeded.Error("request deadline exceeded", nil)
+	}
+
+	logger.Error("internal error", zap.String("method", method), zap.Error(err))
+
+	return errorreason.Internal.Error("internal error", nil)
+}
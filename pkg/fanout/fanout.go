@@ -0,0 +1,47 @@
+// Package fanout runs the queries a response is composed of concurrently, so
+// the response takes about as long as its slowest query rather than all of
+// them in turn, each query bounded by a timeout of its own.
+package fanout
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// Group runs queries concurrently, cancelling the rest once one fails.
+type Group struct {
+	group   *errgroup.Group
+	ctx     context.Context
+	timeout time.Duration
+}
+
+// New creates a group of queries made under ctx, each given up to timeout,
+// or as long as ctx allows when timeout is zero.
+func New(ctx context.Context, timeout time.Duration) *Group {
+	group, ctx := errgroup.WithContext(ctx)
+
+	return &Group{group: group, ctx: ctx, timeout: timeout}
+}
+
+// Go runs the query in its own goroutine. The query must only write to
+// variables which no other query of the group reads or writes, and those
+// must only be read once Wait returns.
+func (g *Group) Go(query func(ctx context.Context) error) {
+	g.group.Go(func() error {
+		ctx := g.ctx
+		if g.timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, g.timeout)
+			defer cancel()
+		}
+
+		return query(ctx)
+	})
+}
+
+// Wait waits for every query to return, returning the first error.
+func (g *Group) Wait() error {
+	return g.group.Wait()
+}
@@ -0,0 +1,197 @@
+package fanout
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestQueriesRunConcurrently(t *testing.T) {
+	queries := New(context.Background(), 0)
+
+	// Each query waits for all of them to start, so they only return if
+	// they run at once.
+	const n = 3
+	var started sync.WaitGroup
+	started.Add(n)
+	allStarted := make(chan struct{})
+	go func() {
+		started.Wait()
+		close(allStarted)
+	}()
+
+	results := make([]int, n)
+	for i := 0; i < n; i++ {
+		i := i
+		queries.Go(func(ctx context.Context) error {
+			started.Done()
+			select {
+			case <-allStarted:
+			case <-time.After(time.Second):
+				return errors.New("queries didn't run concurrently")
+			}
+
+			results[i] = i + 1
+			return nil
+		})
+	}
+
+	if err := queries.Wait(); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, result := range results {
+		if result != i+1 {
+			t.Errorf("results[%d] = %d, want %d", i, result, i+1)
+		}
+	}
+}
+
+func TestEachQueryHasItsOwnTimeout(t *testing.T) {
+	const timeout = 50 * time.Millisecond
+
+	queries := New(context.Background(), timeout)
+
+	var (
+		starts    [2]time.Time
+		deadlines [2]time.Time
+	)
+
+	for i := range deadlines {
+		i := i
+		// The second query starts later, so its deadline must be later
+		// too.
+		if i > 0 {
+			time.Sleep(20 * time.Millisecond)
+		}
+		starts[i] = time.Now()
+
+		queries.Go(func(ctx context.Context) error {
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return errors.New("query has no deadline")
+			}
+			deadlines[i] = deadline
+
+			return nil
+		})
+	}
+
+	if err := queries.Wait(); err != nil {
+		t.Fatal(err)
+	}
+
+	// A deadline shared with the first query would be before the timeout
+	// from the start of the second.
+	for i, deadline := range deadlines {
+		if elapsed := deadline.Sub(starts[i]); elapsed < timeout || elapsed > time.Second {
+			t.Errorf("query %d deadline %s after it started, want %s", i, elapsed, timeout)
+		}
+	}
+}
+
+func TestTimeoutEndsQuery(t *testing.T) {
+	queries := New(context.Background(), 20*time.Millisecond)
+
+	queries.Go(func(ctx context.Context) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+			return nil
+		}
+	})
+
+	if err := queries.Wait(); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestNoTimeoutKeepsParentDeadline(t *testing.T) {
+	parent, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	want, _ := parent.Deadline()
+
+	queries := New(parent, 0)
+
+	var got time.Time
+	queries.Go(func(ctx context.Context) error {
+		got, _ = ctx.Deadline()
+		return nil
+	})
+
+	if err := queries.Wait(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !got.Equal(want) {
+		t.Errorf("deadline = %s, want that of the parent %s", got, want)
+	}
+}
+
+func TestFailureCancelsSiblings(t *testing.T) {
+	queries := New(context.Background(), time.Second)
+
+	failed := errors.New("query failed")
+	var cancelled int32
+
+	for i := 0; i < 2; i++ {
+		queries.Go(func(ctx context.Context) error {
+			select {
+			case <-ctx.Done():
+				atomic.AddInt32(&cancelled, 1)
+				return ctx.Err()
+			case <-time.After(time.Second):
+				return nil
+			}
+		})
+	}
+	queries.Go(func(ctx context.Context) error {
+		return failed
+	})
+
+	if err := queries.Wait(); !errors.Is(err, failed) {
+		t.Errorf("err = %v, want %v", err, failed)
+	}
+	if got := atomic.LoadInt32(&cancelled); got != 2 {
+		t.Errorf("%d sibling queries cancelled, want 2", got)
+	}
+}
+
+func TestWaitReturnsFirstError(t *testing.T) {
+	queries := New(context.Background(), 0)
+
+	first := errors.New("first")
+	second := errors.New("second")
+
+	queries.Go(func(ctx context.Context) error {
+		return first
+	})
+	queries.Go(func(ctx context.Context) error {
+		// Fails after the first, once it has been cancelled by it.
+		<-ctx.Done()
+		return second
+	})
+
+	if err := queries.Wait(); !errors.Is(err, first) {
+		t.Errorf("err = %v, want %v", err, first)
+	}
+}
+
+func TestParentCancellationCancelsQueries(t *testing.T) {
+	parent, cancel := context.WithCancel(context.Background())
+	queries := New(parent, time.Second)
+
+	queries.Go(func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	cancel()
+
+	if err := queries.Wait(); !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want %v", err, context.Canceled)
+	}
+}
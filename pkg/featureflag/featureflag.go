@@ -0,0 +1,132 @@
+// Package featureflag provides named boolean flags which can be flipped at
+// runtime to gate new behaviour, allowing gradual rollouts without redeploys.
+package featureflag
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrUnknownFlag is returned, wrapped, when setting a flag which was not
+// declared with a default.
+var ErrUnknownFlag = errors.New("unknown feature flag")
+
+// Flags is a set of feature flags. Only flags declared with a default can be
+// set, so a typo can't silently enable nothing.
+type Flags struct {
+	mu    sync.RWMutex
+	flags map[string]bool
+}
+
+// New creates a set of flags with their default states.
+func New(defaults map[string]bool) *Flags {
+	flags := make(map[string]bool, len(defaults))
+	for name, enabled := range defaults {
+		flags[name] = enabled
+	}
+
+	return &Flags{flags: flags}
+}
+
+// Enabled returns whether a flag is on. Undeclared flags are always off.
+func (f *Flags) Enabled(name string) bool {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	return f.flags[name]
+}
+
+// Set turns a flag on or off.
+func (f *Flags) Set(name string, enabled bool) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.flags[name]; !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownFlag, name)
+	}
+
+	f.flags[name] = enabled
+
+	return nil
+}
+
+// Names returns the declared flag names in order.
+func (f *Flags) Names() []string {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	names := make([]string, 0, len(f.flags))
+	for name := range f.flags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// LoadFile sets flags from a JSON file of flag names to states, e.g.
+//
+//	{"watch_races": false}
+//
+// Flags missing from the file are left as they are.
+func (f *Flags) LoadFile(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var states map[string]bool
+	if err := json.Unmarshal(data, &states); err != nil {
+		return fmt.Errorf("invalid feature flags file %s: %w", path, err)
+	}
+
+	for name, enabled := range states {
+		if err := f.Set(name, enabled); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Watch reloads the file whenever it is modified, checking every interval,
+// until the context is cancelled. Changes made with Set are overwritten by
+// the next change to the file.
+func (f *Flags) Watch(ctx context.Context, path string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var lastModified time.Time
+	if info, err := os.Stat(path); err == nil {
+		lastModified = info.ModTime()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		info, err := os.Stat(path)
+		if err != nil || !info.ModTime().After(lastModified) {
+			continue
+		}
+		lastModified = info.ModTime()
+
+		if err := f.LoadFile(path); err != nil {
+			log.Printf("failed reloading feature flags: %s\n", err)
+			continue
+		}
+
+		log.Printf("reloaded feature flags from %s\n", path)
+	}
+}
@@ -0,0 +1,105 @@
+// Package fieldlimit protects stored rows, and so list payloads, from overly
+// long strings in malformed input by truncating or rejecting them.
+package fieldlimit
+
+import (
+	"expvar"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// truncations counts truncated values by "table.field", published with
+// expvar.
+var truncations = expvar.NewMap("field_truncations")
+
+// Policy is what happens to a value longer than its limit.
+type Policy int
+
+const (
+	// Truncate cuts the value down to the limit.
+	Truncate Policy = iota
+	// Reject fails the write.
+	Reject
+)
+
+// ParsePolicy parses "truncate" or "reject".
+func ParsePolicy(s string) (Policy, error) {
+	switch strings.ToLower(s) {
+	case "truncate":
+		return Truncate, nil
+	case "reject":
+		return Reject, nil
+	}
+
+	return 0, fmt.Errorf("unknown field length policy %q, expected truncate or reject", s)
+}
+
+// ParseLengths parses comma separated field=length pairs, e.g.
+// "home_side_name=100,away_side_name=100".
+func ParseLengths(s string) (map[string]int, error) {
+	lengths := make(map[string]int)
+
+	for _, pair := range strings.Split(s, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid field length %q, expected field=length", pair)
+		}
+
+		length, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err != nil || length <= 0 {
+			return nil, fmt.Errorf("invalid field length %q, length must be a positive integer", pair)
+		}
+
+		lengths[strings.TrimSpace(parts[0])] = length
+	}
+
+	return lengths, nil
+}
+
+// Limits are the maximum lengths, in characters, of the string fields of a
+// table.
+type Limits struct {
+	table   string
+	policy  Policy
+	lengths map[string]int
+}
+
+// New creates limits for the fields of a table. Fields without a length are
+// unlimited.
+func New(table string, policy Policy, lengths map[string]int) *Limits {
+	return &Limits{table: table, policy: policy, lengths: lengths}
+}
+
+// Apply enforces the limit of a field on value, truncating it in place or
+// returning an error, safe to show the caller, depending on the policy. Nil
+// limits allow anything.
+func (l *Limits) Apply(field string, value *string) error {
+	if l == nil {
+		return nil
+	}
+
+	max, ok := l.lengths[field]
+	if !ok || utf8.RuneCountInString(*value) <= max {
+		return nil
+	}
+
+	if l.policy == Reject {
+		return fmt.Errorf("%s is longer than %d characters", field, max)
+	}
+
+	key := l.table + "." + field
+	truncations.Add(key, 1)
+	log.Printf("truncating %s from %d to %d characters\n", key, utf8.RuneCountInString(*value), max)
+
+	*value = strings.TrimRightFunc(string([]rune(*value)[:max]), unicode.IsSpace)
+
+	return nil
+}
@@ -0,0 +1,99 @@
+// Package fulltext indexes text columns of tables in SQLite FTS5 tables, kept
+// up to date by triggers, so names can be searched by the start of their words
+// rather than scanned for a substring.
+//
+// FTS5 is only compiled into the SQLite driver with the sqlite_fts5 build tag,
+// e.g. go build -tags sqlite_fts5.
+package fulltext
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"git.neds.sh/matty/entain/pkg/sqldialect"
+)
+
+// ErrUnsupported is returned when the database has no full-text search.
+var ErrUnsupported = errors.New("full-text search is not supported for this database")
+
+// abbreviations are expanded in queries, as names spell the words out.
+var abbreviations = map[string]string{
+	"utd":  "united",
+	"st":   "saint",
+	"intl": "international",
+}
+
+// Table returns the name of the index of the table.
+func Table(table string) string {
+	return table + "_fts"
+}
+
+// Enable creates the index of the columns of the id keyed table and the
+// triggers keeping it up to date, indexing the existing rows when the index is
+// first created. It is safe to call on every start up.
+func Enable(ctx context.Context, db *sqldialect.DB, table string, columns []string) error {
+	if _, ok := db.Dialect.(sqldialect.SQLite); !ok {
+		return ErrUnsupported
+	}
+
+	index := Table(table)
+
+	var existing int
+	if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM sqlite_master WHERE name = ?`, index).Scan(&existing); err != nil {
+		return err
+	}
+
+	create := `CREATE VIRTUAL TABLE IF NOT EXISTS ` + index + ` USING fts5(` + strings.Join(columns, ", ") + `, content='` + table + `', content_rowid='id')`
+	if _, err := db.ExecContext(ctx, create); err != nil {
+		if strings.Contains(err.Error(), "no such module") {
+			return fmt.Errorf("%w: %s", ErrUnsupported, err)
+		}
+		return err
+	}
+
+	newValues := "new." + strings.Join(columns, ", new.")
+	oldValues := "old." + strings.Join(columns, ", old.")
+	insert := `INSERT INTO ` + index + ` (rowid, ` + strings.Join(columns, ", ") + `) VALUES (new.id, ` + newValues + `);`
+	remove := `INSERT INTO ` + index + ` (` + index + `, rowid, ` + strings.Join(columns, ", ") + `) VALUES ('delete', old.id, ` + oldValues + `);`
+
+	statements := []string{
+		`CREATE TRIGGER IF NOT EXISTS ` + index + `_insert AFTER INSERT ON ` + table + ` BEGIN ` + insert + ` END`,
+		`CREATE TRIGGER IF NOT EXISTS ` + index + `_delete AFTER DELETE ON ` + table + ` BEGIN ` + remove + ` END`,
+		`CREATE TRIGGER IF NOT EXISTS ` + index + `_update AFTER UPDATE ON ` + table + ` BEGIN ` + remove + ` ` + insert + ` END`,
+	}
+
+	if existing == 0 {
+		statements = append(statements, `INSERT INTO `+index+` (`+index+`) VALUES ('rebuild')`)
+	}
+
+	for _, statement := range statements {
+		if _, err := db.ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Query converts the words of text into an FTS5 query matching rows with a
+// word starting with each, in any order, e.g. "man utd" matches "Manchester
+// United vs Arsenal". It is empty when text has no words.
+func Query(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		term := `"` + word + `"*`
+		if expanded, ok := abbreviations[word]; ok {
+			term = `(` + term + ` OR "` + expanded + `"*)`
+		}
+		terms = append(terms, term)
+	}
+
+	return strings.Join(terms, " AND ")
+}
@@ -0,0 +1,131 @@
+// Package logging builds the structured loggers of the gateway and services,
+// and carries the ID of each request across them so the lines logged for one
+// request can be found together.
+//
+// The gateway takes the ID from the X-Request-Id header of a request, or
+// generates one, and passes it to the services in the x-request-id gRPC
+// metadata.
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"git.neds.sh/matty/entain/pkg/tracing"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	// RequestIDHeader is the HTTP header a request ID is given and echoed in.
+	RequestIDHeader = "X-Request-Id"
+	// RequestIDKey is the gRPC metadata key a request ID is passed in.
+	RequestIDKey = "x-request-id"
+)
+
+// maxRequestIDLength bounds the IDs callers can give, as they are added to
+// every line logged for the request.
+const maxRequestIDLength = 128
+
+// Formats of the log lines.
+const (
+	FormatJSON    = "json"
+	FormatConsole = "console"
+)
+
+type requestIDKey struct{}
+
+// New creates a logger writing lines of the format to stderr, for messages at
+// the level, e.g. info, or above. Lines written with the standard library log
+// package, such as by the database packages, are redirected to the logger at
+// info level so every line is structured.
+func New(format, level string) (*zap.Logger, error) {
+	var lvl zapcore.Level
+	if err := lvl.UnmarshalText([]byte(level)); err != nil {
+		return nil, fmt.Errorf("invalid log level %q, expected one of: debug, info, warn, error", level)
+	}
+
+	if format != FormatJSON && format != FormatConsole {
+		return nil, fmt.Errorf("invalid log format %q, expected %s or %s", format, FormatJSON, FormatConsole)
+	}
+
+	encoder := zap.NewProductionEncoderConfig()
+	encoder.TimeKey = "time"
+	encoder.EncodeTime = zapcore.ISO8601TimeEncoder
+	if format == FormatConsole {
+		encoder.EncodeLevel = zapcore.CapitalLevelEncoder
+	}
+
+	logger, err := zap.Config{
+		Level:            zap.NewAtomicLevelAt(lvl),
+		Encoding:         format,
+		EncoderConfig:    encoder,
+		OutputPaths:      []string{"stderr"},
+		ErrorOutputPaths: []string{"stderr"},
+		// Stack traces are added where they help, not to every error.
+		DisableStacktrace: true,
+	}.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	zap.RedirectStdLog(logger)
+
+	return logger, nil
+}
+
+// NewRequestID generates a random request ID.
+func NewRequestID() string {
+	var id [8]byte
+	rand.Read(id[:])
+
+	return hex.EncodeToString(id[:])
+}
+
+// ValidRequestID reports whether a request ID given by a caller can be used,
+// it must be short and printable ASCII to be safe to log and echo back.
+func ValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+
+	return strings.IndexFunc(id, func(r rune) bool { return r < ' ' || r > '~' }) < 0
+}
+
+// ContextWithRequestID returns a context carrying the request ID.
+func ContextWithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the request ID of the context, if any.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey{}).(string)
+	return id, ok
+}
+
+// ForRequest returns the logger annotated with the request ID and baggage of
+// the context, if any.
+func ForRequest(ctx context.Context, logger *zap.Logger) *zap.Logger {
+	var fields []zap.Field
+
+	if id, ok := RequestIDFromContext(ctx); ok {
+		fields = append(fields, zap.String("request_id", id))
+	}
+
+	baggage := tracing.BaggageFromContext(ctx)
+	keys := make([]string, 0, len(baggage))
+	for key := range baggage {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		fields = append(fields, zap.String(key, baggage[key]))
+	}
+
+	return logger.With(fields...)
+}
@@ -0,0 +1,77 @@
+// Package logsample decides which requests are logged, by key, with rates
+// that can be changed at runtime so logging can be turned up temporarily.
+package logsample
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+)
+
+// Default is the key of the rate used for keys without their own rate.
+const Default = "*"
+
+// Sampler samples requests at a rate per key.
+type Sampler struct {
+	mu    sync.RWMutex
+	rates map[string]float64
+}
+
+// New creates a sampler logging the fraction defaultRate of requests.
+func New(defaultRate float64) (*Sampler, error) {
+	s := &Sampler{rates: make(map[string]float64)}
+
+	if err := s.Set(Default, defaultRate); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Sample reports whether a request for key should be logged.
+func (s *Sampler) Sample(key string) bool {
+	rate := s.Rate(key)
+
+	return rate > 0 && rand.Float64() < rate
+}
+
+// Rate returns the rate of a key, or the default rate if it has none.
+func (s *Sampler) Rate(key string) float64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if rate, ok := s.rates[key]; ok {
+		return rate
+	}
+
+	return s.rates[Default]
+}
+
+// Set changes the rate of a key, or the default rate for Default.
+func (s *Sampler) Set(key string, rate float64) error {
+	if rate < 0 || rate > 1 {
+		return fmt.Errorf("log sampling rate %v must be between 0 and 1", rate)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rates[key] = rate
+
+	return nil
+}
+
+// Keys returns the keys with a rate, including Default, in a stable order.
+func (s *Sampler) Keys() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var keys []string
+	for key := range s.rates {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,34 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// Queries records the duration of database queries.
+type Queries struct {
+	duration *Histogram
+}
+
+// NewQueries registers the metrics of database queries.
+func NewQueries(r *Registry) *Queries {
+	return &Queries{
+		duration: r.Histogram("db_query_duration_seconds", "Duration of database queries by operation.", DefaultBuckets, "operation"),
+	}
+}
+
+// Observe records a query by its operation, the first keyword of the query
+// such as select or insert. The query text itself would make too many series.
+func (q *Queries) Observe(_ context.Context, query string, elapsed time.Duration) {
+	q.duration.Observe(elapsed.Seconds(), operation(query))
+}
+
+func operation(query string) string {
+	fields := strings.Fields(query)
+	if len(fields) == 0 {
+		return "unknown"
+	}
+
+	return strings.ToLower(fields[0])
+}
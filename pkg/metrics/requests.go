@@ -0,0 +1,127 @@
+package metrics
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Request is a completed request kept by a RequestLog.
+type Request struct {
+	// Method is the RPC name, e.g. ListRaces.
+	Method string
+	// Fields are the paths of the fields set in the request, as given by
+	// RequestFields.
+	Fields []string
+	// Code is the name of the gRPC status code returned.
+	Code    string
+	Start   time.Time
+	Elapsed time.Duration
+}
+
+// RequestLog keeps the last requests completed in a ring buffer, so recent
+// traffic can be inspected without a metrics server or logs.
+type RequestLog struct {
+	mu       sync.Mutex
+	requests []Request
+	// next is where the next request is kept, overwriting the oldest once
+	// the buffer is full.
+	next int
+	full bool
+}
+
+// NewRequestLog creates a log keeping the last size requests, none when it
+// isn't positive.
+func NewRequestLog(size int) *RequestLog {
+	if size < 0 {
+		size = 0
+	}
+
+	return &RequestLog{requests: make([]Request, size)}
+}
+
+// Add keeps a completed request. fullMethod is as given to interceptors, e.g.
+// /racing.Racing/ListRaces.
+func (l *RequestLog) Add(fullMethod string, fields []string, code string, start time.Time, elapsed time.Duration) {
+	if len(l.requests) == 0 {
+		return
+	}
+
+	_, method := splitMethod(fullMethod)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.requests[l.next] = Request{Method: method, Fields: fields, Code: code, Start: start, Elapsed: elapsed}
+
+	l.next++
+	if l.next == len(l.requests) {
+		l.next = 0
+		l.full = true
+	}
+}
+
+// List returns up to limit of the requests kept, newest first, every one
+// when limit is 0. Only the requests of the method are returned unless it is
+// empty.
+func (l *RequestLog) List(method string, limit int) []Request {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	kept := l.next
+	if l.full {
+		kept = len(l.requests)
+	}
+
+	var requests []Request
+
+	for i := 1; i <= kept; i++ {
+		if limit > 0 && len(requests) == limit {
+			break
+		}
+
+		request := l.requests[(l.next-i+len(l.requests))%len(l.requests)]
+		if method != "" && request.Method != method {
+			continue
+		}
+
+		requests = append(requests, request)
+	}
+
+	return requests
+}
+
+// RequestFields returns the paths of the fields set in a request, e.g.
+// filter.meeting_ids, sorted. Their values are left out so requests can be
+// compared by their shape and nothing sensitive is kept.
+func RequestFields(request proto.Message) []string {
+	var fields []string
+
+	var walk func(prefix string, m protoreflect.Message)
+	walk = func(prefix string, m protoreflect.Message) {
+		m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			path := prefix + string(fd.Name())
+
+			// Nested messages are walked for their fields, but not well
+			// known types such as timestamps which are values themselves.
+			if fd.Message() != nil && !fd.IsList() && !fd.IsMap() && fd.Message().FullName().Parent() != "google.protobuf" {
+				before := len(fields)
+				walk(path+".", v.Message())
+				if len(fields) > before {
+					return true
+				}
+			}
+
+			fields = append(fields, path)
+			return true
+		})
+	}
+	walk("", request.ProtoReflect())
+
+	sort.Strings(fields)
+
+	return fields
+}
@@ -0,0 +1,90 @@
+package metrics
+
+import (
+	"strings"
+	"time"
+)
+
+// RecentSpan is the span of time Recent reports requests over.
+const RecentSpan = 5 * time.Minute
+
+// Keys of the recent requests window.
+const (
+	recentRequests = "requests"
+	recentErrors   = "errors"
+)
+
+// serverErrorCodes are the names of the gRPC status codes which are failures
+// of the server rather than of the request, counted as errors by Recent.
+var serverErrorCodes = map[string]bool{
+	"Unknown":          true,
+	"DeadlineExceeded": true,
+	"Unimplemented":    true,
+	"Internal":         true,
+	"Unavailable":      true,
+	"DataLoss":         true,
+}
+
+// RPC records the number and latency of gRPC requests by method.
+type RPC struct {
+	handled  *Counter
+	handling *Histogram
+	recent   *Window
+}
+
+// NewServerRPC registers the metrics of the requests handled by a server.
+func NewServerRPC(r *Registry) *RPC {
+	return &RPC{
+		handled:  r.Counter("grpc_server_handled_total", "Total number of RPCs completed on the server, regardless of success or failure.", "grpc_service", "grpc_method", "grpc_code"),
+		handling: r.Histogram("grpc_server_handling_seconds", "Latency of RPCs handled by the server until completion.", DefaultBuckets, "grpc_service", "grpc_method"),
+		recent:   NewWindow(RecentSpan, 10*time.Second),
+	}
+}
+
+// NewClientRPC registers the metrics of the requests made by a client.
+func NewClientRPC(r *Registry) *RPC {
+	return &RPC{
+		handled:  r.Counter("grpc_client_handled_total", "Total number of RPCs completed by the client, regardless of success or failure.", "grpc_service", "grpc_method", "grpc_code"),
+		handling: r.Histogram("grpc_client_handling_seconds", "Latency of RPCs made by the client until completion.", DefaultBuckets, "grpc_service", "grpc_method"),
+		recent:   NewWindow(RecentSpan, 10*time.Second),
+	}
+}
+
+// Observe records a completed request. fullMethod is as given to
+// interceptors, e.g. /racing.Racing/ListRaces, and code is the name of the
+// gRPC status code returned.
+func (m *RPC) Observe(fullMethod, code string, elapsed time.Duration) {
+	service, method := splitMethod(fullMethod)
+
+	m.handled.Inc(service, method, code)
+	m.handling.Observe(elapsed.Seconds(), service, method)
+
+	m.recent.Add(recentRequests, 1)
+	m.recent.Add(service+"/"+recentRequests, 1)
+	if serverErrorCodes[code] {
+		m.recent.Add(recentErrors, 1)
+		m.recent.Add(service+"/"+recentErrors, 1)
+	}
+}
+
+// Recent returns the number of requests completed over the last RecentSpan,
+// and how many of those failed with a server error. The requests are those
+// of the gRPC service, e.g. racing.Racing, or of every service when empty.
+func (m *RPC) Recent(service string) (requests, errors int64) {
+	prefix := ""
+	if service != "" {
+		prefix = service + "/"
+	}
+
+	return int64(m.recent.Sum(prefix + recentRequests)), int64(m.recent.Sum(prefix + recentErrors))
+}
+
+func splitMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+
+	return "unknown", fullMethod
+}
@@ -0,0 +1,75 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+)
+
+// Window totals values by key over a recent span of time, in slots of a
+// resolution, so recent rates can be reported without a metrics server
+// scraping the cumulative counters.
+type Window struct {
+	span       time.Duration
+	resolution time.Duration
+
+	mu    sync.Mutex
+	slots []windowSlot
+}
+
+// windowSlot holds the totals added during the resolution starting at start.
+type windowSlot struct {
+	start  time.Time
+	totals map[string]float64
+}
+
+// NewWindow creates a window totalling values over the span, which is rounded
+// up to a whole number of resolutions.
+func NewWindow(span, resolution time.Duration) *Window {
+	slots := int((span + resolution - 1) / resolution)
+
+	return &Window{
+		span:       time.Duration(slots) * resolution,
+		resolution: resolution,
+		slots:      make([]windowSlot, slots),
+	}
+}
+
+// Span returns the span of time the window totals over.
+func (w *Window) Span() time.Duration {
+	return w.span
+}
+
+// Add adds a value to the total of the key.
+func (w *Window) Add(key string, value float64) {
+	now := time.Now()
+	start := now.Truncate(w.resolution)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	slot := &w.slots[int(now.UnixNano()/int64(w.resolution))%len(w.slots)]
+	if !slot.start.Equal(start) {
+		slot.start = start
+		slot.totals = make(map[string]float64)
+	}
+
+	slot.totals[key] += value
+}
+
+// Sum returns the total of the key over the span. Slots are dropped whole, so
+// the total can cover up to a resolution less than the span.
+func (w *Window) Sum(key string) float64 {
+	oldest := time.Now().Add(-w.span)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var sum float64
+	for _, slot := range w.slots {
+		if slot.start.After(oldest) {
+			sum += slot.totals[key]
+		}
+	}
+
+	return sum
+}
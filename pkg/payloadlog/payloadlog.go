@@ -0,0 +1,160 @@
+// Package payloadlog decides which request payloads are logged, by method,
+// and renders them with sensitive fields redacted and their size capped. The
+// rules can be changed at runtime to capture requests while debugging.
+package payloadlog
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// DefaultMaxBytes is the size payloads are truncated to when a rule has no
+// limit of its own.
+const DefaultMaxBytes = 4096
+
+// Rule enables logging the payloads of a method.
+type Rule struct {
+	Method string
+	// Redact are the field paths, e.g. race.name, cleared before logging.
+	Redact []string
+	// MaxBytes is the size payloads are truncated to.
+	MaxBytes int
+}
+
+// Logger holds the rules of the methods whose payloads are logged. Methods
+// without a rule are not logged.
+type Logger struct {
+	mu    sync.RWMutex
+	rules map[string]Rule
+}
+
+// New creates a logger with no methods logged.
+func New() *Logger {
+	return &Logger{rules: make(map[string]Rule)}
+}
+
+// Set adds or replaces the rule of a method.
+func (l *Logger) Set(rule Rule) error {
+	if rule.MaxBytes < 0 {
+		return fmt.Errorf("invalid max bytes %d, must not be negative", rule.MaxBytes)
+	}
+	if rule.MaxBytes == 0 {
+		rule.MaxBytes = DefaultMaxBytes
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rules[rule.Method] = rule
+
+	return nil
+}
+
+// Remove stops logging the payloads of a method.
+func (l *Logger) Remove(method string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.rules, method)
+}
+
+// Rule returns the rule of a method, if its payloads are logged.
+func (l *Logger) Rule(method string) (Rule, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	rule, ok := l.rules[method]
+	return rule, ok
+}
+
+// Rules returns the rules ordered by method.
+func (l *Logger) Rules() []Rule {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	rules := make([]Rule, 0, len(l.rules))
+	for _, rule := range l.rules {
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Method < rules[j].Method })
+
+	return rules
+}
+
+// Format renders the payload of a request to method for logging, it reports
+// false if the payloads of the method are not logged.
+func (l *Logger) Format(method string, payload proto.Message) (string, bool) {
+	rule, ok := l.Rule(method)
+	if !ok {
+		return "", false
+	}
+
+	redacted := proto.Clone(payload)
+	for _, path := range rule.Redact {
+		redact(redacted.ProtoReflect(), strings.Split(path, "."))
+	}
+
+	out, err := protojson.Marshal(redacted)
+	if err != nil {
+		return fmt.Sprintf("unrenderable payload: %s", err), true
+	}
+
+	if len(out) > rule.MaxBytes {
+		return fmt.Sprintf("%s... (%d bytes truncated)", out[:rule.MaxBytes], len(out)-rule.MaxBytes), true
+	}
+
+	return string(out), true
+}
+
+// redact clears the field at the path, descending through message fields.
+// Paths which don't match the message are ignored.
+func redact(msg protoreflect.Message, path []string) {
+	field := msg.Descriptor().Fields().ByName(protoreflect.Name(path[0]))
+	if field == nil || !msg.Has(field) {
+		return
+	}
+
+	if len(path) == 1 {
+		msg.Clear(field)
+		return
+	}
+
+	if field.Message() == nil || field.IsList() || field.IsMap() {
+		return
+	}
+
+	redact(msg.Mutable(field).Message(), path[1:])
+}
+
+// ValidatePaths checks redaction paths name fields of the message, through
+// singular message fields.
+func ValidatePaths(desc protoreflect.MessageDescriptor, paths []string) error {
+	for _, path := range paths {
+		msg := desc
+
+		names := strings.Split(path, ".")
+		for i, name := range names {
+			field := msg.Fields().ByName(protoreflect.Name(name))
+			if field == nil {
+				return fmt.Errorf("invalid redact path %q, %s has no field %s", path, msg.FullName(), name)
+			}
+
+			if i == len(names)-1 {
+				break
+			}
+
+			if field.Message() == nil || field.IsList() || field.IsMap() {
+				return fmt.Errorf("invalid redact path %q, %s is not a message", path, name)
+			}
+			msg = field.Message()
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+// Package ratelimit limits how often each client calls each RPC, with a token
+// bucket per client and method so a burst is allowed before requests are
+// held to the rate.
+package ratelimit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"sync"
+	"time"
+)
+
+// Default is the method of the limit used for methods without their own.
+const Default = "*"
+
+// sweepInterval is how often buckets which have refilled are dropped, as a
+// full bucket is the same as none.
+const sweepInterval = 10 * time.Minute
+
+// Limit is the rate a client can call a method at, with bursts of up to Burst
+// requests.
+type Limit struct {
+	// Rate is the requests per second, refilling the bucket.
+	Rate float64 `json:"rate"`
+	// Burst is the size of the bucket.
+	Burst int `json:"burst"`
+}
+
+// Limiter holds the buckets of each client and method.
+type Limiter struct {
+	limits map[string]Limit
+
+	mu      sync.Mutex
+	buckets map[bucketKey]*bucket
+	swept   time.Time
+}
+
+type bucketKey struct {
+	method, client string
+}
+
+type bucket struct {
+	limit  Limit
+	tokens float64
+	filled time.Time
+}
+
+// New creates a limiter with the limits by full method name, e.g.
+// /sports.Sports/ListEvents, or Default. Methods without a limit, when there
+// is no Default, aren't limited.
+func New(limits map[string]Limit) (*Limiter, error) {
+	for method, limit := range limits {
+		if limit.Rate <= 0 || limit.Burst < 1 {
+			return nil, fmt.Errorf("invalid rate limit of %s, the rate must be positive and the burst at least 1", method)
+		}
+	}
+
+	return &Limiter{limits: limits, buckets: make(map[bucketKey]*bucket)}, nil
+}
+
+// Load creates a limiter from a JSON file of limits by method, e.g.
+//
+//	{"*": {"rate": 10, "burst": 20}, "/sports.Sports/ListEvents": {"rate": 50, "burst": 100}}
+func Load(path string) (*Limiter, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var limits map[string]Limit
+	if err := json.Unmarshal(data, &limits); err != nil {
+		return nil, fmt.Errorf("invalid rate limits file %s: %w", path, err)
+	}
+
+	return New(limits)
+}
+
+// Allow takes a token from the bucket of the client for the method. When it
+// is empty the request is refused, with how long until a token is available.
+func (l *Limiter) Allow(method, client string) (time.Duration, bool) {
+	limit, ok := l.limits[method]
+	if !ok {
+		limit, ok = l.limits[Default]
+	}
+	if !ok {
+		return 0, true
+	}
+
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+
+	key := bucketKey{method: method, client: client}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limit: limit, tokens: float64(limit.Burst), filled: now}
+		l.buckets[key] = b
+	}
+
+	b.refill(now)
+
+	if b.tokens < 1 {
+		return time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second)), false
+	}
+
+	b.tokens--
+
+	return 0, true
+}
+
+// sweep drops the buckets of clients which have gone quiet long enough to
+// refill, at most once per sweepInterval, so the buckets of every client ever
+// seen aren't kept.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.swept) < sweepInterval {
+		return
+	}
+	l.swept = now
+
+	for key, b := range l.buckets {
+		if b.refill(now); b.tokens >= float64(b.limit.Burst) {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// refill adds the tokens accrued since the bucket was last filled.
+func (b *bucket) refill(now time.Time) {
+	b.tokens = math.Min(float64(b.limit.Burst), b.tokens+now.Sub(b.filled).Seconds()*b.limit.Rate)
+	b.filled = now
+}
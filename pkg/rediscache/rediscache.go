@@ -0,0 +1,77 @@
+// Package rediscache caches protobuf messages in Redis for a short time, so
+// reads of single resources under load can be served without the database.
+package rediscache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/protobuf/proto"
+)
+
+// Cache holds messages under keys starting with a prefix, each for a TTL.
+type Cache struct {
+	client *redis.Client
+	prefix string
+	ttl    time.Duration
+}
+
+// New creates a cache in the Redis at url, e.g. redis://localhost:6379/0,
+// keeping messages for ttl under keys starting with prefix.
+func New(url, prefix string, ttl time.Duration) (*Cache, error) {
+	options, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cache{client: redis.NewClient(options), prefix: prefix, ttl: ttl}, nil
+}
+
+// Get reads the message cached under the key into m, reporting whether it
+// was cached.
+func (c *Cache) Get(ctx context.Context, key string, m proto.Message) (bool, error) {
+	data, err := c.client.Get(ctx, c.prefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if err := proto.Unmarshal(data, m); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Set caches the message under the key.
+func (c *Cache) Set(ctx context.Context, key string, m proto.Message) error {
+	data, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	return c.client.Set(ctx, c.prefix+key, data, c.ttl).Err()
+}
+
+// Delete drops the messages cached under the keys.
+func (c *Cache) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	prefixed := make([]string, len(keys))
+	for i, key := range keys {
+		prefixed[i] = c.prefix + key
+	}
+
+	return c.client.Del(ctx, prefixed...).Err()
+}
+
+// Close closes the connections to Redis.
+func (c *Cache) Close() error {
+	return c.client.Close()
+}
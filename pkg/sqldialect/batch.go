@@ -0,0 +1,210 @@
+package sqldialect
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// maxReportedRowErrors is the number of failed rows described in a
+// BatchError, the rest are only counted.
+const maxReportedRowErrors = 10
+
+// Batch inserts rows into a table with multi-row inserts, skipping rows with
+// the same primary key as an existing row. Rows are buffered until the batch
+// is full so Flush must be called after the last row.
+//
+// A failed insert doesn't stop the batch, the rows of the failed insert are
+// retried one at a time to find those which failed and the rest are kept.
+type Batch struct {
+	tx      *Tx
+	table   string
+	columns []string
+	size    int
+	args    []interface{}
+	// row is the number of rows added, rows are numbered from 1.
+	row    int
+	full   *sql.Stmt
+	single *sql.Stmt
+	failed []RowError
+}
+
+// RowError is the failure to insert a single row of a batch.
+type RowError struct {
+	// Row is the position of the row in the batch, from 1.
+	Row int
+	Err error
+}
+
+func (e RowError) Error() string {
+	return fmt.Sprintf("row %d: %s", e.Row, e.Err)
+}
+
+// BatchError reports the rows of a batch which failed to insert.
+type BatchError struct {
+	Table  string
+	Rows   int
+	Failed []RowError
+}
+
+func (e *BatchError) Error() string {
+	var reported []string
+	for i, failed := range e.Failed {
+		if i == maxReportedRowErrors {
+			reported = append(reported, fmt.Sprintf("and %d more", len(e.Failed)-i))
+			break
+		}
+		reported = append(reported, failed.Error())
+	}
+
+	return fmt.Sprintf("%d of %d rows failed to insert into %s: %s", len(e.Failed), e.Rows, e.Table, strings.Join(reported, "; "))
+}
+
+// NewBatch creates a batch inserting up to size rows of the columns at once.
+func (tx *Tx) NewBatch(table string, columns []string, size int) *Batch {
+	return &Batch{
+		tx:      tx,
+		table:   table,
+		columns: columns,
+		size:    size,
+		args:    make([]interface{}, 0, size*len(columns)),
+	}
+}
+
+// Add queues a row with a value for each column, inserting the batch once it
+// is full.
+func (b *Batch) Add(ctx context.Context, values ...interface{}) error {
+	if len(values) != len(b.columns) {
+		return fmt.Errorf("row of %d values for the %d columns of %s", len(values), len(b.columns), b.table)
+	}
+
+	b.row++
+	b.args = append(b.args, values...)
+
+	if len(b.args) < b.size*len(b.columns) {
+		return nil
+	}
+
+	return b.flush(ctx)
+}
+
+// Flush inserts any queued rows, closes the batch and returns a *BatchError
+// if any row failed to insert. Other errors abort the batch.
+func (b *Batch) Flush(ctx context.Context) error {
+	defer b.close()
+
+	if err := b.flush(ctx); err != nil {
+		return err
+	}
+
+	if len(b.failed) > 0 {
+		return &BatchError{Table: b.table, Rows: b.row, Failed: b.failed}
+	}
+
+	return nil
+}
+
+func (b *Batch) flush(ctx context.Context) error {
+	rows := len(b.args) / len(b.columns)
+	if rows == 0 {
+		return nil
+	}
+
+	// The statement for a full batch is prepared once, only the last
+	// batch is smaller.
+	var stmt *sql.Stmt
+	if rows == b.size {
+		if b.full == nil {
+			full, err := b.prepare(ctx, rows)
+			if err != nil {
+				return err
+			}
+			b.full = full
+		}
+		stmt = b.full
+	} else {
+		partial, err := b.prepare(ctx, rows)
+		if err != nil {
+			return err
+		}
+		defer partial.Close()
+		stmt = partial
+	}
+
+	defer func() { b.args = b.args[:0] }()
+
+	failed, err := b.savepoint(ctx, func() error {
+		_, err := stmt.ExecContext(ctx, b.tx.db.bind(b.args)...)
+		return err
+	})
+	if err != nil || !failed {
+		return err
+	}
+
+	return b.insertEach(ctx)
+}
+
+// insertEach inserts the queued rows one at a time, recording those which
+// fail.
+func (b *Batch) insertEach(ctx context.Context) error {
+	if b.single == nil {
+		single, err := b.prepare(ctx, 1)
+		if err != nil {
+			return err
+		}
+		b.single = single
+	}
+
+	rows := len(b.args) / len(b.columns)
+	first := b.row - rows + 1
+
+	for i := 0; i < rows; i++ {
+		values := b.args[i*len(b.columns) : (i+1)*len(b.columns)]
+
+		var rowErr error
+		_, err := b.savepoint(ctx, func() error {
+			_, rowErr = b.single.ExecContext(ctx, b.tx.db.bind(values)...)
+			return rowErr
+		})
+		if err != nil {
+			return err
+		}
+
+		if rowErr != nil {
+			b.failed = append(b.failed, RowError{Row: first + i, Err: rowErr})
+		}
+	}
+
+	return nil
+}
+
+// savepoint runs an insert in a savepoint so a failure can be rolled back
+// without aborting the transaction. It reports whether the insert failed,
+// the error is only for failing to manage the savepoint.
+func (b *Batch) savepoint(ctx context.Context, insert func() error) (bool, error) {
+	if _, err := b.tx.ExecContext(ctx, "SAVEPOINT batch_insert"); err != nil {
+		return false, err
+	}
+
+	if err := insert(); err != nil {
+		_, err := b.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT batch_insert")
+		return true, err
+	}
+
+	_, err := b.tx.ExecContext(ctx, "RELEASE SAVEPOINT batch_insert")
+	return false, err
+}
+
+func (b *Batch) prepare(ctx context.Context, rows int) (*sql.Stmt, error) {
+	return b.tx.Tx.PrepareContext(ctx, b.tx.db.Rebind(b.tx.db.Dialect.InsertIgnore(b.table, b.columns, rows)))
+}
+
+func (b *Batch) close() {
+	if b.full != nil {
+		b.full.Close()
+	}
+	if b.single != nil {
+		b.single.Close()
+	}
+}
@@ -0,0 +1,162 @@
+package sqldialect
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+)
+
+// DB is a database handle which rebinds the ? placeholders and time.Time
+// arguments of queries for its dialect.
+type DB struct {
+	*sql.DB
+	Dialect Dialect
+
+	observers []func(ctx context.Context, query string, elapsed time.Duration)
+}
+
+// Open opens a database with a supported driver.
+func Open(driver, dsn string) (*DB, error) {
+	dialect, err := Lookup(driver)
+	if err != nil {
+		return nil, err
+	}
+
+	dsn, err = dialect.DSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DB{DB: db, Dialect: dialect}, nil
+}
+
+// ObserveQueries calls observe with the duration of every query run through
+// the handle or its transactions, e.g. to export metrics. It must be called
+// before the handle is used.
+func (db *DB) ObserveQueries(observe func(ctx context.Context, query string, elapsed time.Duration)) {
+	db.observers = append(db.observers, observe)
+}
+
+// observed reports a query started at start to the observers.
+func (db *DB) observed(ctx context.Context, query string, start time.Time) {
+	elapsed := time.Since(start)
+	for _, observe := range db.observers {
+		observe(ctx, query, elapsed)
+	}
+}
+
+// Rebind replaces the ? placeholders of a query with those of the dialect.
+// A ? within a quoted string literal is left as is.
+func (db *DB) Rebind(query string) string {
+	if db.Dialect.Placeholder(1) == "?" {
+		return query
+	}
+
+	var (
+		rebound strings.Builder
+		n       int
+		quoted  bool
+	)
+
+	for _, r := range query {
+		// An escaped quote, '', closes and reopens the literal so needs no
+		// special case.
+		if r == '\'' {
+			quoted = !quoted
+		}
+
+		if r != '?' || quoted {
+			rebound.WriteRune(r)
+			continue
+		}
+
+		n++
+		rebound.WriteString(db.Dialect.Placeholder(n))
+	}
+
+	return rebound.String()
+}
+
+// bind converts arguments to the values the dialect stores, times are bound
+// as given by TimestampValue.
+func (db *DB) bind(args []interface{}) []interface{} {
+	bound := make([]interface{}, len(args))
+	for i, arg := range args {
+		if t, ok := arg.(time.Time); ok {
+			arg = db.Dialect.TimestampValue(t)
+		}
+		bound[i] = arg
+	}
+	return bound
+}
+
+func (db *DB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	defer db.observed(ctx, query, time.Now())
+	return db.DB.ExecContext(ctx, db.Rebind(query), db.bind(args)...)
+}
+
+func (db *DB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	defer db.observed(ctx, query, time.Now())
+	return db.DB.QueryContext(ctx, db.Rebind(query), db.bind(args)...)
+}
+
+func (db *DB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	defer db.observed(ctx, query, time.Now())
+	return db.DB.QueryRowContext(ctx, db.Rebind(query), db.bind(args)...)
+}
+
+// InsertID runs an insert and returns the id generated for the new row.
+func (db *DB) InsertID(ctx context.Context, query string, args ...interface{}) (int64, error) {
+	if db.Dialect.Returning() {
+		var id int64
+		err := db.QueryRowContext(ctx, query+" RETURNING id", args...).Scan(&id)
+		return id, err
+	}
+
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.LastInsertId()
+}
+
+// CreateTable creates a table with the columns if it doesn't already exist.
+func (db *DB) CreateTable(ctx context.Context, table string, columns []Column) error {
+	definitions := make([]string, len(columns))
+	for i, column := range columns {
+		definitions[i] = column.Name + " " + db.Dialect.ColumnType(column.Type)
+	}
+
+	_, err := db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+table+" ("+strings.Join(definitions, ", ")+")")
+	return err
+}
+
+// TableColumns returns the set of column names in a table. A table which does
+// not exist has no columns.
+func (db *DB) TableColumns(ctx context.Context, table string) (map[string]bool, error) {
+	rows, err := db.QueryContext(ctx, db.Dialect.TableColumns(), table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns := make(map[string]bool)
+
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return nil, err
+		}
+
+		columns[column] = true
+	}
+
+	return columns, rows.Err()
+}
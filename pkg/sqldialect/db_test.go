@@ -0,0 +1,65 @@
+package sqldialect
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRebind(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect Dialect
+		query   string
+		want    string
+	}{
+		{name: "postgres", dialect: Postgres{}, query: "SELECT id FROM races WHERE id = ? AND name = ?", want: "SELECT id FROM races WHERE id = $1 AND name = $2"},
+		{name: "postgres list", dialect: Postgres{}, query: "id IN (?,?,?)", want: "id IN ($1,$2,$3)"},
+		{name: "postgres literal", dialect: Postgres{}, query: "name = 'who?' AND id = ?", want: "name = 'who?' AND id = $1"},
+		{name: "postgres escaped quote", dialect: Postgres{}, query: "name = 'it''s ?' AND id = ?", want: "name = 'it''s ?' AND id = $1"},
+		{name: "postgres no placeholders", dialect: Postgres{}, query: "SELECT 1", want: "SELECT 1"},
+		{name: "mysql", dialect: MySQL{}, query: "SELECT id FROM races WHERE id = ? AND name = 'who?'", want: "SELECT id FROM races WHERE id = ? AND name = 'who?'"},
+		{name: "sqlite", dialect: SQLite{}, query: "id = ? AND name = ?", want: "id = ? AND name = ?"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &DB{Dialect: tt.dialect}
+
+			if got := db.Rebind(tt.query); got != tt.want {
+				t.Errorf("Rebind = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBind(t *testing.T) {
+	sydney := time.FixedZone("AEDT", 11*60*60)
+	start := time.Date(2022, 1, 3, 23, 0, 0, 0, sydney)
+
+	tests := []struct {
+		name    string
+		dialect Dialect
+		want    []interface{}
+	}{
+		{name: "postgres", dialect: Postgres{}, want: []interface{}{int64(1), "tennis", start}},
+		{name: "mysql", dialect: MySQL{}, want: []interface{}{int64(1), "tennis", start.UTC()}},
+		{name: "sqlite", dialect: SQLite{}, want: []interface{}{int64(1), "tennis", "2022-01-03T23:00:00+11:00"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &DB{Dialect: tt.dialect}
+			args := []interface{}{int64(1), "tennis", start}
+
+			got := db.bind(args)
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("bind = %v, want %v", got, tt.want)
+			}
+			if args[2] != start {
+				t.Errorf("bind modified the arguments, got %v", args)
+			}
+		})
+	}
+}
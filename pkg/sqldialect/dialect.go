@@ -0,0 +1,96 @@
+// Package sqldialect hides the differences between the databases the
+// services can run against. Queries are written with ? placeholders and
+// rebound for the dialect when executed through a DB.
+package sqldialect
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Type is a portable column type, mapped to a concrete type by each dialect.
+type Type int
+
+const (
+	// Serial is an auto incrementing integer primary key.
+	Serial Type = iota
+	Integer
+	Text
+	Boolean
+	Timestamp
+	// Real is a double precision floating point number.
+	Real
+)
+
+// Column is a column of a table created by a repository.
+type Column struct {
+	Name string
+	Type Type
+}
+
+// Dialect is the SQL syntax of a database.
+type Dialect interface {
+	// Driver is the database/sql driver name.
+	Driver() string
+	// Placeholder returns the nth, starting at 1, bound parameter.
+	Placeholder(n int) string
+	// Timestamp wraps a timestamp column or parameter so they compare as
+	// instants regardless of the offset they were stored with.
+	Timestamp(expr string) string
+	// TimestampValue converts a time to the value bound for a timestamp
+	// parameter.
+	TimestampValue(t time.Time) interface{}
+	// DSN adjusts a data source name with any options the dialect relies
+	// on, such as parsing timestamps.
+	DSN(dsn string) (string, error)
+	// ColumnType returns the column type used in CREATE TABLE.
+	ColumnType(t Type) string
+	// InsertIgnore returns an insert of the columns for a number of rows
+	// which skips rows with the same primary key as an existing row.
+	InsertIgnore(table string, columns []string, rows int) string
+	// TableColumns returns a query for the column names of the table given
+	// as its single parameter. A missing table has no columns.
+	TableColumns() string
+	// Returning reports whether inserts can return the generated id with
+	// RETURNING rather than through LastInsertId.
+	Returning() bool
+	// SyncSequence returns a statement moving the sequence of a serial
+	// column past rows inserted with explicit ids, or "" if not needed.
+	SyncSequence(table, column string) string
+}
+
+// dialects are the supported dialects by driver name.
+var dialects = map[string]Dialect{}
+
+func register(dialect Dialect) {
+	dialects[dialect.Driver()] = dialect
+}
+
+// Lookup returns the dialect for a database/sql driver name.
+func Lookup(driver string) (Dialect, error) {
+	dialect, ok := dialects[driver]
+	if !ok {
+		return nil, fmt.Errorf("unsupported database driver %q, supported drivers are: %s", driver, strings.Join(Drivers(), ", "))
+	}
+
+	return dialect, nil
+}
+
+// Drivers returns the names of the supported drivers.
+func Drivers() []string {
+	var drivers []string
+	for driver := range dialects {
+		drivers = append(drivers, driver)
+	}
+	sort.Strings(drivers)
+	return drivers
+}
+
+// insertValues builds the "table (a, b) VALUES (?, ?), (?, ?)" part of an
+// insert of a number of rows.
+func insertValues(table string, columns []string, rows int) string {
+	row := "(" + strings.Repeat("?, ", len(columns)-1) + "?)"
+	return table + " (" + strings.Join(columns, ", ") + ") VALUES " + strings.Repeat(row+", ", rows-1) + row
+}
@@ -0,0 +1,79 @@
+package sqldialect
+
+import (
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// MySQL is the dialect of MySQL through go-sql-driver/mysql. Timestamps are
+// stored as DATETIME in UTC.
+type MySQL struct{}
+
+func init() {
+	register(MySQL{})
+}
+
+func (MySQL) Driver() string {
+	return "mysql"
+}
+
+func (MySQL) Placeholder(int) string {
+	return "?"
+}
+
+// Timestamp leaves expressions as is, every DATETIME is already UTC.
+func (MySQL) Timestamp(expr string) string {
+	return expr
+}
+
+func (MySQL) TimestampValue(t time.Time) interface{} {
+	return t.UTC()
+}
+
+// DSN turns on parsing DATETIME columns into time.Time, in UTC, as scans
+// expect.
+func (MySQL) DSN(dsn string) (string, error) {
+	config, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return "", err
+	}
+
+	config.ParseTime = true
+	config.Loc = time.UTC
+
+	return config.FormatDSN(), nil
+}
+
+func (MySQL) ColumnType(t Type) string {
+	switch t {
+	case Serial:
+		return "BIGINT AUTO_INCREMENT PRIMARY KEY"
+	case Text:
+		return "TEXT"
+	case Boolean:
+		return "BOOLEAN"
+	case Timestamp:
+		return "DATETIME"
+	case Real:
+		return "DOUBLE"
+	}
+	return "BIGINT"
+}
+
+func (MySQL) InsertIgnore(table string, columns []string, rows int) string {
+	return "INSERT IGNORE INTO " + insertValues(table, columns, rows)
+}
+
+func (MySQL) TableColumns() string {
+	return "SELECT column_name FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = ?"
+}
+
+func (MySQL) Returning() bool {
+	return false
+}
+
+// SyncSequence is not needed as AUTO_INCREMENT moves past explicit ids.
+func (MySQL) SyncSequence(string, string) string {
+	return ""
+}
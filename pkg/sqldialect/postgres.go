@@ -0,0 +1,67 @@
+package sqldialect
+
+import (
+	"strconv"
+	"time"
+
+	_ "github.com/lib/pq"
+)
+
+// Postgres is the dialect of PostgreSQL through lib/pq.
+type Postgres struct{}
+
+func init() {
+	register(Postgres{})
+}
+
+func (Postgres) Driver() string {
+	return "postgres"
+}
+
+func (Postgres) Placeholder(n int) string {
+	return "$" + strconv.Itoa(n)
+}
+
+func (Postgres) Timestamp(expr string) string {
+	return "CAST(" + expr + " AS TIMESTAMPTZ)"
+}
+
+func (Postgres) TimestampValue(t time.Time) interface{} {
+	return t
+}
+
+func (Postgres) DSN(dsn string) (string, error) {
+	return dsn, nil
+}
+
+func (Postgres) ColumnType(t Type) string {
+	switch t {
+	case Serial:
+		return "BIGSERIAL PRIMARY KEY"
+	case Text:
+		return "TEXT"
+	case Boolean:
+		return "BOOLEAN"
+	case Timestamp:
+		return "TIMESTAMPTZ"
+	case Real:
+		return "DOUBLE PRECISION"
+	}
+	return "BIGINT"
+}
+
+func (Postgres) InsertIgnore(table string, columns []string, rows int) string {
+	return "INSERT INTO " + insertValues(table, columns, rows) + " ON CONFLICT DO NOTHING"
+}
+
+func (Postgres) TableColumns() string {
+	return "SELECT column_name FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = ?"
+}
+
+func (Postgres) Returning() bool {
+	return true
+}
+
+func (Postgres) SyncSequence(table, column string) string {
+	return "SELECT setval(pg_get_serial_sequence('" + table + "', '" + column + "'), COALESCE(MAX(" + column + "), 1)) FROM " + table
+}
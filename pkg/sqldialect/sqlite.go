@@ -0,0 +1,69 @@
+package sqldialect
+
+import (
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// SQLite is the dialect of SQLite through mattn/go-sqlite3.
+type SQLite struct{}
+
+func init() {
+	register(SQLite{})
+}
+
+func (SQLite) Driver() string {
+	return "sqlite3"
+}
+
+func (SQLite) Placeholder(int) string {
+	return "?"
+}
+
+// Timestamp normalises to UTC with datetime() as start times are stored as
+// text with a local offset.
+func (SQLite) Timestamp(expr string) string {
+	return "datetime(" + expr + ")"
+}
+
+// TimestampValue stores times as RFC3339 text keeping their offset, as the
+// seeded databases always have.
+func (SQLite) TimestampValue(t time.Time) interface{} {
+	return t.Format(time.RFC3339)
+}
+
+func (SQLite) DSN(dsn string) (string, error) {
+	return dsn, nil
+}
+
+func (SQLite) ColumnType(t Type) string {
+	switch t {
+	case Serial:
+		return "INTEGER PRIMARY KEY"
+	case Text:
+		return "TEXT"
+	case Timestamp:
+		return "DATETIME"
+	case Real:
+		return "REAL"
+	}
+	// SQLite has no boolean type.
+	return "INTEGER"
+}
+
+func (SQLite) InsertIgnore(table string, columns []string, rows int) string {
+	return "INSERT OR IGNORE INTO " + insertValues(table, columns, rows)
+}
+
+func (SQLite) TableColumns() string {
+	return "SELECT name FROM pragma_table_info(?)"
+}
+
+func (SQLite) Returning() bool {
+	return false
+}
+
+func (SQLite) SyncSequence(string, string) string {
+	return ""
+}
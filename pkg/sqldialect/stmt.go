@@ -0,0 +1,41 @@
+package sqldialect
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// Stmt is a prepared statement which binds arguments for its dialect and
+// reports its queries to the observers of its DB, like DB.
+type Stmt struct {
+	*sql.Stmt
+	db    *DB
+	query string
+}
+
+// PrepareContext prepares a query with ? placeholders, for queries run often
+// enough that parsing them once is worth it. The statement must be closed.
+func (db *DB) PrepareContext(ctx context.Context, query string) (*Stmt, error) {
+	stmt, err := db.DB.PrepareContext(ctx, db.Rebind(query))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Stmt{Stmt: stmt, db: db, query: query}, nil
+}
+
+func (s *Stmt) ExecContext(ctx context.Context, args ...interface{}) (sql.Result, error) {
+	defer s.db.observed(ctx, s.query, time.Now())
+	return s.Stmt.ExecContext(ctx, s.db.bind(args)...)
+}
+
+func (s *Stmt) QueryContext(ctx context.Context, args ...interface{}) (*sql.Rows, error) {
+	defer s.db.observed(ctx, s.query, time.Now())
+	return s.Stmt.QueryContext(ctx, s.db.bind(args)...)
+}
+
+func (s *Stmt) QueryRowContext(ctx context.Context, args ...interface{}) *sql.Row {
+	defer s.db.observed(ctx, s.query, time.Now())
+	return s.Stmt.QueryRowContext(ctx, s.db.bind(args)...)
+}
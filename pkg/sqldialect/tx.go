@@ -0,0 +1,38 @@
+package sqldialect
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// Tx is a transaction which rebinds queries for its dialect like DB.
+type Tx struct {
+	*sql.Tx
+	db *DB
+}
+
+// BeginTx starts a transaction.
+func (db *DB) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	tx, err := db.DB.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Tx{Tx: tx, db: db}, nil
+}
+
+func (tx *Tx) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	defer tx.db.observed(ctx, query, time.Now())
+	return tx.Tx.ExecContext(ctx, tx.db.Rebind(query), tx.db.bind(args)...)
+}
+
+func (tx *Tx) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	defer tx.db.observed(ctx, query, time.Now())
+	return tx.Tx.QueryContext(ctx, tx.db.Rebind(query), tx.db.bind(args)...)
+}
+
+func (tx *Tx) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	defer tx.db.observed(ctx, query, time.Now())
+	return tx.Tx.QueryRowContext(ctx, tx.db.Rebind(query), tx.db.bind(args)...)
+}
@@ -0,0 +1,194 @@
+// Package sqlfilter builds the WHERE and ORDER BY clauses of list queries from
+// a declarative description of the fields a repository supports, so filters
+// and sort fields are added in one place rather than per repository.
+package sqlfilter
+
+import (
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Op is the comparison a filter field applies to its columns.
+type Op int
+
+const (
+	// Equal matches rows whose column equals the value, or any of the values
+	// for repeated fields.
+	Equal Op = iota
+	// AtOrAfter matches rows whose timestamp column is at or after the value.
+	// Timestamps are bound as time.Time so must be executed through a
+	// sqldialect.DB.
+	AtOrAfter
+	// Before matches rows whose timestamp column is strictly before the value.
+	Before
+	// Within matches rows whose timestamp column is from now, by the clock
+	// given to Apply, until the duration value from now.
+	Within
+	// Contains matches rows whose text column contains the value, ignoring
+	// case.
+	Contains
+	// Matches matches rows of an id keyed table indexed by the FTS5 table
+	// given as the column, see package fulltext, matching the value as an
+	// FTS5 query.
+	Matches
+	// IsSet matches rows whose column is not null, or null when the value is
+	// false.
+	IsSet
+	// NotEqual matches rows whose column differs from the value, or from all
+	// of the values for repeated fields. Fields with several columns match
+	// rows where any of them differ, so it is meant for single columns.
+	NotEqual
+)
+
+// likeEscaper escapes the wildcards of LIKE patterns, with an escape character
+// which needs no escaping in the string literals of any dialect.
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
+// ContainsPattern returns the LIKE pattern, escaped with '!', matching lower
+// case text containing the value, for queries matching as Contains does.
+func ContainsPattern(value string) string {
+	return "%" + likeEscaper.Replace(strings.ToLower(value)) + "%"
+}
+
+// Field maps a field of a filter message onto the columns it is compared
+// against. A row matches if any of the columns match.
+type Field struct {
+	Name    protoreflect.Name
+	Columns []string
+	Op      Op
+}
+
+// Filters are the fields of a filter message which can be queried on.
+type Filters []Field
+
+// Dialect is the part of the SQL dialect filters depend on, see
+// sqldialect.Dialect.
+type Dialect interface {
+	Timestamp(expr string) string
+}
+
+// Apply appends a WHERE clause to the query for every populated field of the
+// filter and returns the arguments for it. Unset fields and empty lists are
+// ignored, as are fields of the message which aren't registered. The clock now
+// is only read for Within fields, so may be nil for filters without any.
+func (f Filters) Apply(dialect Dialect, now func() time.Time, query string, filter proto.Message) (string, []interface{}) {
+	var (
+		clauses []string
+		args    []interface{}
+	)
+
+	if filter == nil {
+		return query, args
+	}
+
+	msg := filter.ProtoReflect()
+	if !msg.IsValid() {
+		return query, args
+	}
+
+	for _, field := range f {
+		fd := msg.Descriptor().Fields().ByName(field.Name)
+		if fd == nil || !msg.Has(fd) {
+			continue
+		}
+
+		clause, clauseArgs := field.clause(dialect, now, fd, msg.Get(fd))
+		clauses = append(clauses, clause)
+		args = append(args, clauseArgs...)
+	}
+
+	if len(clauses) != 0 {
+		query += " WHERE " + strings.Join(clauses, " AND ")
+	}
+
+	return query, args
+}
+
+// Shape returns the names of the filters set, e.g. "ids,visible", or "none",
+// describing a filter without its values, e.g. in errors.
+func (f Filters) Shape(filter proto.Message) string {
+	var names []string
+
+	if filter != nil && filter.ProtoReflect().IsValid() {
+		msg := filter.ProtoReflect()
+
+		for _, field := range f {
+			if fd := msg.Descriptor().Fields().ByName(field.Name); fd != nil && msg.Has(fd) {
+				names = append(names, string(field.Name))
+			}
+		}
+	}
+
+	if len(names) == 0 {
+		return "none"
+	}
+
+	return strings.Join(names, ",")
+}
+
+func (f Field) clause(dialect Dialect, now func() time.Time, fd protoreflect.FieldDescriptor, value protoreflect.Value) (string, []interface{}) {
+	var (
+		conditions []string
+		args       []interface{}
+	)
+
+	for _, column := range f.Columns {
+		condition, conditionArgs := f.condition(dialect, now, column, fd, value)
+		conditions = append(conditions, condition)
+		args = append(args, conditionArgs...)
+	}
+
+	if len(conditions) == 1 {
+		return conditions[0], args
+	}
+
+	return "(" + strings.Join(conditions, " OR ") + ")", args
+}
+
+func (f Field) condition(dialect Dialect, now func() time.Time, column string, fd protoreflect.FieldDescriptor, value protoreflect.Value) (string, []interface{}) {
+	switch f.Op {
+	case AtOrAfter, Before:
+		operator := ">="
+		if f.Op == Before {
+			operator = "<"
+		}
+
+		ts, _ := value.Message().Interface().(*timestamppb.Timestamp)
+		return dialect.Timestamp(column) + " " + operator + " " + dialect.Timestamp("?"), []interface{}{ts.AsTime()}
+	case Within:
+		d, _ := value.Message().Interface().(*durationpb.Duration)
+		from := now()
+		return "(" + dialect.Timestamp(column) + " >= " + dialect.Timestamp("?") + " AND " + dialect.Timestamp(column) + " < " + dialect.Timestamp("?") + ")", []interface{}{from, from.Add(d.AsDuration())}
+	case Contains:
+		return "LOWER(" + column + ") LIKE ? ESCAPE '!'", []interface{}{ContainsPattern(value.String())}
+	case Matches:
+		return "id IN (SELECT rowid FROM " + column + " WHERE " + column + " MATCH ?)", []interface{}{value.String()}
+	case IsSet:
+		if value.Bool() {
+			return column + " IS NOT NULL", nil
+		}
+		return column + " IS NULL", nil
+	}
+
+	operator, listOperator := "=", "IN"
+	if f.Op == NotEqual {
+		operator, listOperator = "<>", "NOT IN"
+	}
+
+	if !fd.IsList() {
+		return column + " " + operator + " ?", []interface{}{value.Interface()}
+	}
+
+	list := value.List()
+	args := make([]interface{}, list.Len())
+	for i := range args {
+		args[i] = list.Get(i).Interface()
+	}
+
+	return column + " " + listOperator + " (" + strings.Repeat("?,", len(args)-1) + "?)", args
+}
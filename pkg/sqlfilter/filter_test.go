@@ -0,0 +1,234 @@
+package sqlfilter
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// testDialect wraps timestamps so tests can tell they were converted.
+type testDialect struct{}
+
+func (testDialect) Timestamp(expr string) string {
+	return "ts(" + expr + ")"
+}
+
+// filterDescriptor is a filter message with a field of each kind filters are
+// declared on. It is proto2 so the presence of scalars, e.g. a false bool, is
+// recorded.
+var filterDescriptor = func() protoreflect.MessageDescriptor {
+	optional := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+	repeated := descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	field := func(name string, number int32, label *descriptorpb.FieldDescriptorProto_Label, kind descriptorpb.FieldDescriptorProto_Type, typeName string) *descriptorpb.FieldDescriptorProto {
+		fd := &descriptorpb.FieldDescriptorProto{Name: proto.String(name), Number: proto.Int32(number), Label: label, Type: kind.Enum()}
+		if typeName != "" {
+			fd.TypeName = proto.String(typeName)
+		}
+		return fd
+	}
+
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:       proto.String("sqlfilter_test.proto"),
+		Package:    proto.String("sqlfilter.test"),
+		Syntax:     proto.String("proto2"),
+		Dependency: []string{"google/protobuf/duration.proto", "google/protobuf/timestamp.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Filter"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("sport", 1, optional, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+				field("ids", 2, repeated, descriptorpb.FieldDescriptorProto_TYPE_INT64, ""),
+				field("name_contains", 3, optional, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+				field("settled", 4, optional, descriptorpb.FieldDescriptorProto_TYPE_BOOL, ""),
+				field("after", 5, optional, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".google.protobuf.Timestamp"),
+				field("within", 6, optional, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".google.protobuf.Duration"),
+			},
+		}},
+	}, protoregistry.GlobalFiles)
+	if err != nil {
+		panic(err)
+	}
+
+	return file.Messages().ByName("Filter")
+}()
+
+// newFilter returns a filter message with the fields set.
+func newFilter(fields map[protoreflect.Name]interface{}) proto.Message {
+	msg := dynamicpb.NewMessage(filterDescriptor)
+
+	for name, value := range fields {
+		fd := filterDescriptor.Fields().ByName(name)
+
+		switch value := value.(type) {
+		case []int64:
+			list := msg.Mutable(fd).List()
+			for _, v := range value {
+				list.Append(protoreflect.ValueOfInt64(v))
+			}
+		case proto.Message:
+			msg.Set(fd, protoreflect.ValueOfMessage(value.ProtoReflect()))
+		default:
+			msg.Set(fd, protoreflect.ValueOf(value))
+		}
+	}
+
+	return msg
+}
+
+func TestFiltersApply(t *testing.T) {
+	now := time.Date(2022, 1, 3, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	tests := []struct {
+		name      string
+		field     Field
+		filter    map[protoreflect.Name]interface{}
+		wantQuery string
+		wantArgs  []interface{}
+	}{
+		{
+			name:      "unset",
+			field:     Field{Name: "sport", Columns: []string{"sport"}},
+			wantQuery: "SELECT",
+		},
+		{
+			name:      "equal",
+			field:     Field{Name: "sport", Columns: []string{"sport"}},
+			filter:    map[protoreflect.Name]interface{}{"sport": "tennis"},
+			wantQuery: "SELECT WHERE sport = ?",
+			wantArgs:  []interface{}{"tennis"},
+		},
+		{
+			name:      "equal any column",
+			field:     Field{Name: "sport", Columns: []string{"home", "away"}},
+			filter:    map[protoreflect.Name]interface{}{"sport": "tennis"},
+			wantQuery: "SELECT WHERE (home = ? OR away = ?)",
+			wantArgs:  []interface{}{"tennis", "tennis"},
+		},
+		{
+			name:      "not equal",
+			field:     Field{Name: "sport", Columns: []string{"sport"}, Op: NotEqual},
+			filter:    map[protoreflect.Name]interface{}{"sport": "tennis"},
+			wantQuery: "SELECT WHERE sport <> ?",
+			wantArgs:  []interface{}{"tennis"},
+		},
+		{
+			name:      "in",
+			field:     Field{Name: "ids", Columns: []string{"id"}},
+			filter:    map[protoreflect.Name]interface{}{"ids": []int64{1, 2, 3}},
+			wantQuery: "SELECT WHERE id IN (?,?,?)",
+			wantArgs:  []interface{}{int64(1), int64(2), int64(3)},
+		},
+		{
+			name:      "not in",
+			field:     Field{Name: "ids", Columns: []string{"id"}, Op: NotEqual},
+			filter:    map[protoreflect.Name]interface{}{"ids": []int64{4}},
+			wantQuery: "SELECT WHERE id NOT IN (?)",
+			wantArgs:  []interface{}{int64(4)},
+		},
+		{
+			name:      "empty list",
+			field:     Field{Name: "ids", Columns: []string{"id"}},
+			filter:    map[protoreflect.Name]interface{}{"ids": []int64{}},
+			wantQuery: "SELECT",
+		},
+		{
+			name:      "contains",
+			field:     Field{Name: "name_contains", Columns: []string{"name"}, Op: Contains},
+			filter:    map[protoreflect.Name]interface{}{"name_contains": "Cup"},
+			wantQuery: "SELECT WHERE LOWER(name) LIKE ? ESCAPE '!'",
+			wantArgs:  []interface{}{"%cup%"},
+		},
+		{
+			name:      "contains wildcards",
+			field:     Field{Name: "name_contains", Columns: []string{"name"}, Op: Contains},
+			filter:    map[protoreflect.Name]interface{}{"name_contains": "50%_off!"},
+			wantQuery: "SELECT WHERE LOWER(name) LIKE ? ESCAPE '!'",
+			wantArgs:  []interface{}{"%50!%!_off!!%"},
+		},
+		{
+			name:      "is set",
+			field:     Field{Name: "settled", Columns: []string{"settled_at"}, Op: IsSet},
+			filter:    map[protoreflect.Name]interface{}{"settled": true},
+			wantQuery: "SELECT WHERE settled_at IS NOT NULL",
+		},
+		{
+			name:      "is not set",
+			field:     Field{Name: "settled", Columns: []string{"settled_at"}, Op: IsSet},
+			filter:    map[protoreflect.Name]interface{}{"settled": false},
+			wantQuery: "SELECT WHERE settled_at IS NULL",
+		},
+		{
+			name:      "at or after",
+			field:     Field{Name: "after", Columns: []string{"start"}, Op: AtOrAfter},
+			filter:    map[protoreflect.Name]interface{}{"after": timestamppb.New(now)},
+			wantQuery: "SELECT WHERE ts(start) >= ts(?)",
+			wantArgs:  []interface{}{now},
+		},
+		{
+			name:      "before",
+			field:     Field{Name: "after", Columns: []string{"start"}, Op: Before},
+			filter:    map[protoreflect.Name]interface{}{"after": timestamppb.New(now)},
+			wantQuery: "SELECT WHERE ts(start) < ts(?)",
+			wantArgs:  []interface{}{now},
+		},
+		{
+			name:      "within",
+			field:     Field{Name: "within", Columns: []string{"start"}, Op: Within},
+			filter:    map[protoreflect.Name]interface{}{"within": durationpb.New(time.Hour)},
+			wantQuery: "SELECT WHERE (ts(start) >= ts(?) AND ts(start) < ts(?))",
+			wantArgs:  []interface{}{now, now.Add(time.Hour)},
+		},
+		{
+			name:      "matches",
+			field:     Field{Name: "name_contains", Columns: []string{"races_fts"}, Op: Matches},
+			filter:    map[protoreflect.Name]interface{}{"name_contains": "cup*"},
+			wantQuery: "SELECT WHERE id IN (SELECT rowid FROM races_fts WHERE races_fts MATCH ?)",
+			wantArgs:  []interface{}{"cup*"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, args := Filters{tt.field}.Apply(testDialect{}, clock, "SELECT", newFilter(tt.filter))
+
+			if query != tt.wantQuery {
+				t.Errorf("query = %q, want %q", query, tt.wantQuery)
+			}
+			if len(args) != 0 || len(tt.wantArgs) != 0 {
+				if !reflect.DeepEqual(args, tt.wantArgs) {
+					t.Errorf("args = %v, want %v", args, tt.wantArgs)
+				}
+			}
+		})
+	}
+}
+
+func TestFiltersApplyJoinsFields(t *testing.T) {
+	filters := Filters{
+		{Name: "sport", Columns: []string{"sport"}},
+		{Name: "ids", Columns: []string{"id"}},
+		{Name: "settled", Columns: []string{"settled_at"}, Op: IsSet},
+	}
+	filter := newFilter(map[protoreflect.Name]interface{}{"sport": "tennis", "ids": []int64{7}})
+
+	query, args := filters.Apply(testDialect{}, nil, "SELECT", filter)
+
+	if want := "SELECT WHERE sport = ? AND id IN (?)"; query != want {
+		t.Errorf("query = %q, want %q", query, want)
+	}
+	if want := []interface{}{"tennis", int64(7)}; !reflect.DeepEqual(args, want) {
+		t.Errorf("args = %v, want %v", args, want)
+	}
+	if got, want := filters.Shape(filter), "sport,ids"; got != want {
+		t.Errorf("Shape = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,97 @@
+package sqlfilter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Sortable maps the fields which can be given in an order_by to their
+// columns. Important to verify against allowed field names to protect from
+// SQL injection.
+type Sortable map[string]string
+
+// Error is returned when an order_by is invalid, the message is safe to
+// return to the caller.
+type Error struct {
+	Reason string
+}
+
+func (e *Error) Error() string {
+	return e.Reason
+}
+
+func errorf(format string, args ...interface{}) error {
+	return &Error{Reason: fmt.Sprintf(format, args...)}
+}
+
+// Apply appends the ordering specified in the request to the SQL SELECT
+// query. The format of the order_by in the query is a comma seperated list of
+// fields with "desc" as a suffix to change the ordering.
+// e.g. "advertised_start_time, name desc". Rows are ordered by id last, see
+// OrderBy.
+// https://cloud.google.com/apis/design/design_patterns#sorting_order
+func (s Sortable) Apply(query string, orderBy *string) (string, error) {
+	columns, err := s.Columns(orderBy)
+	if err != nil {
+		return "", err
+	}
+
+	return query + OrderBy(columns), nil
+}
+
+// Columns returns the columns of the ORDER BY clause specified by orderBy,
+// e.g. "advertised_start_time, name DESC", or "" when it is unset, so they
+// can be combined with an ordering of the repository's own.
+func (s Sortable) Columns(orderBy *string) (string, error) {
+	if orderBy == nil || strings.TrimSpace(*orderBy) == "" {
+		return "", nil
+	}
+
+	var sqls []string
+
+	for _, orderByField := range strings.Split(*orderBy, ",") {
+		orderByFieldSql, err := s.fieldToSql(orderByField)
+		if err != nil {
+			return "", err
+		}
+		sqls = append(sqls, orderByFieldSql)
+	}
+
+	return strings.Join(sqls, ", "), nil
+}
+
+// Fields returns the sortable field names in a stable order.
+func (s Sortable) Fields() []string {
+	var fields []string
+	for field := range s {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func (s Sortable) fieldToSql(orderByField string) (string, error) {
+	orderByFieldSplit := strings.Fields(orderByField)
+	if len(orderByFieldSplit) == 0 || len(orderByFieldSplit) > 2 {
+		return "", errorf("invalid order_by %q, expected a field optionally followed by asc or desc", orderByField)
+	}
+
+	field := orderByFieldSplit[0]
+	orderByFieldSql, ok := s[field]
+	if !ok {
+		return "", errorf("cannot order by %q, allowed fields are: %s", field, strings.Join(s.Fields(), ", "))
+	}
+
+	if len(orderByFieldSplit) == 2 {
+		switch strings.ToLower(orderByFieldSplit[1]) {
+		case "asc":
+		case "desc":
+			orderByFieldSql += " DESC"
+		default:
+			return "", errorf("invalid order_by direction %q for %s, expected asc or desc", orderByFieldSplit[1], field)
+		}
+	}
+
+	return orderByFieldSql, nil
+}
@@ -0,0 +1,52 @@
+package sqlfilter
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSortableApply(t *testing.T) {
+	sortable := Sortable{
+		"name":                  "name",
+		"advertised_start_time": "advertised_start_time",
+	}
+
+	tests := []struct {
+		name    string
+		orderBy *string
+		want    string
+		invalid bool
+	}{
+		{name: "unset", orderBy: nil, want: "SELECT ORDER BY id"},
+		{name: "blank", orderBy: stringPtr("  "), want: "SELECT ORDER BY id"},
+		{name: "field", orderBy: stringPtr("name"), want: "SELECT ORDER BY name, id"},
+		{name: "asc", orderBy: stringPtr("name asc"), want: "SELECT ORDER BY name, id"},
+		{name: "desc", orderBy: stringPtr("name DESC"), want: "SELECT ORDER BY name DESC, id"},
+		{name: "several", orderBy: stringPtr("advertised_start_time, name desc"), want: "SELECT ORDER BY advertised_start_time, name DESC, id"},
+		{name: "unknown field", orderBy: stringPtr("id; DROP TABLE races"), invalid: true},
+		{name: "unknown direction", orderBy: stringPtr("name sideways"), invalid: true},
+		{name: "too many words", orderBy: stringPtr("name desc please"), invalid: true},
+		{name: "empty field", orderBy: stringPtr("name,"), invalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := sortable.Apply("SELECT", tt.orderBy)
+
+			var orderErr *Error
+			if invalid := errors.As(err, &orderErr); invalid != tt.invalid {
+				t.Fatalf("err = %v, want invalid %t", err, tt.invalid)
+			}
+			if !tt.invalid && err != nil {
+				t.Fatalf("err = %v, want nil", err)
+			}
+			if got != tt.want {
+				t.Errorf("query = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,114 @@
+package sqlfilter
+
+import (
+	"encoding/base64"
+	"strconv"
+)
+
+const (
+	// DefaultPageSize is used when a page token is given without a size.
+	DefaultPageSize = 100
+	// MaxPageSize is the largest page returned, larger sizes are coerced.
+	MaxPageSize = 1000
+)
+
+// tiebreaker is the unique column ordering rows last, so rows which sort
+// equally on the requested columns come in the same order on every page.
+const tiebreaker = "id"
+
+// OrderBy returns the ORDER BY clause for the columns, e.g. "name DESC", or
+// just the tiebreaker when there are none. LIMIT and OFFSET only page through
+// a list consistently when its order is total.
+func OrderBy(columns string) string {
+	if columns == "" {
+		return " ORDER BY " + tiebreaker
+	}
+
+	return " ORDER BY " + columns + ", " + tiebreaker
+}
+
+// Page is a window of a list query.
+type Page struct {
+	Size   int32
+	Offset int64
+}
+
+// ParsePage builds the page for a page_size and page_token. A nil page, and
+// no error, is returned when neither are given so all rows are listed.
+func ParsePage(size int32, token string) (*Page, error) {
+	if size < 0 {
+		return nil, errorf("invalid page_size %d, must not be negative", size)
+	}
+
+	if size == 0 && token == "" {
+		return nil, nil
+	}
+
+	page := &Page{Size: size}
+
+	if page.Size == 0 {
+		page.Size = DefaultPageSize
+	}
+	if page.Size > MaxPageSize {
+		page.Size = MaxPageSize
+	}
+
+	if token != "" {
+		offset, err := decodeToken(token)
+		if err != nil {
+			return nil, errorf("invalid page_token %q", token)
+		}
+		page.Offset = offset
+	}
+
+	return page, nil
+}
+
+// Apply appends the LIMIT and OFFSET of the page to the query. A nil page
+// leaves the query unchanged.
+func (p *Page) Apply(query string) string {
+	if p == nil {
+		return query
+	}
+
+	return query + " LIMIT " + strconv.Itoa(int(p.Size)) + " OFFSET " + strconv.FormatInt(p.Offset, 10)
+}
+
+// NextToken returns the token for the page following this one given the
+// number of rows returned and the total matching rows, or "" if this is the
+// last page.
+func (p *Page) NextToken(returned int, total int64) string {
+	if p == nil {
+		return ""
+	}
+
+	next := p.Offset + int64(returned)
+	if returned == 0 || next >= total {
+		return ""
+	}
+
+	return encodeToken(next)
+}
+
+// Tokens are opaque to callers so the paging scheme can change without
+// breaking them.
+func encodeToken(offset int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(offset, 10)))
+}
+
+func decodeToken(token string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+
+	offset, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if offset < 0 {
+		return 0, errorf("negative offset")
+	}
+
+	return offset, nil
+}
@@ -0,0 +1,105 @@
+package sqlfilter
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+)
+
+func TestParsePage(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    int32
+		token   string
+		want    *Page
+		invalid bool
+	}{
+		{name: "unpaged", want: nil},
+		{name: "size", size: 10, want: &Page{Size: 10}},
+		{name: "default size", token: encodeToken(20), want: &Page{Size: DefaultPageSize, Offset: 20}},
+		{name: "coerced size", size: MaxPageSize + 1, want: &Page{Size: MaxPageSize}},
+		{name: "token", size: 10, token: encodeToken(30), want: &Page{Size: 10, Offset: 30}},
+		{name: "negative size", size: -1, invalid: true},
+		{name: "not base64", size: 10, token: "not a token!", invalid: true},
+		{name: "not a number", size: 10, token: base64.RawURLEncoding.EncodeToString([]byte("ten")), invalid: true},
+		{name: "negative offset", size: 10, token: base64.RawURLEncoding.EncodeToString([]byte("-10")), invalid: true},
+		{name: "padded", size: 10, token: base64.URLEncoding.EncodeToString([]byte("10")), invalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePage(tt.size, tt.token)
+
+			var pageErr *Error
+			if invalid := errors.As(err, &pageErr); invalid != tt.invalid {
+				t.Fatalf("err = %v, want invalid %t", err, tt.invalid)
+			}
+			if !tt.invalid && err != nil {
+				t.Fatalf("err = %v, want nil", err)
+			}
+
+			if (got == nil) != (tt.want == nil) || got != nil && *got != *tt.want {
+				t.Errorf("page = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPageApply(t *testing.T) {
+	tests := []struct {
+		name string
+		page *Page
+		want string
+	}{
+		{name: "unpaged", page: nil, want: "SELECT"},
+		{name: "first", page: &Page{Size: 10}, want: "SELECT LIMIT 10 OFFSET 0"},
+		{name: "later", page: &Page{Size: 10, Offset: 30}, want: "SELECT LIMIT 10 OFFSET 30"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.page.Apply("SELECT"); got != tt.want {
+				t.Errorf("query = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPageNextToken(t *testing.T) {
+	tests := []struct {
+		name       string
+		page       *Page
+		returned   int
+		total      int64
+		wantOffset int64
+		wantLast   bool
+	}{
+		{name: "unpaged", page: nil, returned: 5, total: 5, wantLast: true},
+		{name: "more", page: &Page{Size: 2}, returned: 2, total: 5, wantOffset: 2},
+		{name: "middle", page: &Page{Size: 2, Offset: 2}, returned: 2, total: 5, wantOffset: 4},
+		{name: "last", page: &Page{Size: 2, Offset: 4}, returned: 1, total: 5, wantLast: true},
+		{name: "exactly full", page: &Page{Size: 2, Offset: 3}, returned: 2, total: 5, wantLast: true},
+		{name: "nothing returned", page: &Page{Size: 2, Offset: 10}, returned: 0, total: 5, wantLast: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := tt.page.NextToken(tt.returned, tt.total)
+
+			if tt.wantLast {
+				if token != "" {
+					t.Errorf("token = %q, want none", token)
+				}
+				return
+			}
+
+			offset, err := decodeToken(token)
+			if err != nil {
+				t.Fatalf("decoding token %q: %v", token, err)
+			}
+			if offset != tt.wantOffset {
+				t.Errorf("offset = %d, want %d", offset, tt.wantOffset)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package sqlfilter
+
+import "time"
+
+// Statuses derived from the advertised start time of a race or event, and
+// whether its result has been recorded.
+const (
+	StatusOpen   = "OPEN"
+	StatusClosed = "CLOSED"
+	// StatusLive is an event which has started but not yet finished.
+	StatusLive = "LIVE"
+	// StatusFinished is a race or event whose result has been recorded.
+	StatusFinished = "FINISHED"
+)
+
+// Status derives whether betting is open from the advertised start time,
+// anything which has started is closed.
+func Status(advertisedStart time.Time) string {
+	if advertisedStart.Before(time.Now()) {
+		return StatusClosed
+	}
+	return StatusOpen
+}
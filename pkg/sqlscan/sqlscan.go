@@ -0,0 +1,26 @@
+// Package sqlscan scans rows by column name rather than position, so the
+// columns of a query can be added to or reordered without breaking scans.
+package sqlscan
+
+import "database/sql"
+
+// Targets returns the scan destinations for the columns of rows, looked up by
+// name in fields. Columns without a field are scanned and discarded, fields
+// without a column are left untouched.
+func Targets(rows *sql.Rows, fields map[string]interface{}) ([]interface{}, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]interface{}, len(columns))
+	for i, column := range columns {
+		target, ok := fields[column]
+		if !ok {
+			target = new(interface{})
+		}
+		targets[i] = target
+	}
+
+	return targets, nil
+}
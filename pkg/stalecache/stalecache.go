@@ -0,0 +1,106 @@
+// Package stalecache keeps the last successful response of each request, so
+// it can be served instead of an error while it is no older than a bound,
+// trading freshness for availability.
+package stalecache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Cache holds the responses of up to a number of requests, dropping those
+// least recently stored when full.
+type Cache struct {
+	maxAge     time.Duration
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	// order has the most recently stored entry at the front.
+	order *list.List
+}
+
+type entry struct {
+	key      string
+	response proto.Message
+	storedAt time.Time
+}
+
+// New creates a cache serving responses up to maxAge old, of up to
+// maxEntries requests.
+func New(maxAge time.Duration, maxEntries int) *Cache {
+	return &Cache{
+		maxAge:     maxAge,
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+	}
+}
+
+// Key identifies a request to a method, extra being anything else the
+// response depends on, such as the experiments of the caller.
+func Key(method string, request proto.Message, extra ...string) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+
+	key := method + "\x00" + string(data)
+	for _, value := range extra {
+		key += "\x00" + value
+	}
+
+	return key, nil
+}
+
+// Put stores the response to the request. The response must not be changed
+// afterwards.
+func (c *Cache) Put(key string, response proto.Message) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		c.order.Remove(element)
+	}
+
+	c.entries[key] = c.order.PushFront(&entry{key: key, response: response, storedAt: time.Now()})
+
+	for c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*entry).key)
+	}
+}
+
+// Get returns the last response to the request and its age, or false when
+// there is none or it is older than the bound.
+func (c *Cache) Get(key string) (proto.Message, time.Duration, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, 0, false
+	}
+
+	e := element.Value.(*entry)
+
+	age := time.Since(e.storedAt)
+	if age > c.maxAge {
+		return nil, 0, false
+	}
+
+	return e.response, age, true
+}
+
+// Purge drops every response stored.
+func (c *Cache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]*list.Element)
+	c.order.Init()
+}
@@ -0,0 +1,61 @@
+// Package testutil holds the scaffolding the test suites of the services and
+// the gateway share: a scratch database and a gRPC server served in memory.
+package testutil
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"git.neds.sh/matty/entain/pkg/sqldialect"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// bufSize is the size of the buffer of the in-memory connections.
+const bufSize = 1 << 20
+
+// OpenDB opens a scratch SQLite database in a temporary directory, closed and
+// removed once the test ends. It is empty until seeded, e.g. by the Init of a
+// repository.
+func OpenDB(tb testing.TB) *sqldialect.DB {
+	tb.Helper()
+
+	db, err := sqldialect.Open("sqlite3", filepath.Join(tb.TempDir(), "test.db"))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	tb.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+// Dial serves a gRPC server over an in-memory listener and returns a client
+// connection to it, both closed once the test ends. register registers the
+// services of the server, created with opts such as its interceptors.
+func Dial(tb testing.TB, register func(s *grpc.Server), opts ...grpc.ServerOption) *grpc.ClientConn {
+	tb.Helper()
+
+	listener := bufconn.Listen(bufSize)
+
+	server := grpc.NewServer(opts...)
+	register(server)
+	go server.Serve(listener)
+	tb.Cleanup(server.Stop)
+
+	conn, err := grpc.DialContext(
+		context.Background(),
+		"bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	tb.Cleanup(func() { conn.Close() })
+
+	return conn
+}
@@ -0,0 +1,85 @@
+package tracing
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// BaggageKey is the HTTP header and gRPC metadata key baggage is propagated
+// in.
+const BaggageKey = "baggage"
+
+// Keys of the baggage the gateway describes requests with.
+const (
+	BaggageClientID     = "client.id"
+	BaggageJurisdiction = "jurisdiction"
+	// BaggageExperimentPrefix prefixes the name of each experiment the
+	// client is bucketed into, the value is the variant.
+	BaggageExperimentPrefix = "experiment."
+)
+
+// Baggage describes a request with key value pairs propagated alongside the
+// trace, e.g. the client making it. Every span started for the request is
+// annotated with them, so traces can be sliced by them.
+type Baggage map[string]string
+
+type baggageKey struct{}
+
+// ContextWithBaggage returns a context carrying the baggage.
+func ContextWithBaggage(ctx context.Context, baggage Baggage) context.Context {
+	return context.WithValue(ctx, baggageKey{}, baggage)
+}
+
+// BaggageFromContext returns the baggage of the context, nil if there is none.
+func BaggageFromContext(ctx context.Context) Baggage {
+	baggage, _ := ctx.Value(baggageKey{}).(Baggage)
+	return baggage
+}
+
+// FormatBaggage formats baggage as a W3C baggage header, sorted by key so the
+// output is stable.
+func FormatBaggage(baggage Baggage) string {
+	keys := make([]string, 0, len(baggage))
+	for key := range baggage {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	members := make([]string, 0, len(keys))
+	for _, key := range keys {
+		members = append(members, key+"="+url.PathEscape(baggage[key]))
+	}
+
+	return strings.Join(members, ",")
+}
+
+// ParseBaggage parses a W3C baggage header, e.g.
+//
+//	client.id=ios-app,jurisdiction=VIC
+//
+// Members which can't be parsed are skipped, and their properties ignored.
+func ParseBaggage(value string) Baggage {
+	baggage := make(Baggage)
+
+	for _, member := range strings.Split(value, ",") {
+		// Properties follow the value, separated by semicolons.
+		member = strings.SplitN(member, ";", 2)[0]
+
+		parts := strings.SplitN(member, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		key := strings.TrimSpace(parts[0])
+		value, err := url.PathUnescape(strings.TrimSpace(parts[1]))
+		if key == "" || err != nil {
+			continue
+		}
+
+		baggage[key] = value
+	}
+
+	return baggage
+}
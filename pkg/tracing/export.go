@@ -0,0 +1,289 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// defaultEndpoint is the default OTLP over HTTP endpoint of a collector.
+	defaultEndpoint = "http://localhost:4318"
+
+	// exportInterval is how often finished spans are exported.
+	exportInterval = 5 * time.Second
+
+	// exportBatchSize is the most spans exported in one request.
+	exportBatchSize = 512
+
+	// queueSize is the number of finished spans which can wait for export
+	// before more are dropped.
+	queueSize = 2048
+
+	// exportTimeout bounds each export request.
+	exportTimeout = 10 * time.Second
+
+	// scopeName names this package as the instrumentation of the spans.
+	scopeName = "git.neds.sh/matty/entain/pkg/tracing"
+)
+
+type exporter struct {
+	url     string
+	headers map[string]string
+	service string
+	client  *http.Client
+
+	spans   chan *Span
+	dropped uint64
+}
+
+// NewFromEnv creates a tracer configured by the OpenTelemetry environment
+// variables. Tracing is disabled, returning a nil tracer, unless an endpoint
+// is set.
+//
+//	OTEL_EXPORTER_OTLP_ENDPOINT         base URL of the collector, /v1/traces is appended
+//	OTEL_EXPORTER_OTLP_TRACES_ENDPOINT  full URL spans are sent to, overriding the above
+//	OTEL_EXPORTER_OTLP_HEADERS          key=value pairs separated by commas, e.g. for auth
+//	OTEL_EXPORTER_OTLP_PROTOCOL         only http/json is supported
+//	OTEL_SERVICE_NAME                   defaults to service
+//	OTEL_TRACES_EXPORTER                none disables tracing
+func NewFromEnv(service string) (*Tracer, error) {
+	if os.Getenv("OTEL_TRACES_EXPORTER") == "none" {
+		return nil, nil
+	}
+
+	url := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT")
+	if url == "" {
+		base := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
+		if base == "" {
+			return nil, nil
+		}
+		url = strings.TrimSuffix(base, "/") + "/v1/traces"
+	}
+
+	if protocol := os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL"); protocol != "" && protocol != "http/json" {
+		return nil, fmt.Errorf("unsupported OTLP protocol %q, only http/json is supported", protocol)
+	}
+
+	headers, err := parseHeaders(os.Getenv("OTEL_EXPORTER_OTLP_HEADERS"))
+	if err != nil {
+		return nil, err
+	}
+
+	if name := os.Getenv("OTEL_SERVICE_NAME"); name != "" {
+		service = name
+	}
+
+	return &Tracer{
+		exporter: &exporter{
+			url:     url,
+			headers: headers,
+			service: service,
+			client:  &http.Client{Timeout: exportTimeout},
+			spans:   make(chan *Span, queueSize),
+		},
+	}, nil
+}
+
+func parseHeaders(value string) (map[string]string, error) {
+	headers := make(map[string]string)
+
+	for _, pair := range strings.Split(value, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("invalid OTLP header %q, expected key=value", pair)
+		}
+
+		headers[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+
+	return headers, nil
+}
+
+// Run exports finished spans until the context is cancelled, then exports
+// those remaining.
+func (t *Tracer) Run(ctx context.Context) {
+	if t == nil {
+		return
+	}
+
+	log.Printf("exporting traces to: %s\n", t.exporter.url)
+
+	ticker := time.NewTicker(exportInterval)
+	defer ticker.Stop()
+
+	var batch []*Span
+
+	for {
+		select {
+		case span := <-t.exporter.spans:
+			batch = append(batch, span)
+			if len(batch) < exportBatchSize {
+				continue
+			}
+		case <-ticker.C:
+		case <-ctx.Done():
+			for len(t.exporter.spans) > 0 {
+				batch = append(batch, <-t.exporter.spans)
+			}
+			t.exporter.export(batch)
+			return
+		}
+
+		t.exporter.export(batch)
+		batch = nil
+	}
+}
+
+// queue adds a finished span to those waiting for export, never blocking the
+// request which finished it.
+func (e *exporter) queue(span *Span) {
+	select {
+	case e.spans <- span:
+	default:
+		atomic.AddUint64(&e.dropped, 1)
+	}
+}
+
+func (e *exporter) export(batch []*Span) {
+	if dropped := atomic.SwapUint64(&e.dropped, 0); dropped > 0 {
+		log.Printf("dropped %d spans waiting for export\n", dropped)
+	}
+
+	if len(batch) == 0 {
+		return
+	}
+
+	body, err := json.Marshal(e.request(batch))
+	if err != nil {
+		log.Printf("failed encoding spans: %s\n", err)
+		return
+	}
+
+	req, err := http.NewRequest(http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		log.Printf("failed exporting spans: %s\n", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range e.headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		log.Printf("failed exporting spans: %s\n", err)
+		return
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		log.Printf("failed exporting spans: collector returned %s\n", resp.Status)
+	}
+}
+
+// The OTLP JSON encoding of an export request, ids are hex rather than the
+// base64 of the regular protobuf JSON mapping.
+type (
+	exportRequest struct {
+		ResourceSpans []resourceSpans `json:"resourceSpans"`
+	}
+
+	resourceSpans struct {
+		Resource   resource     `json:"resource"`
+		ScopeSpans []scopeSpans `json:"scopeSpans"`
+	}
+
+	resource struct {
+		Attributes []keyValue `json:"attributes"`
+	}
+
+	scopeSpans struct {
+		Scope scope      `json:"scope"`
+		Spans []spanJSON `json:"spans"`
+	}
+
+	scope struct {
+		Name string `json:"name"`
+	}
+
+	keyValue struct {
+		Key   string   `json:"key"`
+		Value anyValue `json:"value"`
+	}
+
+	anyValue struct {
+		StringValue string `json:"stringValue"`
+	}
+
+	spanJSON struct {
+		TraceID           string     `json:"traceId"`
+		SpanID            string     `json:"spanId"`
+		ParentSpanID      string     `json:"parentSpanId,omitempty"`
+		Name              string     `json:"name"`
+		Kind              SpanKind   `json:"kind"`
+		StartTimeUnixNano string     `json:"startTimeUnixNano"`
+		EndTimeUnixNano   string     `json:"endTimeUnixNano"`
+		Attributes        []keyValue `json:"attributes,omitempty"`
+		Status            statusJSON `json:"status"`
+	}
+
+	statusJSON struct {
+		Code    StatusCode `json:"code,omitempty"`
+		Message string     `json:"message,omitempty"`
+	}
+)
+
+func (e *exporter) request(batch []*Span) exportRequest {
+	spans := make([]spanJSON, len(batch))
+	for i, span := range batch {
+		spans[i] = spanJSON{
+			TraceID:           span.context.TraceID.String(),
+			SpanID:            span.context.SpanID.String(),
+			Name:              span.name,
+			Kind:              span.kind,
+			StartTimeUnixNano: strconv.FormatInt(span.start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(span.end.UnixNano(), 10),
+			Attributes:        keyValues(span.attributes),
+			Status:            statusJSON{Code: span.status, Message: span.message},
+		}
+		if span.parent != (SpanID{}) {
+			spans[i].ParentSpanID = span.parent.String()
+		}
+	}
+
+	return exportRequest{
+		ResourceSpans: []resourceSpans{{
+			Resource: resource{
+				Attributes: keyValues(map[string]string{"service.name": e.service}),
+			},
+			ScopeSpans: []scopeSpans{{
+				Scope: scope{Name: scopeName},
+				Spans: spans,
+			}},
+		}},
+	}
+}
+
+func keyValues(attributes map[string]string) []keyValue {
+	kvs := make([]keyValue, 0, len(attributes))
+	for key, value := range attributes {
+		kvs = append(kvs, keyValue{Key: key, Value: anyValue{StringValue: value}})
+	}
+	sort.Slice(kvs, func(i, j int) bool { return kvs[i].Key < kvs[j].Key })
+
+	return kvs
+}
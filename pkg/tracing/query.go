@@ -0,0 +1,28 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// ObserveQuery records a database query as a span, for use with
+// sqldialect.DB.ObserveQueries. Only queries made for a traced request are
+// recorded, not those of background work such as polling.
+func (t *Tracer) ObserveQuery(ctx context.Context, query string, elapsed time.Duration) {
+	if _, ok := parentFromContext(ctx); !ok {
+		return
+	}
+
+	fields := strings.Fields(query)
+
+	name := "query"
+	if len(fields) > 0 {
+		name = strings.ToUpper(fields[0])
+	}
+
+	// The queries are indented in the source, the whitespace is just noise.
+	t.Record(ctx, name, KindClient, time.Now().Add(-elapsed), map[string]string{
+		"db.statement": strings.Join(fields, " "),
+	})
+}
@@ -0,0 +1,178 @@
+// Package tracing records spans of requests across the gateway, services and
+// databases, and exports them to an OpenTelemetry collector with OTLP over
+// HTTP. The trace context is propagated between processes as a W3C
+// traceparent, in HTTP headers and gRPC metadata.
+//
+// Tracers are configured by the standard OpenTelemetry environment variables,
+// see NewFromEnv.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+)
+
+// SpanKind describes the relationship of a span to its parent and children,
+// the values are those of OTLP.
+type SpanKind int
+
+const (
+	KindInternal SpanKind = 1
+	KindServer   SpanKind = 2
+	KindClient   SpanKind = 3
+)
+
+// StatusCode is the outcome of a span, the values are those of OTLP.
+type StatusCode int
+
+const (
+	StatusUnset StatusCode = 0
+	StatusOK    StatusCode = 1
+	StatusError StatusCode = 2
+)
+
+// TraceID identifies a trace.
+type TraceID [16]byte
+
+// SpanID identifies a span within a trace.
+type SpanID [8]byte
+
+func (id TraceID) String() string { return hex.EncodeToString(id[:]) }
+func (id SpanID) String() string  { return hex.EncodeToString(id[:]) }
+
+// SpanContext identifies a span, possibly in another process.
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+}
+
+// IsValid reports whether the ids are set, all zero ids are invalid.
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID != TraceID{} && sc.SpanID != SpanID{}
+}
+
+// Span is a single operation within a trace. The methods of a nil span do
+// nothing, so callers need not check whether tracing is enabled.
+type Span struct {
+	tracer *Tracer
+
+	context    SpanContext
+	parent     SpanID
+	name       string
+	kind       SpanKind
+	start      time.Time
+	end        time.Time
+	attributes map[string]string
+	status     StatusCode
+	message    string
+}
+
+// Tracer starts spans and exports them once they end. A nil tracer is
+// disabled, starting spans which do nothing.
+type Tracer struct {
+	exporter *exporter
+}
+
+type spanKey struct{}
+type remoteKey struct{}
+
+// Start starts a span as a child of the span in the context, or of a remote
+// span added by ContextWithRemote, otherwise starting a new trace. The span is
+// annotated with the baggage of the context, and must be ended.
+func (t *Tracer) Start(ctx context.Context, name string, kind SpanKind) (context.Context, *Span) {
+	if t == nil {
+		return ctx, nil
+	}
+
+	span := &Span{
+		tracer:     t,
+		name:       name,
+		kind:       kind,
+		start:      time.Now(),
+		attributes: make(map[string]string),
+	}
+
+	for key, value := range BaggageFromContext(ctx) {
+		span.attributes[key] = value
+	}
+
+	if parent, ok := parentFromContext(ctx); ok {
+		span.context.TraceID = parent.TraceID
+		span.parent = parent.SpanID
+	} else {
+		rand.Read(span.context.TraceID[:])
+	}
+	rand.Read(span.context.SpanID[:])
+
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+// Record records a span which has already finished, such as a database
+// query reported after it ran.
+func (t *Tracer) Record(ctx context.Context, name string, kind SpanKind, start time.Time, attributes map[string]string) {
+	_, span := t.Start(ctx, name, kind)
+	if span == nil {
+		return
+	}
+
+	span.start = start
+	for key, value := range attributes {
+		span.attributes[key] = value
+	}
+	span.End()
+}
+
+// ContextWithRemote returns a context whose spans are started as children of
+// a span in another process.
+func ContextWithRemote(ctx context.Context, remote SpanContext) context.Context {
+	return context.WithValue(ctx, remoteKey{}, remote)
+}
+
+// SpanContextFromContext returns the identity of the current span, for
+// propagating to another process.
+func SpanContextFromContext(ctx context.Context) (SpanContext, bool) {
+	return parentFromContext(ctx)
+}
+
+func parentFromContext(ctx context.Context) (SpanContext, bool) {
+	if span, ok := ctx.Value(spanKey{}).(*Span); ok && span != nil {
+		return span.context, true
+	}
+
+	if remote, ok := ctx.Value(remoteKey{}).(SpanContext); ok && remote.IsValid() {
+		return remote, true
+	}
+
+	return SpanContext{}, false
+}
+
+// SetAttribute annotates the span.
+func (s *Span) SetAttribute(key, value string) {
+	if s == nil {
+		return
+	}
+
+	s.attributes[key] = value
+}
+
+// SetStatus records the outcome of the span.
+func (s *Span) SetStatus(code StatusCode, message string) {
+	if s == nil {
+		return
+	}
+
+	s.status = code
+	s.message = message
+}
+
+// End finishes the span and queues it for export.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+
+	s.end = time.Now()
+	s.tracer.exporter.queue(s)
+}
@@ -0,0 +1,211 @@
+// Package watchhub periodically polls a repository and fans out the items,
+// such as races or events, whose watched fields changed since the last poll to
+// the subscribers they match. Nothing is polled while nobody is subscribed.
+package watchhub
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// subscriberBuffer is the number of items which can be queued for a
+// subscriber before it is considered too slow and disconnected.
+const subscriberBuffer = 64
+
+// Item is an item listed by a poll.
+type Item struct {
+	ID int64
+	// State is a copy of the item with only its watched fields set, the
+	// item is published when it differs from that of the last poll.
+	State proto.Message
+	// Message is the item published to subscribers, shared by all of them.
+	Message proto.Message
+}
+
+// ListFunc lists every item watched.
+type ListFunc func(ctx context.Context) ([]Item, error)
+
+// MatchFunc reports whether an item is one a subscriber is interested in.
+type MatchFunc func(message proto.Message) bool
+
+// Hub polls for changed items and fans them out to subscribers.
+type Hub struct {
+	list     ListFunc
+	interval time.Duration
+	logger   *zap.Logger
+
+	// wake is signalled when the first subscriber joins an idle hub.
+	wake chan struct{}
+
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+}
+
+type subscriber struct {
+	match    MatchFunc
+	messages chan proto.Message
+}
+
+// New creates a new hub polling list every interval while it has
+// subscribers.
+func New(list ListFunc, interval time.Duration, logger *zap.Logger) *Hub {
+	return &Hub{
+		list:        list,
+		interval:    interval,
+		logger:      logger,
+		wake:        make(chan struct{}, 1),
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+// Run polls for changes, while there are subscribers, until the context is
+// cancelled.
+func (h *Hub) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			h.closeAll()
+			return
+		case <-h.wake:
+		}
+
+		h.watch(ctx)
+
+		if ctx.Err() != nil {
+			h.closeAll()
+			return
+		}
+	}
+}
+
+// watch polls for changes every interval until the context is cancelled or
+// the last subscriber leaves. Changes made while nobody watched are never
+// published, so the first poll only records the state of the items.
+func (h *Hub) watch(ctx context.Context) {
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	var previous map[int64]proto.Message
+
+	for {
+		current, err := h.poll(ctx, previous)
+		if err != nil {
+			h.logger.Error("failed polling for changes", zap.Error(err))
+		} else {
+			previous = current
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if h.idle() {
+			return
+		}
+	}
+}
+
+// Subscribe registers interest in the items match accepts. Items are
+// delivered on the returned channel, which is closed if the subscriber falls
+// too far behind. The returned function must be called to unsubscribe.
+func (h *Hub) Subscribe(match MatchFunc) (<-chan proto.Message, func()) {
+	sub := &subscriber{
+		match:    match,
+		messages: make(chan proto.Message, subscriberBuffer),
+	}
+
+	h.mu.Lock()
+	h.subscribers[sub] = struct{}{}
+	first := len(h.subscribers) == 1
+	h.mu.Unlock()
+
+	if first {
+		select {
+		case h.wake <- struct{}{}:
+		default:
+			// Already woken.
+		}
+	}
+
+	return sub.messages, func() { h.remove(sub) }
+}
+
+// idle reports whether the hub has no subscribers.
+func (h *Hub) idle() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return len(h.subscribers) == 0
+}
+
+// poll lists all items and publishes those that changed since previous. On
+// the first poll there is nothing to compare against so nothing is published.
+func (h *Hub) poll(ctx context.Context, previous map[int64]proto.Message) (map[int64]proto.Message, error) {
+	items, err := h.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	current := make(map[int64]proto.Message, len(items))
+
+	for _, item := range items {
+		current[item.ID] = item.State
+
+		if previous == nil {
+			continue
+		}
+
+		if old, ok := previous[item.ID]; ok && proto.Equal(old, item.State) {
+			continue
+		}
+
+		h.publish(item.Message)
+	}
+
+	return current, nil
+}
+
+func (h *Hub) publish(message proto.Message) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subscribers {
+		if !sub.match(message) {
+			continue
+		}
+
+		select {
+		case sub.messages <- message:
+		default:
+			// Never block the hub on a slow subscriber.
+			delete(h.subscribers, sub)
+			close(sub.messages)
+		}
+	}
+}
+
+func (h *Hub) remove(sub *subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.subscribers[sub]; ok {
+		delete(h.subscribers, sub)
+		close(sub.messages)
+	}
+}
+
+func (h *Hub) closeAll() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subscribers {
+		delete(h.subscribers, sub)
+		close(sub.messages)
+	}
+}
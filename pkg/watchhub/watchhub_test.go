@@ -0,0 +1,177 @@
+package watchhub
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// item is an item with the ID whose watched state is status.
+func item(id int64, status string) Item {
+	return Item{ID: id, State: wrapperspb.String(status), Message: wrapperspb.Int64(id)}
+}
+
+func TestPollPublishesChangedItems(t *testing.T) {
+	var items []Item
+	h := New(func(context.Context) ([]Item, error) { return items, nil }, time.Second, zap.NewNop())
+
+	messages, unsubscribe := h.Subscribe(func(proto.Message) bool { return true })
+	defer unsubscribe()
+
+	polls := []struct {
+		name  string
+		items []Item
+		want  []int64
+	}{
+		{name: "first", items: []Item{item(1, "OPEN"), item(2, "OPEN")}, want: nil},
+		{name: "unchanged", items: []Item{item(1, "OPEN"), item(2, "OPEN")}, want: nil},
+		{name: "changed", items: []Item{item(1, "CLOSED"), item(2, "OPEN")}, want: []int64{1}},
+		{name: "added", items: []Item{item(1, "CLOSED"), item(2, "OPEN"), item(3, "OPEN")}, want: []int64{3}},
+		{name: "removed", items: []Item{item(2, "OPEN"), item(3, "OPEN")}, want: nil},
+	}
+
+	var previous map[int64]proto.Message
+	for _, poll := range polls {
+		items = poll.items
+
+		current, err := h.poll(context.Background(), previous)
+		if err != nil {
+			t.Fatal(err)
+		}
+		previous = current
+
+		got := drain(messages)
+		if len(got) != len(poll.want) {
+			t.Fatalf("%s: published %v, want %v", poll.name, got, poll.want)
+		}
+		for i := range got {
+			if got[i] != poll.want[i] {
+				t.Errorf("%s: published %v, want %v", poll.name, got, poll.want)
+			}
+		}
+	}
+}
+
+func TestPublishOnlyToMatchingSubscribers(t *testing.T) {
+	h := New(nil, time.Second, zap.NewNop())
+
+	even, unsubscribeEven := h.Subscribe(func(message proto.Message) bool {
+		return message.(*wrapperspb.Int64Value).GetValue()%2 == 0
+	})
+	defer unsubscribeEven()
+	all, unsubscribeAll := h.Subscribe(func(proto.Message) bool { return true })
+	defer unsubscribeAll()
+
+	for id := int64(1); id <= 4; id++ {
+		h.publish(wrapperspb.Int64(id))
+	}
+
+	if got := drain(even); len(got) != 2 || got[0] != 2 || got[1] != 4 {
+		t.Errorf("even subscriber got %v, want [2 4]", got)
+	}
+	if got := drain(all); len(got) != 4 {
+		t.Errorf("subscriber of all got %v, want [1 2 3 4]", got)
+	}
+}
+
+func TestSlowSubscriberIsDisconnected(t *testing.T) {
+	h := New(nil, time.Second, zap.NewNop())
+
+	messages, unsubscribe := h.Subscribe(func(proto.Message) bool { return true })
+	defer unsubscribe()
+
+	for id := int64(0); id <= subscriberBuffer; id++ {
+		h.publish(wrapperspb.Int64(id))
+	}
+
+	for i := 0; i < subscriberBuffer; i++ {
+		if _, ok := <-messages; !ok {
+			t.Fatalf("channel closed after %d items, want %d queued", i, subscriberBuffer)
+		}
+	}
+	if _, ok := <-messages; ok {
+		t.Error("channel of a subscriber which fell behind not closed")
+	}
+}
+
+func TestRunClosesSubscribersWhenCancelled(t *testing.T) {
+	h := New(func(context.Context) ([]Item, error) { return nil, nil }, time.Millisecond, zap.NewNop())
+
+	messages, unsubscribe := h.Subscribe(func(proto.Message) bool { return true })
+	defer unsubscribe()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		h.Run(ctx)
+		close(done)
+	}()
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Run didn't return once cancelled")
+	}
+
+	if _, ok := <-messages; ok {
+		t.Error("channel of subscriber not closed")
+	}
+}
+
+func TestRunPollsOnlyWithSubscribers(t *testing.T) {
+	var polls int32
+	polled := make(chan struct{}, 1)
+	h := New(func(context.Context) ([]Item, error) {
+		atomic.AddInt32(&polls, 1)
+		select {
+		case polled <- struct{}{}:
+		default:
+		}
+		return nil, nil
+	}, time.Millisecond, zap.NewNop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go h.Run(ctx)
+
+	time.Sleep(20 * time.Millisecond)
+	if got := atomic.LoadInt32(&polls); got != 0 {
+		t.Fatalf("polled %d times without subscribers, want 0", got)
+	}
+
+	_, unsubscribe := h.Subscribe(func(proto.Message) bool { return true })
+	select {
+	case <-polled:
+	case <-time.After(time.Second):
+		t.Fatal("not polled once subscribed")
+	}
+
+	unsubscribe()
+	// Let a poll which was in progress finish.
+	time.Sleep(20 * time.Millisecond)
+	stopped := atomic.LoadInt32(&polls)
+
+	time.Sleep(20 * time.Millisecond)
+	if got := atomic.LoadInt32(&polls); got != stopped {
+		t.Errorf("polled %d times after the last subscriber left, want 0", got-stopped)
+	}
+}
+
+// drain returns the IDs of the items queued for a subscriber.
+func drain(messages <-chan proto.Message) []int64 {
+	var ids []int64
+	for {
+		select {
+		case message := <-messages:
+			ids = append(ids, message.(*wrapperspb.Int64Value).GetValue())
+		default:
+			return ids
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// Command export writes the closed races to a Parquet file, so they can be
+// loaded into the analytics warehouse without going through the API. It is
+// meant to be run by a scheduler, e.g. nightly, or by hand.
+//
+//	go run ./cmd/export -out /var/exports/races.parquet
+//
+// The file is written alongside the output path and renamed into place once
+// complete, so a loader never picks up a partial export.
+package main
+
+import (
+	"context"
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"git.neds.sh/matty/entain/pkg/fieldlimit"
+	"git.neds.sh/matty/entain/pkg/sqldialect"
+	"git.neds.sh/matty/entain/racing/db"
+	"git.neds.sh/matty/entain/racing/proto/racing"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+var (
+	dbDriver = flag.String("db-driver", "sqlite3", "Database driver, one of: "+strings.Join(sqldialect.Drivers(), ", "))
+	dsn      = flag.String("dsn", "./db/racing.db", "Data source name of the database for the driver")
+	out      = flag.String("out", "races.parquet", "Path of the Parquet file written")
+)
+
+// raceRow is the schema of the exported races. Times are milliseconds since
+// the epoch, which every warehouse loads as a timestamp.
+type raceRow struct {
+	ID                  int64  `parquet:"name=id, type=INT64"`
+	MeetingID           int64  `parquet:"name=meeting_id, type=INT64"`
+	Name                string `parquet:"name=name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Number              int64  `parquet:"name=number, type=INT64"`
+	Visible             bool   `parquet:"name=visible, type=BOOLEAN"`
+	AdvertisedStartTime int64  `parquet:"name=advertised_start_time, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+	Status              string `parquet:"name=status, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+}
+
+func main() {
+	flag.Parse()
+
+	if err := run(); err != nil {
+		log.Fatalf("failed exporting races: %s\n", err)
+	}
+}
+
+func run() error {
+	racingDB, err := sqldialect.Open(*dbDriver, *dsn)
+	if err != nil {
+		return err
+	}
+	defer racingDB.Close()
+
+	// The repository is only listed from, it isn't initialised so an empty
+	// database isn't seeded.
+	racesRepo := db.NewRacesRepo(racingDB, fieldlimit.New("races", fieldlimit.Truncate, nil), 0, time.Now)
+
+	closedBefore, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+
+	races, err := racesRepo.List(context.Background(), &racing.ListRacesRequestFilter{AdvertisedStartBefore: closedBefore}, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := write(*out, races); err != nil {
+		return err
+	}
+
+	log.Printf("exported %d races to %s\n", len(races), *out)
+
+	return nil
+}
+
+// write writes the races to a Parquet file at path, replacing it.
+func write(path string, races []*racing.Race) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".export-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	// Temporary files are only readable by their owner, the loader may not
+	// be.
+	if err := tmp.Chmod(0644); err != nil {
+		return err
+	}
+
+	pw, err := writer.NewParquetWriterFromWriter(tmp, new(raceRow), 1)
+	if err != nil {
+		return err
+	}
+	pw.CompressionType = parquet.CompressionCodec_SNAPPY
+
+	for _, race := range races {
+		row := raceRow{
+			ID:                  race.Id,
+			MeetingID:           race.MeetingId,
+			Name:                race.Name,
+			Number:              race.Number,
+			Visible:             race.Visible,
+			AdvertisedStartTime: race.AdvertisedStartTime.AsTime().UnixNano() / int64(time.Millisecond),
+			Status:              race.Status,
+		}
+		if err := pw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	if err := pw.WriteStop(); err != nil {
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"git.neds.sh/matty/entain/pkg/rediscache"
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// cachedRacesRepo serves races by ID from a cache in front of the
+// repository, dropping them from the cache as they are written. Races are
+// cached with their status, so it can lag behind the start of a race by the
+// TTL of the cache.
+type cachedRacesRepo struct {
+	RacesRepo
+	cache *rediscache.Cache
+}
+
+// NewCachedRacesRepo caches the races got by ID from the repository. Errors
+// of the cache are logged and the race read from the repository instead.
+func NewCachedRacesRepo(repo RacesRepo, cache *rediscache.Cache) RacesRepo {
+	return &cachedRacesRepo{RacesRepo: repo, cache: cache}
+}
+
+func (r *cachedRacesRepo) Get(ctx context.Context, id int64) (*racing.Race, error) {
+	var race racing.Race
+
+	cached, err := r.cache.Get(ctx, raceKey(id), &race)
+	if err != nil {
+		log.Printf("warning: reading race %d from cache: %s\n", id, err)
+	}
+	if cached {
+		return &race, nil
+	}
+
+	got, err := r.RacesRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.cache.Set(ctx, raceKey(id), got); err != nil {
+		log.Printf("warning: caching race %d: %s\n", id, err)
+	}
+
+	return got, nil
+}
+
+func (r *cachedRacesRepo) Update(ctx context.Context, race *racing.Race) (*racing.Race, error) {
+	defer r.invalidate(ctx, race.GetId())
+
+	return r.RacesRepo.Update(ctx, race)
+}
+
+func (r *cachedRacesRepo) Delete(ctx context.Context, id int64) error {
+	defer r.invalidate(ctx, id)
+
+	return r.RacesRepo.Delete(ctx, id)
+}
+
+func (r *cachedRacesRepo) SetResult(ctx context.Context, id int64, result *racing.RaceResult, settledAt time.Time) (*racing.Race, error) {
+	defer r.invalidate(ctx, id)
+
+	return r.RacesRepo.SetResult(ctx, id, result, settledAt)
+}
+
+// invalidate drops a race written from the cache. It is dropped even when the
+// write fails, as it may have been made.
+func (r *cachedRacesRepo) invalidate(ctx context.Context, id int64) {
+	if err := r.cache.Delete(ctx, raceKey(id)); err != nil {
+		log.Printf("warning: dropping race %d from cache: %s\n", id, err)
+	}
+}
+
+func raceKey(id int64) string {
+	return "race:" + strconv.FormatInt(id, 10)
+}
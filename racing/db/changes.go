@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"git.neds.sh/matty/entain/pkg/changelog"
+	"git.neds.sh/matty/entain/pkg/sqlfilter"
+	"git.neds.sh/matty/entain/racing/proto/racing"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/protobuf/proto"
+)
+
+// ChangesTable is the table whose changes are captured, for publishing them
+// with a changelog.Publisher.
+const ChangesTable = racesTable
+
+// enableChanges starts capturing changes to races. Databases without change
+// capture still serve races, only ListChanges and WatchChanges fail.
+func (r *racesRepo) enableChanges() error {
+	err := changelog.Enable(context.Background(), r.db, racesTable)
+	if errors.Is(err, changelog.ErrUnsupported) {
+		log.Printf("warning: changes to %s are not captured: %s\n", racesTable, err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return changelog.EnableColumn(context.Background(), r.db, racesTable, "visible", changelog.Visibility)
+}
+
+func (r *racesRepo) ListChanges(ctx context.Context, afterSeq int64, limit int32) ([]*racing.Change, error) {
+	if limit <= 0 {
+		limit = sqlfilter.DefaultPageSize
+	}
+	if limit > sqlfilter.MaxPageSize {
+		limit = sqlfilter.MaxPageSize
+	}
+
+	changes, err := changelog.List(ctx, r.db, racesTable, afterSeq, int(limit))
+	if err != nil {
+		return nil, fmt.Errorf("listing changes to races after %d: %w", afterSeq, err)
+	}
+
+	raceChanges := make([]*racing.Change, 0, len(changes))
+	for _, change := range changes {
+		message, err := changeMessage(change)
+		if err != nil {
+			return nil, fmt.Errorf("listing changes to races after %d: %w", afterSeq, err)
+		}
+
+		raceChanges = append(raceChanges, message)
+	}
+
+	return raceChanges, nil
+}
+
+// ChangeMessage converts a captured change to a race into its protobuf
+// message, for publishing changes as protobuf.
+func ChangeMessage(change changelog.Change) (proto.Message, error) {
+	return changeMessage(change)
+}
+
+func changeMessage(change changelog.Change) (*racing.Change, error) {
+	changedAt, err := ptypes.TimestampProto(change.ChangedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &racing.Change{
+		Seq:       change.Seq,
+		RaceId:    change.RowID,
+		Operation: change.Operation,
+		ChangedAt: changedAt,
+	}, nil
+}
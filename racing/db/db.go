@@ -1,30 +1,65 @@
 package db
 
 import (
+	"context"
 	"time"
 
 	"syreclabs.com/go/faker"
 )
 
+// seedBatchSize is the number of rows inserted by each statement when seeding.
+const seedBatchSize = 500
+
+// SetRandomSeed makes the random dummy data seeded into an empty database the
+// same on every run given the same seed. Start times are still relative to
+// when the service starts.
+func SetRandomSeed(seed int64) {
+	faker.Seed(seed)
+}
+
 func (r *racesRepo) seed() error {
-	statement, err := r.db.Prepare(`CREATE TABLE IF NOT EXISTS races (id INTEGER PRIMARY KEY, meeting_id INTEGER, name TEXT, number INTEGER, visible INTEGER, advertised_start_time DATETIME)`)
-	if err == nil {
-		_, err = statement.Exec()
+	ctx := context.Background()
+
+	if err := r.db.CreateTable(ctx, racesTable, raceColumns); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	batch := tx.NewBatch(racesTable, []string{"id", "meeting_id", "name", "number", "visible", "advertised_start_time"}, seedBatchSize)
+
+	// Start times are relative to a single now so they are reproducible.
+	now := time.Now()
+
+	for i := 1; i <= r.seedCount; i++ {
+		err := batch.Add(ctx,
+			i,
+			faker.Number().Between(1, 10),
+			faker.Team().Name(),
+			faker.Number().Between(1, 12),
+			faker.Number().Between(0, 1),
+			faker.Time().Between(now.AddDate(0, 0, -1), now.AddDate(0, 0, 2)),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := batch.Flush(ctx); err != nil {
+		return err
 	}
 
-	for i := 1; i <= 100; i++ {
-		statement, err = r.db.Prepare(`INSERT OR IGNORE INTO races(id, meeting_id, name, number, visible, advertised_start_time) VALUES (?,?,?,?,?,?)`)
-		if err == nil {
-			_, err = statement.Exec(
-				i,
-				faker.Number().Between(1, 10),
-				faker.Team().Name(),
-				faker.Number().Between(1, 12),
-				faker.Number().Between(0, 1),
-				faker.Time().Between(time.Now().AddDate(0, 0, -1), time.Now().AddDate(0, 0, 2)).Format(time.RFC3339),
-			)
+	// The seeded rows have explicit ids so make sure created races don't
+	// collide with them.
+	if sync := r.db.Dialect.SyncSequence(racesTable, "id"); sync != "" {
+		if _, err := tx.ExecContext(ctx, sync); err != nil {
+			return err
 		}
 	}
 
-	return err
+	return tx.Commit()
 }
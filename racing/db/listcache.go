@@ -0,0 +1,114 @@
+package db
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"git.neds.sh/matty/entain/pkg/sqlfilter"
+	"git.neds.sh/matty/entain/pkg/stalecache"
+	"git.neds.sh/matty/entain/racing/proto/racing"
+	"google.golang.org/protobuf/proto"
+)
+
+// listCachedRacesRepo memoizes the lists and counts of races in memory, as
+// the same list is asked for many times over with the same result. Every
+// list cached is dropped when a race is written, and otherwise once it is
+// older than the TTL of the cache, so statuses lag by at most the TTL.
+type listCachedRacesRepo struct {
+	RacesRepo
+	cache *stalecache.Cache
+}
+
+// NewListCachedRacesRepo memoizes the lists and counts of races from the
+// repository, keyed by their filter, order and page.
+func NewListCachedRacesRepo(repo RacesRepo, cache *stalecache.Cache) RacesRepo {
+	return &listCachedRacesRepo{RacesRepo: repo, cache: cache}
+}
+
+func (r *listCachedRacesRepo) List(ctx context.Context, filter *racing.ListRacesRequestFilter, orderBy *string, page *sqlfilter.Page) ([]*racing.Race, error) {
+	key, err := stalecache.Key("List", filterOrEmpty(filter), listKey(orderBy, page)...)
+	if err != nil {
+		return r.RacesRepo.List(ctx, filter, orderBy, page)
+	}
+
+	if cached, _, ok := r.cache.Get(key); ok {
+		return proto.Clone(cached).(*racing.ListRacesResponse).Races, nil
+	}
+
+	races, err := r.RacesRepo.List(ctx, filter, orderBy, page)
+	if err != nil {
+		return nil, err
+	}
+
+	// The races are cloned as they are stored, as the caller is free to
+	// change them.
+	r.cache.Put(key, proto.Clone(&racing.ListRacesResponse{Races: races}))
+
+	return races, nil
+}
+
+func (r *listCachedRacesRepo) Count(ctx context.Context, filter *racing.ListRacesRequestFilter) (int64, error) {
+	key, err := stalecache.Key("Count", filterOrEmpty(filter))
+	if err != nil {
+		return r.RacesRepo.Count(ctx, filter)
+	}
+
+	if cached, _, ok := r.cache.Get(key); ok {
+		return cached.(*racing.ListRacesResponse).TotalSize, nil
+	}
+
+	count, err := r.RacesRepo.Count(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	r.cache.Put(key, &racing.ListRacesResponse{TotalSize: count})
+
+	return count, nil
+}
+
+func (r *listCachedRacesRepo) Create(ctx context.Context, race *racing.Race) (*racing.Race, error) {
+	defer r.cache.Purge()
+
+	return r.RacesRepo.Create(ctx, race)
+}
+
+func (r *listCachedRacesRepo) Update(ctx context.Context, race *racing.Race) (*racing.Race, error) {
+	defer r.cache.Purge()
+
+	return r.RacesRepo.Update(ctx, race)
+}
+
+func (r *listCachedRacesRepo) Delete(ctx context.Context, id int64) error {
+	defer r.cache.Purge()
+
+	return r.RacesRepo.Delete(ctx, id)
+}
+
+func (r *listCachedRacesRepo) SetResult(ctx context.Context, id int64, result *racing.RaceResult, settledAt time.Time) (*racing.Race, error) {
+	defer r.cache.Purge()
+
+	return r.RacesRepo.SetResult(ctx, id, result, settledAt)
+}
+
+func filterOrEmpty(filter *racing.ListRacesRequestFilter) *racing.ListRacesRequestFilter {
+	if filter == nil {
+		return &racing.ListRacesRequestFilter{}
+	}
+
+	return filter
+}
+
+// listKey is what a list depends on besides its filter.
+func listKey(orderBy *string, page *sqlfilter.Page) []string {
+	key := []string{"", ""}
+	if orderBy != nil {
+		key[0] = "order:" + *orderBy
+	}
+	if page != nil {
+		key[1] = strconv.Itoa(int(page.Size)) + "+" + strconv.FormatInt(page.Offset, 10)
+	}
+
+	return key
+}
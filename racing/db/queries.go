@@ -1,7 +1,15 @@
 package db
 
+// racesFrom joins the results of races, which are null until settled.
+const racesFrom = `FROM races
+	LEFT JOIN race_results ON race_results.race_id = races.id`
+
 const (
-	racesList = "list"
+	racesList   = "list"
+	racesCount  = "count"
+	racesInsert = "insert"
+	racesUpdate = "update"
+	racesDelete = "delete"
 )
 
 func getRaceQueries() map[string]string {
@@ -13,8 +21,34 @@ func getRaceQueries() map[string]string {
 				name, 
 				number, 
 				visible, 
-				advertised_start_time 
-			FROM races
+				advertised_start_time,
+				winner,
+				settled_at
+			` + racesFrom + `
+		`,
+		racesCount: `
+			SELECT COUNT(*) ` + racesFrom + `
+		`,
+		racesInsert: `
+			INSERT INTO races (
+				meeting_id, 
+				name, 
+				number, 
+				visible, 
+				advertised_start_time
+			) VALUES (?,?,?,?,?)
+		`,
+		racesUpdate: `
+			UPDATE races SET 
+				meeting_id = ?, 
+				name = ?, 
+				number = ?, 
+				visible = ?, 
+				advertised_start_time = ? 
+			WHERE id = ?
+		`,
+		racesDelete: `
+			DELETE FROM races WHERE id = ?
 		`,
 	}
 }
@@ -1,15 +1,20 @@
 package db
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"github.com/golang/protobuf/ptypes"
-	_ "github.com/mattn/go-sqlite3"
-	"strings"
 	"sync"
 	"time"
 
+	"git.neds.sh/matty/entain/pkg/fieldlimit"
+	"git.neds.sh/matty/entain/pkg/sqldialect"
+	"git.neds.sh/matty/entain/pkg/sqlfilter"
+	"git.neds.sh/matty/entain/pkg/sqlscan"
 	"git.neds.sh/matty/entain/racing/proto/racing"
+	"google.golang.org/protobuf/proto"
 )
 
 // RacesRepo provides repository access to races.
@@ -17,20 +22,54 @@ type RacesRepo interface {
 	// Init will initialise our races repository.
 	Init() error
 
-	// List will return a list of races.
-	List(filter *racing.ListRacesRequestFilter, orderBy *string) ([]*racing.Race, error)
+	// List will return a list of races, limited to the page if one is given.
+	List(ctx context.Context, filter *racing.ListRacesRequestFilter, orderBy *string, page *sqlfilter.Page) ([]*racing.Race, error)
+	// Count will return the number of races matching the filter.
+	Count(ctx context.Context, filter *racing.ListRacesRequestFilter) (int64, error)
+	// CountByStatus will return the number of races of each status.
+	CountByStatus(ctx context.Context) (map[string]int64, error)
 	// Get will return a race by ID.
-	Get(id int64) (*racing.Race, error)
+	Get(ctx context.Context, id int64) (*racing.Race, error)
+	// Create will insert a new race and return it with its assigned ID.
+	Create(ctx context.Context, race *racing.Race) (*racing.Race, error)
+	// Update will replace the race with the same ID.
+	Update(ctx context.Context, race *racing.Race) (*racing.Race, error)
+	// Delete will remove a race by ID.
+	Delete(ctx context.Context, id int64) error
+	// CheckSchema will compare the database schema against the columns
+	// required by the repository.
+	CheckSchema(ctx context.Context) ([]*racing.TableSchema, error)
+	// ListChanges will return up to limit changes to races after the
+	// sequence number afterSeq, oldest first.
+	ListChanges(ctx context.Context, afterSeq int64, limit int32) ([]*racing.Change, error)
+	// Search will return the races whose name contains the query, by
+	// advertised start time.
+	Search(ctx context.Context, in *racing.SearchRacesRequest) ([]*racing.Race, error)
+	// ListRunners will return the runners of a race, by number.
+	ListRunners(ctx context.Context, raceID int64) ([]*racing.Runner, error)
+	// SetResult will record the result of a race, settled at the time.
+	SetResult(ctx context.Context, id int64, result *racing.RaceResult, settledAt time.Time) (*racing.Race, error)
+	// GetResult will return the result of a settled race.
+	GetResult(ctx context.Context, id int64) (*racing.RaceResult, error)
 }
 
 type racesRepo struct {
-	db   *sql.DB
-	init sync.Once
+	db        *sqldialect.DB
+	limits    *fieldlimit.Limits
+	seedCount int
+	now       func() time.Time
+	init      sync.Once
+
+	// fullText is set by Init when race names are indexed for Search.
+	fullText bool
 }
 
-// NewRacesRepo creates a new races repository.
-func NewRacesRepo(db *sql.DB) RacesRepo {
-	return &racesRepo{db: db}
+// NewRacesRepo creates a new races repository. String fields longer than the
+// limits are truncated or rejected when written. An empty database is seeded
+// with seedCount random races. Relative filters, such as starting_within, are
+// resolved by the clock now.
+func NewRacesRepo(db *sqldialect.DB, limits *fieldlimit.Limits, seedCount int, now func() time.Time) RacesRepo {
+	return &racesRepo{db: db, limits: limits, seedCount: seedCount, now: now}
 }
 
 // Init prepares the race repository dummy data.
@@ -38,14 +77,48 @@ func (r *racesRepo) Init() error {
 	var err error
 
 	r.init.Do(func() {
+		// Catch a partially migrated database before seeding as inserts
+		// and scans fail in confusing ways when columns are missing.
+		if err = r.verifySchema(); err != nil {
+			return
+		}
+
 		// For test/example purposes, we seed the DB with some dummy races.
-		err = r.seed()
+		if err = r.seed(); err != nil {
+			return
+		}
+
+		if err = r.createRunnersTable(); err != nil {
+			return
+		}
+
+		if err = r.seedRunners(); err != nil {
+			return
+		}
+
+		if err = r.createResultsTable(); err != nil {
+			return
+		}
+
+		if err = r.enableChanges(); err != nil {
+			return
+		}
+
+		if err = r.enableSettlementChanges(); err != nil {
+			return
+		}
+
+		err = r.enableFullText()
 	})
 
-	return err
+	if err != nil {
+		return fmt.Errorf("initialising races: %w", err)
+	}
+
+	return nil
 }
 
-func (r *racesRepo) List(filter *racing.ListRacesRequestFilter, orderBy *string) ([]*racing.Race, error) {
+func (r *racesRepo) List(ctx context.Context, filter *racing.ListRacesRequestFilter, orderBy *string, page *sqlfilter.Page) ([]*racing.Race, error) {
 	var (
 		err   error
 		query string
@@ -55,133 +128,254 @@ func (r *racesRepo) List(filter *racing.ListRacesRequestFilter, orderBy *string)
 	query = getRaceQueries()[racesList]
 
 	query, args = r.applyFilter(query, filter)
-	query = r.applyOrdering(query, orderBy)
+	query, err = r.applyOrdering(query, orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	query = page.Apply(query)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("listing races filtered by %s: %w", filterFields.Shape(filter), err)
+	}
+	defer rows.Close()
+
+	races, err := r.scanRaces(rows)
+	if err != nil {
+		return nil, fmt.Errorf("listing races filtered by %s: %w", filterFields.Shape(filter), err)
+	}
+
+	return races, nil
+}
+
+func (r *racesRepo) Count(ctx context.Context, filter *racing.ListRacesRequestFilter) (int64, error) {
+	var count int64
+
+	query, args := r.applyFilter(getRaceQueries()[racesCount], filter)
+
+	if err := r.db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("counting races filtered by %s: %w", filterFields.Shape(filter), err)
+	}
+
+	return count, nil
+}
+
+// CountByStatus counts the settled races as finished, the others yet to start
+// as open and the rest as closed, as their status is derived.
+func (r *racesRepo) CountByStatus(ctx context.Context) (map[string]int64, error) {
+	total, err := r.Count(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
 
-	rows, err := r.db.Query(query, args...)
+	finished, err := r.Count(ctx, &racing.ListRacesRequestFilter{Settled: proto.Bool(true)})
 	if err != nil {
 		return nil, err
 	}
 
-	return r.scanRaces(rows)
+	open, err := r.Count(ctx, &racing.ListRacesRequestFilter{AdvertisedStartAfter: ptypes.TimestampNow(), Settled: proto.Bool(false)})
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]int64{
+		sqlfilter.StatusOpen:     open,
+		sqlfilter.StatusClosed:   total - open - finished,
+		sqlfilter.StatusFinished: finished,
+	}, nil
 }
 
-func (r *racesRepo) Get(id int64) (*racing.Race, error) {
+func (r *racesRepo) Get(ctx context.Context, id int64) (*racing.Race, error) {
 	// Repurpose listing functionality with an additional filter for
 	// consistancy.
 	filter := racing.ListRacesRequestFilter{Ids: []int64{id}}
-	races, err := r.List(&filter, nil)
+	races, err := r.List(ctx, &filter, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if len(races) != 1 {
-		// From the uber style guide fmt.Errorf is appropriate
-		return nil, fmt.Errorf("no race with id: %v", id)
+
+	switch len(races) {
+	case 0:
+		return nil, &NotFoundError{Resource: ResourceRace, ID: id}
+	case 1:
+		return races[0], nil
+	default:
+		// IDs are the primary key so this is a broken database rather
+		// than something the caller can act on.
+		return nil, fmt.Errorf("found %d races with id %v, expected one", len(races), id)
 	}
-	return races[0], nil
 }
 
-func (r *racesRepo) applyFilter(query string, filter *racing.ListRacesRequestFilter) (string, []interface{}) {
-	var (
-		clauses []string
-		args    []interface{}
-	)
-
-	if filter == nil {
-		return query, args
+func (r *racesRepo) Create(ctx context.Context, race *racing.Race) (*racing.Race, error) {
+	if err := r.limitFields(race); err != nil {
+		return nil, err
 	}
 
-	if len(filter.MeetingIds) > 0 {
-		clauses = append(clauses, "meeting_id IN ("+strings.Repeat("?,", len(filter.MeetingIds)-1)+"?)")
+	args, err := raceColumnValues(race)
+	if err != nil {
+		return nil, err
+	}
 
-		for _, meetingID := range filter.MeetingIds {
-			args = append(args, meetingID)
-		}
+	id, err := r.db.InsertID(ctx, getRaceQueries()[racesInsert], args...)
+	if err != nil {
+		return nil, fmt.Errorf("creating race: %w", err)
 	}
 
-	if len(filter.Ids) > 0 {
-		clauses = append(clauses, "id IN ("+strings.Repeat("?,", len(filter.Ids)-1)+"?)")
+	return r.Get(ctx, id)
+}
 
-		for _, ID := range filter.Ids {
-			args = append(args, ID)
-		}
+func (r *racesRepo) Update(ctx context.Context, race *racing.Race) (*racing.Race, error) {
+	if err := r.limitFields(race); err != nil {
+		return nil, err
 	}
 
-	if filter.Visible != nil {
-		clauses = append(clauses, "visible = ?")
-		args = append(args, *filter.Visible)
+	args, err := raceColumnValues(race)
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, race.Id)
+
+	result, err := r.db.ExecContext(ctx, getRaceQueries()[racesUpdate], args...)
+	if err != nil {
+		return nil, fmt.Errorf("updating race %d: %w", race.Id, err)
 	}
 
-	if len(clauses) != 0 {
-		query += " WHERE " + strings.Join(clauses, " AND ")
+	if err := expectRowAffected(result, race.Id); err != nil {
+		return nil, err
 	}
 
-	return query, args
+	return r.Get(ctx, race.Id)
 }
 
-func convertOrderByFieldToSql(orderByField string) (orderByFieldSql string, ok bool) {
-	// Important to verify against allowed field names to protect from SQL
-	// injection.
-	sortableFields := map[string]string{
-		"name":                  "name",
-		"number":                "number",
-		"advertised_start_time": "advertised_start_time",
+func (r *racesRepo) Delete(ctx context.Context, id int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("deleting race %d: %w", id, err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, getRaceQueries()[racesDelete], id)
+	if err != nil {
+		return fmt.Errorf("deleting race %d: %w", id, err)
 	}
 
-	orderByFieldSplit := strings.Fields(orderByField)
-	if len(orderByFieldSplit) > 2 {
-		return "", false
+	if err := expectRowAffected(result, id); err != nil {
+		return err
 	}
-	field := orderByFieldSplit[0]
-	databaseField, ok := sortableFields[field]
-	if !ok {
-		return "", false
+
+	if _, err := tx.ExecContext(ctx, runnersDelete, id); err != nil {
+		return fmt.Errorf("deleting runners of race %d: %w", id, err)
 	}
-	orderByFieldSql += databaseField
-	if len(orderByFieldSplit) == 2 {
-		desc := strings.ToLower(orderByFieldSplit[1])
-		if strings.Contains(desc, "desc") {
-			orderByFieldSql += " DESC"
-		}
+
+	if _, err := tx.ExecContext(ctx, resultDelete, id); err != nil {
+		return fmt.Errorf("deleting result of race %d: %w", id, err)
 	}
-	return orderByFieldSql, true
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("deleting race %d: %w", id, err)
+	}
+
+	return nil
 }
 
-func convertOrderByToSql(orderBy string) (orderBySql string) {
-	var sqls []string
-	orderBySql = ""
+// limitFields enforces the length limits of the string fields of a race.
+func (r *racesRepo) limitFields(race *racing.Race) error {
+	if race == nil {
+		return nil
+	}
 
-	for _, orderByField := range strings.Split(orderBy, ",") {
-		orderByField = strings.TrimSpace(orderByField)
-		if orderByFieldSql, ok := convertOrderByFieldToSql(orderByField); ok {
-			sqls = append(sqls, orderByFieldSql)
+	for _, field := range []struct {
+		name  string
+		value *string
+	}{
+		{"name", &race.Name},
+	} {
+		if err := r.limits.Apply(field.name, field.value); err != nil {
+			return invalidf("race %s", err)
 		}
 	}
-	if len(sqls) != 0 {
-		orderBySql = " ORDER BY " + strings.Join(sqls, ", ")
+
+	return nil
+}
+
+// raceColumnValues validates a race and returns the values for the writable
+// columns in the order used by the insert and update queries. The status is
+// derived so is not stored.
+func raceColumnValues(race *racing.Race) ([]interface{}, error) {
+	if race == nil {
+		return nil, invalidf("race is required")
+	}
+	if race.Name == "" {
+		return nil, invalidf("race name is required")
+	}
+	if race.AdvertisedStartTime == nil {
+		return nil, invalidf("race advertised start time is required")
 	}
-	return orderBySql
+
+	advertisedStart, err := ptypes.Timestamp(race.AdvertisedStartTime)
+	if err != nil {
+		return nil, invalidf("race advertised start time is invalid: %s", err)
+	}
+
+	return []interface{}{
+		race.MeetingId,
+		race.Name,
+		race.Number,
+		race.Visible,
+		advertisedStart,
+	}, nil
 }
 
-// If specified this will apply the ordering specified in the request to the
-// SQL SELECT query. The format of the order_by in the query is a comma
-// seperated list of fields with "desc" as a suffix to change the ordering.
-// e.g. "advertised_start_time, name desc"
-// https://cloud.google.com/apis/design/design_patterns#sorting_order
-func (r *racesRepo) applyOrdering(query string, orderBy *string) string {
-	if orderBy == nil {
-		return query
+// expectRowAffected returns an error if a write by ID matched no rows.
+func expectRowAffected(result sql.Result, id int64) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("checking race %d was written: %w", id, err)
+	}
+	if affected == 0 {
+		return &NotFoundError{Resource: ResourceRace, ID: id}
 	}
+	return nil
+}
 
-	orderBySql := convertOrderByToSql(*orderBy)
+// filterFields maps the fields of the ListRacesRequestFilter onto the columns
+// they filter.
+var filterFields = sqlfilter.Filters{
+	{Name: "meeting_ids", Columns: []string{"meeting_id"}},
+	{Name: "ids", Columns: []string{"id"}},
+	{Name: "visible", Columns: []string{"visible"}},
+	{Name: "advertised_start_after", Columns: []string{"advertised_start_time"}, Op: sqlfilter.AtOrAfter},
+	{Name: "advertised_start_before", Columns: []string{"advertised_start_time"}, Op: sqlfilter.Before},
+	{Name: "starting_within", Columns: []string{"advertised_start_time"}, Op: sqlfilter.Within},
+	{Name: "settled", Columns: []string{"settled_at"}, Op: sqlfilter.IsSet},
+	{Name: "number", Columns: []string{"number"}},
+	{Name: "name_contains", Columns: []string{"name"}, Op: sqlfilter.Contains},
+}
+
+// sortableFields maps the fields which can be given in an order_by to their
+// columns.
+var sortableFields = sqlfilter.Sortable{
+	"name":                  "name",
+	"number":                "number",
+	"advertised_start_time": "advertised_start_time",
+	"settled_at":            "settled_at",
+}
 
-	return query + orderBySql
+func (r *racesRepo) applyFilter(query string, filter *racing.ListRacesRequestFilter) (string, []interface{}) {
+	return filterFields.Apply(r.db.Dialect, r.now, query, filter)
 }
 
-func getRaceStatus(advertisedStart time.Time) string {
-	if advertisedStart.Before(time.Now()) {
-		return "CLOSED"
+// If specified this will apply the ordering specified in the request to the
+// SQL SELECT query. Races are ordered by id last so pages don't overlap, see
+// sqlfilter.OrderBy.
+func (r *racesRepo) applyOrdering(query string, orderBy *string) (string, error) {
+	query, err := sortableFields.Apply(query, orderBy)
+	if err != nil {
+		return "", invalidf("%s", err)
 	}
-	return "OPEN"
+
+	return query, nil
 }
 
 func (m *racesRepo) scanRaces(
@@ -192,13 +386,31 @@ func (m *racesRepo) scanRaces(
 	for rows.Next() {
 		var race racing.Race
 		var advertisedStart time.Time
+		var winner sql.NullInt64
+		var settledAt sql.NullTime
+
+		// Scan by column name so the SELECT can change without breaking
+		// the scan.
+		targets, err := sqlscan.Targets(rows, map[string]interface{}{
+			"id":                    &race.Id,
+			"meeting_id":            &race.MeetingId,
+			"name":                  &race.Name,
+			"number":                &race.Number,
+			"visible":               &race.Visible,
+			"advertised_start_time": &advertisedStart,
+			"winner":                &winner,
+			"settled_at":            &settledAt,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("scanning race: %w", err)
+		}
 
-		if err := rows.Scan(&race.Id, &race.MeetingId, &race.Name, &race.Number, &race.Visible, &advertisedStart); err != nil {
-			if err == sql.ErrNoRows {
+		if err := rows.Scan(targets...); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
 				return nil, nil
 			}
 
-			return nil, err
+			return nil, fmt.Errorf("scanning race: %w", err)
 		}
 
 		ts, err := ptypes.TimestampProto(advertisedStart)
@@ -208,10 +420,23 @@ func (m *racesRepo) scanRaces(
 
 		race.AdvertisedStartTime = ts
 
-		race.Status = getRaceStatus(advertisedStart)
+		race.Status = sqlfilter.Status(advertisedStart)
+
+		if settledAt.Valid {
+			race.Status = sqlfilter.StatusFinished
+			race.Result = &racing.RaceResult{Winner: winner.Int64}
+
+			if race.SettledAt, err = ptypes.TimestampProto(settledAt.Time); err != nil {
+				return nil, err
+			}
+		}
 
 		races = append(races, &race)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("scanning races: %w", err)
+	}
+
 	return races, nil
 }
@@ -0,0 +1,122 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"git.neds.sh/matty/entain/pkg/changelog"
+	"git.neds.sh/matty/entain/pkg/sqldialect"
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+const resultsTable = "race_results"
+
+// changeSettle is the operation of the change recorded when the result of a
+// race is set, so settlement can be followed apart from other updates.
+const changeSettle = "SETTLE"
+
+// resultColumns are the columns of the results table, kept apart from races
+// so existing databases need no migration. Each race has at most one result,
+// keyed by its ID.
+var resultColumns = []sqldialect.Column{
+	{Name: "race_id", Type: sqldialect.Serial},
+	{Name: "winner", Type: sqldialect.Integer},
+	{Name: "settled_at", Type: sqldialect.Timestamp},
+}
+
+const (
+	resultInsert = `INSERT INTO race_results (race_id, winner, settled_at) VALUES (?,?,?)`
+	resultUpdate = `UPDATE race_results SET winner = ?, settled_at = ? WHERE race_id = ?`
+	resultDelete = `DELETE FROM race_results WHERE race_id = ?`
+	raceExists   = `SELECT COUNT(*) FROM races WHERE id = ?`
+
+	// runnersRunning counts the runners of a race, and those of them not
+	// scratched with a number.
+	runnersRunning = `
+		SELECT COUNT(*), COALESCE(SUM(CASE WHEN number = ? AND NOT scratched THEN 1 ELSE 0 END), 0)
+		FROM runners WHERE race_id = ?
+	`
+)
+
+func (r *racesRepo) createResultsTable() error {
+	return r.db.CreateTable(context.Background(), resultsTable, resultColumns)
+}
+
+// enableSettlementChanges records a settle change to a race whenever its
+// result is set.
+func (r *racesRepo) enableSettlementChanges() error {
+	err := changelog.EnableRelated(context.Background(), r.db, racesTable, resultsTable, "race_id", changeSettle)
+	if errors.Is(err, changelog.ErrUnsupported) {
+		log.Printf("warning: settlement of %s is not captured: %s\n", racesTable, err)
+		return nil
+	}
+
+	return err
+}
+
+// SetResult records the result of the race as settled at the time, replacing
+// any result already recorded. The winner must be a runner of the race not
+// scratched, unless the race has no runners.
+func (r *racesRepo) SetResult(ctx context.Context, id int64, result *racing.RaceResult, settledAt time.Time) (*racing.Race, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("setting result of race %d: %w", id, err)
+	}
+	defer tx.Rollback()
+
+	var exists int
+	if err := tx.QueryRowContext(ctx, raceExists, id).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("setting result of race %d: %w", id, err)
+	}
+	if exists == 0 {
+		return nil, &NotFoundError{Resource: ResourceRace, ID: id}
+	}
+
+	var runners, running int
+	if err := tx.QueryRowContext(ctx, runnersRunning, result.Winner, id).Scan(&runners, &running); err != nil {
+		return nil, fmt.Errorf("checking runners of race %d: %w", id, err)
+	}
+	if runners > 0 && running == 0 {
+		return nil, invalidf("runner %d is not running in race %d", result.Winner, id)
+	}
+
+	updated, err := tx.ExecContext(ctx, resultUpdate, result.Winner, settledAt, id)
+	if err != nil {
+		return nil, fmt.Errorf("setting result of race %d: %w", id, err)
+	}
+
+	affected, err := updated.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("setting result of race %d: %w", id, err)
+	}
+
+	if affected == 0 {
+		if _, err := tx.ExecContext(ctx, resultInsert, id, result.Winner, settledAt); err != nil {
+			return nil, fmt.Errorf("setting result of race %d: %w", id, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("setting result of race %d: %w", id, err)
+	}
+
+	return r.Get(ctx, id)
+}
+
+// GetResult returns the result of the race, or a NotFoundError for the result
+// when the race hasn't been settled.
+func (r *racesRepo) GetResult(ctx context.Context, id int64) (*racing.RaceResult, error) {
+	race, err := r.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if race.Result == nil {
+		return nil, &NotFoundError{Resource: ResourceResult, ID: id}
+	}
+
+	return race.Result, nil
+}
@@ -0,0 +1,140 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"git.neds.sh/matty/entain/pkg/sqldialect"
+	"git.neds.sh/matty/entain/racing/proto/racing"
+	"syreclabs.com/go/faker"
+)
+
+const runnersTable = "runners"
+
+// runnerColumns are the columns of the runners table, kept apart from races
+// so existing databases need no migration.
+var runnerColumns = []sqldialect.Column{
+	{Name: "id", Type: sqldialect.Serial},
+	{Name: "race_id", Type: sqldialect.Integer},
+	{Name: "number", Type: sqldialect.Integer},
+	{Name: "barrier", Type: sqldialect.Integer},
+	{Name: "name", Type: sqldialect.Text},
+	{Name: "scratched", Type: sqldialect.Boolean},
+}
+
+const (
+	runnersList   = `SELECT id, number, barrier, name, scratched FROM runners WHERE race_id = ? ORDER BY number`
+	runnersDelete = `DELETE FROM runners WHERE race_id = ?`
+
+	// racesWithoutRunners selects the seeded races, those with IDs up to the
+	// seed count, which have no runners yet.
+	racesWithoutRunners = `
+		SELECT id FROM races
+		WHERE id <= ? AND NOT EXISTS (SELECT 1 FROM runners WHERE runners.race_id = races.id)
+		ORDER BY id
+	`
+)
+
+func (r *racesRepo) createRunnersTable() error {
+	return r.db.CreateTable(context.Background(), runnersTable, runnerColumns)
+}
+
+// seedRunners gives the seeded races without runners a field of fake runners,
+// each drawing a barrier and a few scratched. Races created through the API
+// are left without runners.
+func (r *racesRepo) seedRunners() error {
+	ctx := context.Background()
+
+	rows, err := r.db.QueryContext(ctx, racesWithoutRunners, r.seedCount)
+	if err != nil {
+		return err
+	}
+
+	var raceIDs []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		raceIDs = append(raceIDs, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if len(raceIDs) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	batch := tx.NewBatch(runnersTable, []string{"race_id", "number", "barrier", "name", "scratched"}, seedBatchSize)
+
+	for _, raceID := range raceIDs {
+		field := faker.RandomInt(6, 14)
+
+		// Barriers are drawn by shuffling them with the seeded source so
+		// the runners are reproducible too.
+		barriers := make([]int, field)
+		for i := range barriers {
+			barriers[i] = i + 1
+		}
+		for i := len(barriers) - 1; i > 0; i-- {
+			j := faker.RandomInt(0, i)
+			barriers[i], barriers[j] = barriers[j], barriers[i]
+		}
+
+		for number := 1; number <= field; number++ {
+			err := batch.Add(ctx,
+				raceID,
+				number,
+				barriers[number-1],
+				strings.Title(faker.Commerce().Color()+" "+faker.Team().Creature()),
+				faker.RandomInt(1, 10) == 1,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := batch.Flush(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *racesRepo) ListRunners(ctx context.Context, raceID int64) ([]*racing.Runner, error) {
+	rows, err := r.db.QueryContext(ctx, runnersList, raceID)
+	if err != nil {
+		return nil, fmt.Errorf("listing runners of race %d: %w", raceID, err)
+	}
+	defer rows.Close()
+
+	return scanRunners(rows)
+}
+
+func scanRunners(rows *sql.Rows) ([]*racing.Runner, error) {
+	var runners []*racing.Runner
+
+	for rows.Next() {
+		var runner racing.Runner
+
+		if err := rows.Scan(&runner.Id, &runner.Number, &runner.Barrier, &runner.Name, &runner.Scratched); err != nil {
+			return nil, fmt.Errorf("scanning runner: %w", err)
+		}
+
+		runners = append(runners, &runner)
+	}
+
+	return runners, rows.Err()
+}
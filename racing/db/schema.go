@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"git.neds.sh/matty/entain/pkg/sqldialect"
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+const racesTable = "races"
+
+// raceColumns are the columns of the races table created when seeding.
+var raceColumns = []sqldialect.Column{
+	{Name: "id", Type: sqldialect.Serial},
+	{Name: "meeting_id", Type: sqldialect.Integer},
+	{Name: "name", Type: sqldialect.Text},
+	{Name: "number", Type: sqldialect.Integer},
+	{Name: "visible", Type: sqldialect.Boolean},
+	{Name: "advertised_start_time", Type: sqldialect.Timestamp},
+}
+
+// requiredRaceColumns are the columns of the races table which are read or
+// written by the repository. Keep this in sync with the queries.
+var requiredRaceColumns = []string{
+	"id",
+	"meeting_id",
+	"name",
+	"number",
+	"visible",
+	"advertised_start_time",
+}
+
+func (r *racesRepo) CheckSchema(ctx context.Context) ([]*racing.TableSchema, error) {
+	columns, err := r.db.TableColumns(ctx, racesTable)
+	if err != nil {
+		return nil, fmt.Errorf("reading columns of %s: %w", racesTable, err)
+	}
+
+	return []*racing.TableSchema{compareColumns(racesTable, columns, requiredRaceColumns)}, nil
+}
+
+// verifySchema makes sure the races table has every column the repository
+// needs so a partially migrated database fails at startup with a useful
+// message rather than on the first query. Unknown columns are only warned
+// about as they do no harm.
+func (r *racesRepo) verifySchema() error {
+	ctx := context.Background()
+
+	columns, err := r.db.TableColumns(ctx, racesTable)
+	if err != nil {
+		return fmt.Errorf("reading columns of %s: %w", racesTable, err)
+	}
+
+	if len(columns) == 0 {
+		// A fresh database, the table will be created when seeding.
+		return nil
+	}
+
+	table := compareColumns(racesTable, columns, requiredRaceColumns)
+
+	if len(table.UnknownColumns) > 0 {
+		log.Printf("warning: %s table has unknown columns: %s\n", table.Table, strings.Join(table.UnknownColumns, ", "))
+	}
+
+	if !table.Ok {
+		return fmt.Errorf("%s table is missing columns: %s (has the database been migrated?)", table.Table, strings.Join(table.MissingColumns, ", "))
+	}
+
+	return nil
+}
+
+// compareColumns compares the columns of a table in the database against the
+// expected columns.
+func compareColumns(name string, columns map[string]bool, expected []string) *racing.TableSchema {
+	table := racing.TableSchema{Table: name}
+
+	for _, column := range expected {
+		if !columns[column] {
+			table.MissingColumns = append(table.MissingColumns, column)
+		}
+		delete(columns, column)
+	}
+
+	for column := range columns {
+		table.UnknownColumns = append(table.UnknownColumns, column)
+	}
+	sort.Strings(table.UnknownColumns)
+
+	table.Ok = len(table.MissingColumns) == 0
+
+	return &table
+}
@@ -0,0 +1,84 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"git.neds.sh/matty/entain/pkg/fulltext"
+	"git.neds.sh/matty/entain/pkg/sqlfilter"
+	"git.neds.sh/matty/entain/racing/proto/racing"
+	"google.golang.org/protobuf/proto"
+)
+
+// searchFields maps the fields of the SearchRacesRequest onto the columns they
+// match, scanning the names for the query.
+var searchFields = sqlfilter.Filters{
+	{Name: "query", Columns: []string{"name"}, Op: sqlfilter.Contains},
+	{Name: "visible", Columns: []string{"visible"}},
+}
+
+// fullTextSearchFields match the words of the query in the full-text index
+// of the names instead.
+var fullTextSearchFields = sqlfilter.Filters{
+	{Name: "query", Columns: []string{fulltext.Table(racesTable)}, Op: sqlfilter.Matches},
+	{Name: "visible", Columns: []string{"visible"}},
+}
+
+// enableFullText indexes the names of races for Search. Databases without
+// full-text search scan the names instead.
+func (r *racesRepo) enableFullText() error {
+	err := fulltext.Enable(context.Background(), r.db, racesTable, []string{"name"})
+	if errors.Is(err, fulltext.ErrUnsupported) {
+		log.Printf("warning: race names are not indexed for search: %s\n", err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	r.fullText = true
+
+	return nil
+}
+
+func (r *racesRepo) Search(ctx context.Context, in *racing.SearchRacesRequest) ([]*racing.Race, error) {
+	limit := in.GetLimit()
+	if limit <= 0 {
+		limit = sqlfilter.DefaultPageSize
+	}
+	if limit > sqlfilter.MaxPageSize {
+		limit = sqlfilter.MaxPageSize
+	}
+
+	fields := searchFields
+
+	if r.fullText {
+		match := fulltext.Query(in.GetQuery())
+		if match == "" {
+			return nil, nil
+		}
+
+		in = proto.Clone(in).(*racing.SearchRacesRequest)
+		in.Query = match
+		fields = fullTextSearchFields
+	}
+
+	query, args := fields.Apply(r.db.Dialect, nil, getRaceQueries()[racesList], in)
+	query += " ORDER BY advertised_start_time"
+	query = (&sqlfilter.Page{Size: limit}).Apply(query)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("searching races: %w", err)
+	}
+	defer rows.Close()
+
+	races, err := r.scanRaces(rows)
+	if err != nil {
+		return nil, fmt.Errorf("searching races: %w", err)
+	}
+
+	return races, nil
+}
@@ -3,11 +3,16 @@ module git.neds.sh/matty/entain/racing
 go 1.16
 
 require (
+	git.neds.sh/matty/entain/pkg v0.0.0-00010101000000-000000000000
 	github.com/bufbuild/buf v0.37.0 // indirect
+	github.com/envoyproxy/protoc-gen-validate v0.6.3
 	github.com/golang/protobuf v1.5.2
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.7.2
-	github.com/mattn/go-sqlite3 v1.14.10
+	github.com/mattn/go-sqlite3 v1.14.10 // indirect
+	github.com/xitongsys/parquet-go v1.6.2
+	go.uber.org/zap v1.16.0
 	golang.org/x/net v0.0.0-20211216030914-fe4d6282115f
+	golang.org/x/sync v0.0.0-20210220032951-036812b2e83c // indirect
 	golang.org/x/sys v0.0.0-20211216021012-1d35b9e2eb4e // indirect
 	golang.org/x/text v0.3.7 // indirect
 	google.golang.org/genproto v0.0.0-20211223182754-3ac035c7e7cb
@@ -16,3 +21,5 @@ require (
 	google.golang.org/protobuf v1.27.1
 	syreclabs.com/go/faker v1.2.3
 )
+
+replace git.neds.sh/matty/entain/pkg => ../pkg
@@ -1,59 +1,320 @@
 package main
 
 import (
-	"database/sql"
+	"context"
+	"errors"
 	"flag"
 	"log"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
+	"git.neds.sh/matty/entain/pkg/changelog"
+	"git.neds.sh/matty/entain/pkg/config"
+	"git.neds.sh/matty/entain/pkg/errstatus"
+	"git.neds.sh/matty/entain/pkg/fieldlimit"
+	"git.neds.sh/matty/entain/pkg/logging"
+	"git.neds.sh/matty/entain/pkg/logsample"
+	"git.neds.sh/matty/entain/pkg/metrics"
+	"git.neds.sh/matty/entain/pkg/payloadlog"
+	"git.neds.sh/matty/entain/pkg/ratelimit"
+	"git.neds.sh/matty/entain/pkg/rediscache"
+	"git.neds.sh/matty/entain/pkg/sqldialect"
+	"git.neds.sh/matty/entain/pkg/stalecache"
+	"git.neds.sh/matty/entain/pkg/tlsconfig"
+	"git.neds.sh/matty/entain/pkg/tracing"
 	"git.neds.sh/matty/entain/racing/db"
 	"git.neds.sh/matty/entain/racing/proto/racing"
 	"git.neds.sh/matty/entain/racing/service"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
 var (
-	grpcEndpoint = flag.String("grpc-endpoint", "localhost:9000", "gRPC server endpoint")
+	grpcEndpoint          = flag.String("grpc-endpoint", ":9000", "Address the gRPC server listens on")
+	changeSink            = flag.String("change-sink", "", "Where changes to races are published as they are made, stdout, an http(s) URL to POST them to, kafka://host:port,.../topic or nats://host:port,.../prefix, disabled when empty")
+	changePublishInterval = flag.Duration("change-publish-interval", time.Second, "How often new changes to races are published to the change sink")
+	redisURL              = flag.String("redis-url", "", "Redis to cache races got by ID in, e.g. redis://localhost:6379/0, disabled when empty")
+	redisTTL              = flag.Duration("redis-ttl", 5*time.Second, "How long races got by ID are cached in Redis")
+	listCacheTTL          = flag.Duration("list-cache-ttl", 0, "How long lists and counts of races are memoized in memory, disabled when 0")
+	listCacheSize         = flag.Int("list-cache-size", 1000, "Number of lists and counts of races memoized in memory")
+	watchPollInterval     = flag.Duration("watch-poll-interval", time.Second, "How often races are checked for changes to stream to watchers, while there are any")
+	featureFlagFile       = flag.String("feature-flags", "", "JSON file of feature flag states, reloaded when changed")
+	dbDriver              = flag.String("db-driver", "sqlite3", "Database driver, one of: "+strings.Join(sqldialect.Drivers(), ", "))
+	dsn                   = flag.String("dsn", "./db/racing.db", "Data source name of the database for the driver")
+	logFormat             = flag.String("log-format", logging.FormatJSON, "Format of the log lines, json or console")
+	logLevel              = flag.String("log-level", "info", "Least severe level of the messages logged, one of: debug, info, warn, error")
+	logSampleRate         = flag.Float64("log-sample-rate", 0, "Fraction of requests logged, can be changed per method at runtime")
+	fieldMaxLengths       = flag.String("field-max-lengths", "name=255", "Maximum lengths of string fields as field=length pairs")
+	fieldLengthPolicy     = flag.String("field-length-policy", "truncate", "What happens to fields over their maximum length, truncate or reject")
+	debugAddr             = flag.String("debug-addr", "", "Address to serve expvar counters on /debug/vars, disabled when empty")
+	metricsAddr           = flag.String("metrics-addr", "", "Address to serve Prometheus metrics on /metrics, disabled when empty")
+	randomSeed            = flag.Int64("seed", 0, "Seed of the random data an empty database is seeded with, 0 picks one at random")
+	seedCount             = flag.Int("seed-count", 100, "Number of random races an empty database is seeded with")
+	healthInterval        = flag.Duration("health-check-interval", 10*time.Second, "How often the database is pinged to report the health of the server")
+	queryTimeout          = flag.Duration("query-timeout", 10*time.Second, "How long each of the queries run concurrently for a response may take, unlimited when 0")
+	requestLogSize        = flag.Int("request-log-size", 100, "Number of the most recent requests kept for ListRecentRequests, none when 0")
+	shutdownTimeout       = flag.Duration("shutdown-timeout", 30*time.Second, "How long in-flight requests are given to finish when shutting down before they are cancelled")
+	requireAuth           = flag.Bool("require-auth", false, "Reject changes made without a client authenticated by its API key at the gateway")
+	requireRole           = flag.String("require-role", "", "Role, e.g. admin, clients must be granted at the gateway to make changes, any client can when empty")
+	rateLimitFile         = flag.String("rate-limits", "", "JSON file of the rate limits of each client by method, disabled when empty")
+	tlsCert               = flag.String("tls-cert", "", "PEM certificate to serve gRPC over TLS with, plaintext when empty")
+	tlsKey                = flag.String("tls-key", "", "PEM private key of the TLS certificate")
+	tlsClientCA           = flag.String("tls-client-ca", "", "PEM CA clients must present a certificate signed by (mTLS), not required when empty")
+	serveReflection       = flag.Bool("reflection", true, "Serve gRPC reflection so tools such as grpcurl can describe the server without the protos")
+	serveStaleFor         = flag.Duration("serve-stale-for", 0, "How old the last list of races for a request can be to be served, flagged stale, when the database errors, disabled when 0")
 )
 
+// featureFlagReloadInterval is how often the feature flags file is checked for
+// changes.
+const featureFlagReloadInterval = 10 * time.Second
+
+// fallbackCacheSize is the number of list requests whose last responses are
+// kept for -serve-stale-for.
+const fallbackCacheSize = 1000
+
 func main() {
-	flag.Parse()
+	if err := config.Parse(flag.CommandLine, "RACING", os.Args[1:]); err != nil {
+		log.Fatalf("failed reading config: %s\n", err)
+	}
 
-	if err := run(); err != nil {
-		log.Fatalf("failed running grpc server: %s\n", err)
+	logger, err := logging.New(*logFormat, *logLevel)
+	if err != nil {
+		log.Fatalf("failed creating logger: %s\n", err)
+	}
+	defer logger.Sync()
+
+	if err := run(logger); err != nil {
+		logger.Fatal("failed running grpc server", zap.Error(err))
 	}
 }
 
-func run() error {
-	conn, err := net.Listen("tcp", ":9000")
+func run(logger *zap.Logger) error {
+	if *debugAddr != "" {
+		go func() {
+			logger.Info("debug server listening", zap.String("addr", *debugAddr))
+			if err := http.ListenAndServe(*debugAddr, nil); err != nil {
+				logger.Error("failed running debug server", zap.Error(err))
+			}
+		}()
+	}
+
+	conn, err := net.Listen("tcp", *grpcEndpoint)
+	if err != nil {
+		return err
+	}
+
+	racingDB, err := sqldialect.Open(*dbDriver, *dsn)
 	if err != nil {
 		return err
 	}
+	// Closed once in-flight requests have drained, so none are cut off
+	// mid-query.
+	defer racingDB.Close()
 
-	racingDB, err := sql.Open("sqlite3", "./db/racing.db")
+	registry := metrics.NewRegistry()
+	racingDB.ObserveQueries(metrics.NewQueries(registry).Observe)
+
+	tracer, err := tracing.NewFromEnv("racing")
 	if err != nil {
 		return err
 	}
+	racingDB.ObserveQueries(tracer.ObserveQuery)
+
+	if *metricsAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", registry)
 
-	racesRepo := db.NewRacesRepo(racingDB)
+		go func() {
+			logger.Info("metrics server listening", zap.String("addr", *metricsAddr))
+			if err := http.ListenAndServe(*metricsAddr, mux); err != nil {
+				logger.Error("failed running metrics server", zap.Error(err))
+			}
+		}()
+	}
+
+	fieldLimits, err := newFieldLimits("races")
+	if err != nil {
+		return err
+	}
+
+	seed := *randomSeed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	db.SetRandomSeed(seed)
+	logger.Info("random data seed", zap.Int64("seed", seed))
+
+	racesRepo := db.NewRacesRepo(racingDB, fieldLimits, *seedCount, time.Now)
 	if err := racesRepo.Init(); err != nil {
 		return err
 	}
 
-	grpcServer := grpc.NewServer()
+	if *redisURL != "" {
+		cache, err := rediscache.New(*redisURL, "racing:", *redisTTL)
+		if err != nil {
+			return err
+		}
+		defer cache.Close()
+
+		racesRepo = db.NewCachedRacesRepo(racesRepo, cache)
+	}
+
+	if *listCacheTTL > 0 {
+		racesRepo = db.NewListCachedRacesRepo(racesRepo, stalecache.New(*listCacheTTL, *listCacheSize))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	featureFlags := service.NewFeatureFlags()
+	if *featureFlagFile != "" {
+		if err := featureFlags.LoadFile(*featureFlagFile); err != nil {
+			return err
+		}
+		go featureFlags.Watch(ctx, *featureFlagFile, featureFlagReloadInterval)
+	}
+
+	raceHub := service.NewRaceHub(racesRepo, *watchPollInterval, time.Now, logger)
+	go raceHub.Run(ctx)
+
+	sink, err := changelog.ParseSink(*changeSink, db.ChangeMessage)
+	if err != nil {
+		return err
+	}
+	go changelog.NewPublisher(racingDB, db.ChangesTable, sink, *changePublishInterval, logger).Run(ctx)
+
+	logSampler, err := logsample.New(*logSampleRate)
+	if err != nil {
+		return err
+	}
+
+	payloadLogger := payloadlog.New()
+
+	var rateLimiter *ratelimit.Limiter
+	if *rateLimitFile != "" {
+		loaded, err := ratelimit.Load(*rateLimitFile)
+		if err != nil {
+			return err
+		}
+		rateLimiter = loaded
+	}
+
+	go tracer.Run(ctx)
+
+	rpcMetrics := metrics.NewServerRPC(registry)
+	requestLog := metrics.NewRequestLog(*requestLogSize)
+
+	var fallbackCache *stalecache.Cache
+	if *serveStaleFor > 0 {
+		fallbackCache = stalecache.New(*serveStaleFor, fallbackCacheSize)
+	}
+
+	serverOpts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(service.NewUnaryTracingInterceptor(tracer), service.NewUnaryMetricsInterceptor(rpcMetrics, requestLog), service.NewLoggingInterceptor(logger, logSampler, payloadLogger), service.NewUnaryRecoveryInterceptor(logger), service.NewUnaryRateLimitInterceptor(rateLimiter), service.NewAuthInterceptor(*requireAuth, *requireRole), service.UnaryValidationInterceptor, service.NewUnaryFallbackInterceptor(fallbackCache, logger), errstatus.NewUnaryInterceptor(logger, service.ErrorStatus)),
+		grpc.ChainStreamInterceptor(service.NewStreamTracingInterceptor(tracer), service.NewStreamMetricsInterceptor(rpcMetrics, requestLog), service.NewStreamLoggingInterceptor(logger), service.NewStreamRecoveryInterceptor(logger), service.NewStreamRateLimitInterceptor(rateLimiter), service.StreamValidationInterceptor, errstatus.NewStreamInterceptor(logger, service.ErrorStatus)),
+	}
+
+	if *tlsCert != "" {
+		tlsConfig, err := tlsconfig.Server(*tlsCert, *tlsKey, *tlsClientCA)
+		if err != nil {
+			return err
+		}
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	} else if *tlsClientCA != "" {
+		return errors.New("-tls-client-ca requires -tls-cert")
+	}
+
+	grpcServer := grpc.NewServer(serverOpts...)
+
+	healthServer := health.NewServer()
+	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
+	go service.WatchHealth(ctx, racingDB, healthServer, *healthInterval, logger)
+
+	if *serveReflection {
+		reflection.Register(grpcServer)
+	}
 
 	racing.RegisterRacingServer(
 		grpcServer,
 		service.NewRacingService(
 			racesRepo,
+			raceHub,
+			featureFlags,
+			logSampler,
+			payloadLogger,
+			rpcMetrics,
+			requestLog,
+			*queryTimeout,
 		),
 	)
 
-	log.Printf("gRPC server listening on: %s\n", *grpcEndpoint)
+	logger.Info("gRPC server listening", zap.String("addr", *grpcEndpoint))
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- grpcServer.Serve(conn)
+	}()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
 
-	if err := grpcServer.Serve(conn); err != nil {
+	select {
+	case err := <-serveErr:
 		return err
+	case sig := <-signals:
+		logger.Info("shutting down", zap.String("signal", sig.String()), zap.Duration("timeout", *shutdownTimeout))
 	}
 
+	// Background work, such as pushing races to watchers, is stopped first so it doesn't
+	// hold up draining. Reporting NOT_SERVING lets the gateway stop sending
+	// requests before the listener closes.
+	cancel()
+	healthServer.Shutdown()
+
+	drain(grpcServer, *shutdownTimeout, logger)
+
 	return nil
 }
+
+// drain stops the server accepting requests and waits for those in flight to
+// finish, cancelling any still running after the timeout.
+func drain(server *grpc.Server, timeout time.Duration, logger *zap.Logger) {
+	drained := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		logger.Info("drained in-flight requests")
+	case <-time.After(timeout):
+		logger.Warn("timed out draining in-flight requests, cancelling them")
+		server.Stop()
+	}
+}
+
+// newFieldLimits creates the length limits of the string fields of a table
+// from the flags.
+func newFieldLimits(table string) (*fieldlimit.Limits, error) {
+	policy, err := fieldlimit.ParsePolicy(*fieldLengthPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	lengths, err := fieldlimit.ParseLengths(*fieldMaxLengths)
+	if err != nil {
+		return nil, err
+	}
+
+	return fieldlimit.New(table, policy, lengths), nil
+}
@@ -1,3 +1,3 @@
+// Package proto holds the racing protos. The code is generated by the gen
+// module at the root of the repository, run go generate from there.
 package proto
-
-//go:generate protoc --go_out=. --go-grpc_out=require_unimplemented_servers=false:. racing/racing.proto
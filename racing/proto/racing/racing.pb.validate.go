@@ -0,0 +1,5034 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: racing/racing.proto
+
+package racing
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ListRacesRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRacesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRacesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRacesRequestMultiError, or nil if none found.
+func (m *ListRacesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRacesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListRacesRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListRacesRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListRacesRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CountOnly
+
+	if m.GetPageSize() < 0 {
+		err := ListRacesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPageToken()) > 256 {
+		err := ListRacesRequestValidationError{
+			field:  "PageToken",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.OrderBy != nil {
+
+		if utf8.RuneCountInString(m.GetOrderBy()) > 256 {
+			err := ListRacesRequestValidationError{
+				field:  "OrderBy",
+				reason: "value length must be at most 256 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRacesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRacesRequestMultiError is an error wrapping multiple validation errors
+// returned by ListRacesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListRacesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRacesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRacesRequestMultiError) AllErrors() []error { return m }
+
+// ListRacesRequestValidationError is the validation error returned by
+// ListRacesRequest.Validate if the designated constraints aren't met.
+type ListRacesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRacesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRacesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRacesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRacesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRacesRequestValidationError) ErrorName() string { return "ListRacesRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListRacesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRacesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRacesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRacesRequestValidationError{}
+
+// Validate checks the field values on ListRacesResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRacesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRacesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRacesResponseMultiError, or nil if none found.
+func (m *ListRacesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRacesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRaces() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRacesResponseValidationError{
+						field:  fmt.Sprintf("Races[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRacesResponseValidationError{
+						field:  fmt.Sprintf("Races[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRacesResponseValidationError{
+					field:  fmt.Sprintf("Races[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for TotalSize
+
+	if len(errors) > 0 {
+		return ListRacesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRacesResponseMultiError is an error wrapping multiple validation errors
+// returned by ListRacesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListRacesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRacesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRacesResponseMultiError) AllErrors() []error { return m }
+
+// ListRacesResponseValidationError is the validation error returned by
+// ListRacesResponse.Validate if the designated constraints aren't met.
+type ListRacesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRacesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRacesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRacesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRacesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRacesResponseValidationError) ErrorName() string {
+	return "ListRacesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRacesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRacesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRacesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRacesResponseValidationError{}
+
+// Validate checks the field values on ListRacesRequestFilter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRacesRequestFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRacesRequestFilter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRacesRequestFilterMultiError, or nil if none found.
+func (m *ListRacesRequestFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRacesRequestFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := ListRacesRequestFilterValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetAdvertisedStartAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListRacesRequestFilterValidationError{
+					field:  "AdvertisedStartAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListRacesRequestFilterValidationError{
+					field:  "AdvertisedStartAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAdvertisedStartAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListRacesRequestFilterValidationError{
+				field:  "AdvertisedStartAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAdvertisedStartBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListRacesRequestFilterValidationError{
+					field:  "AdvertisedStartBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListRacesRequestFilterValidationError{
+					field:  "AdvertisedStartBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAdvertisedStartBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListRacesRequestFilterValidationError{
+				field:  "AdvertisedStartBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if d := m.GetStartingWithin(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = ListRacesRequestFilterValidationError{
+				field:  "StartingWithin",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := ListRacesRequestFilterValidationError{
+					field:  "StartingWithin",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if m.Visible != nil {
+		// no validation rules for Visible
+	}
+
+	if m.Settled != nil {
+		// no validation rules for Settled
+	}
+
+	if m.Number != nil {
+
+		if m.GetNumber() <= 0 {
+			err := ListRacesRequestFilterValidationError{
+				field:  "Number",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.NameContains != nil {
+
+		if l := utf8.RuneCountInString(m.GetNameContains()); l < 1 || l > 256 {
+			err := ListRacesRequestFilterValidationError{
+				field:  "NameContains",
+				reason: "value length must be between 1 and 256 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRacesRequestFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRacesRequestFilterMultiError is an error wrapping multiple validation
+// errors returned by ListRacesRequestFilter.ValidateAll() if the designated
+// constraints aren't met.
+type ListRacesRequestFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRacesRequestFilterMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRacesRequestFilterMultiError) AllErrors() []error { return m }
+
+// ListRacesRequestFilterValidationError is the validation error returned by
+// ListRacesRequestFilter.Validate if the designated constraints aren't met.
+type ListRacesRequestFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRacesRequestFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRacesRequestFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRacesRequestFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRacesRequestFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRacesRequestFilterValidationError) ErrorName() string {
+	return "ListRacesRequestFilterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRacesRequestFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRacesRequestFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRacesRequestFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRacesRequestFilterValidationError{}
+
+// Validate checks the field values on GetRaceRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetRaceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRaceRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetRaceRequestMultiError,
+// or nil if none found.
+func (m *GetRaceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRaceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetRaceRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRaceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRaceRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRaceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetRaceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRaceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRaceRequestMultiError) AllErrors() []error { return m }
+
+// GetRaceRequestValidationError is the validation error returned by
+// GetRaceRequest.Validate if the designated constraints aren't met.
+type GetRaceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRaceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRaceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRaceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRaceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRaceRequestValidationError) ErrorName() string { return "GetRaceRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRaceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRaceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRaceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRaceRequestValidationError{}
+
+// Validate checks the field values on CreateRaceRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateRaceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRaceRequestMultiError, or nil if none found.
+func (m *CreateRaceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRaceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRace()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRaceRequestValidationError{
+					field:  "Race",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRaceRequestValidationError{
+					field:  "Race",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRace()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRaceRequestValidationError{
+				field:  "Race",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateRaceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRaceRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateRaceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateRaceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRaceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRaceRequestMultiError) AllErrors() []error { return m }
+
+// CreateRaceRequestValidationError is the validation error returned by
+// CreateRaceRequest.Validate if the designated constraints aren't met.
+type CreateRaceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRaceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRaceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRaceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRaceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRaceRequestValidationError) ErrorName() string {
+	return "CreateRaceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRaceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRaceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRaceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRaceRequestValidationError{}
+
+// Validate checks the field values on UpdateRaceRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRaceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRaceRequestMultiError, or nil if none found.
+func (m *UpdateRaceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRaceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRace()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRaceRequestValidationError{
+					field:  "Race",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRaceRequestValidationError{
+					field:  "Race",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRace()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRaceRequestValidationError{
+				field:  "Race",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateRaceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRaceRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateRaceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateRaceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRaceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRaceRequestMultiError) AllErrors() []error { return m }
+
+// UpdateRaceRequestValidationError is the validation error returned by
+// UpdateRaceRequest.Validate if the designated constraints aren't met.
+type UpdateRaceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRaceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRaceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRaceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRaceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRaceRequestValidationError) ErrorName() string {
+	return "UpdateRaceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRaceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRaceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRaceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRaceRequestValidationError{}
+
+// Validate checks the field values on DeleteRaceRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRaceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRaceRequestMultiError, or nil if none found.
+func (m *DeleteRaceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRaceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := DeleteRaceRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteRaceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRaceRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteRaceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteRaceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRaceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRaceRequestMultiError) AllErrors() []error { return m }
+
+// DeleteRaceRequestValidationError is the validation error returned by
+// DeleteRaceRequest.Validate if the designated constraints aren't met.
+type DeleteRaceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRaceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRaceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRaceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRaceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRaceRequestValidationError) ErrorName() string {
+	return "DeleteRaceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRaceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRaceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRaceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRaceRequestValidationError{}
+
+// Validate checks the field values on SetRaceResultRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetRaceResultRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetRaceResultRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetRaceResultRequestMultiError, or nil if none found.
+func (m *SetRaceResultRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetRaceResultRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := SetRaceResultRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWinner() <= 0 {
+		err := SetRaceResultRequestValidationError{
+			field:  "Winner",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetRaceResultRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetRaceResultRequestMultiError is an error wrapping multiple validation
+// errors returned by SetRaceResultRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetRaceResultRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetRaceResultRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetRaceResultRequestMultiError) AllErrors() []error { return m }
+
+// SetRaceResultRequestValidationError is the validation error returned by
+// SetRaceResultRequest.Validate if the designated constraints aren't met.
+type SetRaceResultRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetRaceResultRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetRaceResultRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetRaceResultRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetRaceResultRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetRaceResultRequestValidationError) ErrorName() string {
+	return "SetRaceResultRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetRaceResultRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetRaceResultRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetRaceResultRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetRaceResultRequestValidationError{}
+
+// Validate checks the field values on GetRaceResultRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRaceResultRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRaceResultRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRaceResultRequestMultiError, or nil if none found.
+func (m *GetRaceResultRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRaceResultRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetRaceResultRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRaceResultRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRaceResultRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRaceResultRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRaceResultRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRaceResultRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRaceResultRequestMultiError) AllErrors() []error { return m }
+
+// GetRaceResultRequestValidationError is the validation error returned by
+// GetRaceResultRequest.Validate if the designated constraints aren't met.
+type GetRaceResultRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRaceResultRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRaceResultRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRaceResultRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRaceResultRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRaceResultRequestValidationError) ErrorName() string {
+	return "GetRaceResultRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRaceResultRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRaceResultRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRaceResultRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRaceResultRequestValidationError{}
+
+// Validate checks the field values on ListFeatureFlagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFeatureFlagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFeatureFlagsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFeatureFlagsRequestMultiError, or nil if none found.
+func (m *ListFeatureFlagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFeatureFlagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListFeatureFlagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFeatureFlagsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListFeatureFlagsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListFeatureFlagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFeatureFlagsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFeatureFlagsRequestMultiError) AllErrors() []error { return m }
+
+// ListFeatureFlagsRequestValidationError is the validation error returned by
+// ListFeatureFlagsRequest.Validate if the designated constraints aren't met.
+type ListFeatureFlagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFeatureFlagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFeatureFlagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFeatureFlagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFeatureFlagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFeatureFlagsRequestValidationError) ErrorName() string {
+	return "ListFeatureFlagsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFeatureFlagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFeatureFlagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFeatureFlagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFeatureFlagsRequestValidationError{}
+
+// Validate checks the field values on ListFeatureFlagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFeatureFlagsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFeatureFlagsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFeatureFlagsResponseMultiError, or nil if none found.
+func (m *ListFeatureFlagsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFeatureFlagsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFlags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListFeatureFlagsResponseValidationError{
+						field:  fmt.Sprintf("Flags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListFeatureFlagsResponseValidationError{
+						field:  fmt.Sprintf("Flags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListFeatureFlagsResponseValidationError{
+					field:  fmt.Sprintf("Flags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListFeatureFlagsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFeatureFlagsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListFeatureFlagsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListFeatureFlagsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFeatureFlagsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFeatureFlagsResponseMultiError) AllErrors() []error { return m }
+
+// ListFeatureFlagsResponseValidationError is the validation error returned by
+// ListFeatureFlagsResponse.Validate if the designated constraints aren't met.
+type ListFeatureFlagsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFeatureFlagsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFeatureFlagsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFeatureFlagsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFeatureFlagsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFeatureFlagsResponseValidationError) ErrorName() string {
+	return "ListFeatureFlagsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFeatureFlagsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFeatureFlagsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFeatureFlagsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFeatureFlagsResponseValidationError{}
+
+// Validate checks the field values on SetFeatureFlagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetFeatureFlagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetFeatureFlagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetFeatureFlagRequestMultiError, or nil if none found.
+func (m *SetFeatureFlagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetFeatureFlagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Enabled
+
+	if len(errors) > 0 {
+		return SetFeatureFlagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetFeatureFlagRequestMultiError is an error wrapping multiple validation
+// errors returned by SetFeatureFlagRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetFeatureFlagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetFeatureFlagRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetFeatureFlagRequestMultiError) AllErrors() []error { return m }
+
+// SetFeatureFlagRequestValidationError is the validation error returned by
+// SetFeatureFlagRequest.Validate if the designated constraints aren't met.
+type SetFeatureFlagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetFeatureFlagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetFeatureFlagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetFeatureFlagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetFeatureFlagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetFeatureFlagRequestValidationError) ErrorName() string {
+	return "SetFeatureFlagRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetFeatureFlagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetFeatureFlagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetFeatureFlagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetFeatureFlagRequestValidationError{}
+
+// Validate checks the field values on CheckSchemaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckSchemaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckSchemaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckSchemaRequestMultiError, or nil if none found.
+func (m *CheckSchemaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckSchemaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CheckSchemaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckSchemaRequestMultiError is an error wrapping multiple validation errors
+// returned by CheckSchemaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CheckSchemaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckSchemaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckSchemaRequestMultiError) AllErrors() []error { return m }
+
+// CheckSchemaRequestValidationError is the validation error returned by
+// CheckSchemaRequest.Validate if the designated constraints aren't met.
+type CheckSchemaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckSchemaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckSchemaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckSchemaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckSchemaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckSchemaRequestValidationError) ErrorName() string {
+	return "CheckSchemaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckSchemaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckSchemaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckSchemaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckSchemaRequestValidationError{}
+
+// Validate checks the field values on CheckSchemaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckSchemaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckSchemaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckSchemaResponseMultiError, or nil if none found.
+func (m *CheckSchemaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckSchemaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckSchemaResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckSchemaResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckSchemaResponseValidationError{
+					field:  fmt.Sprintf("Tables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CheckSchemaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckSchemaResponseMultiError is an error wrapping multiple validation
+// errors returned by CheckSchemaResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CheckSchemaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckSchemaResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckSchemaResponseMultiError) AllErrors() []error { return m }
+
+// CheckSchemaResponseValidationError is the validation error returned by
+// CheckSchemaResponse.Validate if the designated constraints aren't met.
+type CheckSchemaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckSchemaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckSchemaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckSchemaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckSchemaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckSchemaResponseValidationError) ErrorName() string {
+	return "CheckSchemaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckSchemaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckSchemaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckSchemaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckSchemaResponseValidationError{}
+
+// Validate checks the field values on WatchRacesRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WatchRacesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchRacesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchRacesRequestMultiError, or nil if none found.
+func (m *WatchRacesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchRacesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchRacesRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchRacesRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchRacesRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WatchRacesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchRacesRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchRacesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatchRacesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchRacesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchRacesRequestMultiError) AllErrors() []error { return m }
+
+// WatchRacesRequestValidationError is the validation error returned by
+// WatchRacesRequest.Validate if the designated constraints aren't met.
+type WatchRacesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchRacesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchRacesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchRacesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchRacesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchRacesRequestValidationError) ErrorName() string {
+	return "WatchRacesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchRacesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchRacesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchRacesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchRacesRequestValidationError{}
+
+// Validate checks the field values on ListLogSamplingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLogSamplingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLogSamplingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLogSamplingRequestMultiError, or nil if none found.
+func (m *ListLogSamplingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLogSamplingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListLogSamplingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLogSamplingRequestMultiError is an error wrapping multiple validation
+// errors returned by ListLogSamplingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListLogSamplingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLogSamplingRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLogSamplingRequestMultiError) AllErrors() []error { return m }
+
+// ListLogSamplingRequestValidationError is the validation error returned by
+// ListLogSamplingRequest.Validate if the designated constraints aren't met.
+type ListLogSamplingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLogSamplingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLogSamplingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLogSamplingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLogSamplingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLogSamplingRequestValidationError) ErrorName() string {
+	return "ListLogSamplingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLogSamplingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLogSamplingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLogSamplingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLogSamplingRequestValidationError{}
+
+// Validate checks the field values on ListLogSamplingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLogSamplingResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLogSamplingResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLogSamplingResponseMultiError, or nil if none found.
+func (m *ListLogSamplingResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLogSamplingResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListLogSamplingResponseValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListLogSamplingResponseValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListLogSamplingResponseValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListLogSamplingResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLogSamplingResponseMultiError is an error wrapping multiple validation
+// errors returned by ListLogSamplingResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListLogSamplingResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLogSamplingResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLogSamplingResponseMultiError) AllErrors() []error { return m }
+
+// ListLogSamplingResponseValidationError is the validation error returned by
+// ListLogSamplingResponse.Validate if the designated constraints aren't met.
+type ListLogSamplingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLogSamplingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLogSamplingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLogSamplingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLogSamplingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLogSamplingResponseValidationError) ErrorName() string {
+	return "ListLogSamplingResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLogSamplingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLogSamplingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLogSamplingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLogSamplingResponseValidationError{}
+
+// Validate checks the field values on SetLogSamplingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetLogSamplingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetLogSamplingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetLogSamplingRequestMultiError, or nil if none found.
+func (m *SetLogSamplingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetLogSamplingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Method
+
+	// no validation rules for Rate
+
+	if len(errors) > 0 {
+		return SetLogSamplingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetLogSamplingRequestMultiError is an error wrapping multiple validation
+// errors returned by SetLogSamplingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetLogSamplingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetLogSamplingRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetLogSamplingRequestMultiError) AllErrors() []error { return m }
+
+// SetLogSamplingRequestValidationError is the validation error returned by
+// SetLogSamplingRequest.Validate if the designated constraints aren't met.
+type SetLogSamplingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetLogSamplingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetLogSamplingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetLogSamplingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetLogSamplingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetLogSamplingRequestValidationError) ErrorName() string {
+	return "SetLogSamplingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetLogSamplingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetLogSamplingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetLogSamplingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetLogSamplingRequestValidationError{}
+
+// Validate checks the field values on ListChangesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListChangesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListChangesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListChangesRequestMultiError, or nil if none found.
+func (m *ListChangesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListChangesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AfterSeq
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListChangesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListChangesRequestMultiError is an error wrapping multiple validation errors
+// returned by ListChangesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListChangesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListChangesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListChangesRequestMultiError) AllErrors() []error { return m }
+
+// ListChangesRequestValidationError is the validation error returned by
+// ListChangesRequest.Validate if the designated constraints aren't met.
+type ListChangesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListChangesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListChangesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListChangesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListChangesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListChangesRequestValidationError) ErrorName() string {
+	return "ListChangesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListChangesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListChangesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListChangesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListChangesRequestValidationError{}
+
+// Validate checks the field values on ListChangesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListChangesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListChangesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListChangesResponseMultiError, or nil if none found.
+func (m *ListChangesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListChangesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListChangesResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListChangesResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListChangesResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for LastSeq
+
+	if len(errors) > 0 {
+		return ListChangesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListChangesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListChangesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListChangesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListChangesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListChangesResponseMultiError) AllErrors() []error { return m }
+
+// ListChangesResponseValidationError is the validation error returned by
+// ListChangesResponse.Validate if the designated constraints aren't met.
+type ListChangesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListChangesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListChangesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListChangesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListChangesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListChangesResponseValidationError) ErrorName() string {
+	return "ListChangesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListChangesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListChangesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListChangesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListChangesResponseValidationError{}
+
+// Validate checks the field values on WatchChangesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchChangesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchChangesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchChangesRequestMultiError, or nil if none found.
+func (m *WatchChangesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchChangesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AfterSeq
+
+	if len(errors) > 0 {
+		return WatchChangesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchChangesRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchChangesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchChangesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchChangesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchChangesRequestMultiError) AllErrors() []error { return m }
+
+// WatchChangesRequestValidationError is the validation error returned by
+// WatchChangesRequest.Validate if the designated constraints aren't met.
+type WatchChangesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchChangesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchChangesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchChangesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchChangesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchChangesRequestValidationError) ErrorName() string {
+	return "WatchChangesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchChangesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchChangesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchChangesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchChangesRequestValidationError{}
+
+// Validate checks the field values on ListPayloadLoggingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPayloadLoggingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPayloadLoggingRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPayloadLoggingRequestMultiError, or nil if none found.
+func (m *ListPayloadLoggingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPayloadLoggingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListPayloadLoggingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPayloadLoggingRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPayloadLoggingRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListPayloadLoggingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPayloadLoggingRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPayloadLoggingRequestMultiError) AllErrors() []error { return m }
+
+// ListPayloadLoggingRequestValidationError is the validation error returned by
+// ListPayloadLoggingRequest.Validate if the designated constraints aren't met.
+type ListPayloadLoggingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPayloadLoggingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPayloadLoggingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPayloadLoggingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPayloadLoggingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPayloadLoggingRequestValidationError) ErrorName() string {
+	return "ListPayloadLoggingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPayloadLoggingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPayloadLoggingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPayloadLoggingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPayloadLoggingRequestValidationError{}
+
+// Validate checks the field values on ListPayloadLoggingResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPayloadLoggingResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPayloadLoggingResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPayloadLoggingResponseMultiError, or nil if none found.
+func (m *ListPayloadLoggingResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPayloadLoggingResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPayloadLoggingResponseValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPayloadLoggingResponseValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPayloadLoggingResponseValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPayloadLoggingResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPayloadLoggingResponseMultiError is an error wrapping multiple
+// validation errors returned by ListPayloadLoggingResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListPayloadLoggingResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPayloadLoggingResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPayloadLoggingResponseMultiError) AllErrors() []error { return m }
+
+// ListPayloadLoggingResponseValidationError is the validation error returned
+// by ListPayloadLoggingResponse.Validate if the designated constraints aren't met.
+type ListPayloadLoggingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPayloadLoggingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPayloadLoggingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPayloadLoggingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPayloadLoggingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPayloadLoggingResponseValidationError) ErrorName() string {
+	return "ListPayloadLoggingResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPayloadLoggingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPayloadLoggingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPayloadLoggingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPayloadLoggingResponseValidationError{}
+
+// Validate checks the field values on SetPayloadLoggingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetPayloadLoggingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetPayloadLoggingRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetPayloadLoggingRequestMultiError, or nil if none found.
+func (m *SetPayloadLoggingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetPayloadLoggingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Method
+
+	// no validation rules for Enabled
+
+	if all {
+		switch v := interface{}(m.GetRedact()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetPayloadLoggingRequestValidationError{
+					field:  "Redact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetPayloadLoggingRequestValidationError{
+					field:  "Redact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRedact()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetPayloadLoggingRequestValidationError{
+				field:  "Redact",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MaxBytes
+
+	if len(errors) > 0 {
+		return SetPayloadLoggingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetPayloadLoggingRequestMultiError is an error wrapping multiple validation
+// errors returned by SetPayloadLoggingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetPayloadLoggingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetPayloadLoggingRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetPayloadLoggingRequestMultiError) AllErrors() []error { return m }
+
+// SetPayloadLoggingRequestValidationError is the validation error returned by
+// SetPayloadLoggingRequest.Validate if the designated constraints aren't met.
+type SetPayloadLoggingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetPayloadLoggingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetPayloadLoggingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetPayloadLoggingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetPayloadLoggingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetPayloadLoggingRequestValidationError) ErrorName() string {
+	return "SetPayloadLoggingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetPayloadLoggingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetPayloadLoggingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetPayloadLoggingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetPayloadLoggingRequestValidationError{}
+
+// Validate checks the field values on SearchRacesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchRacesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchRacesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchRacesRequestMultiError, or nil if none found.
+func (m *SearchRacesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchRacesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 1 || l > 256 {
+		err := SearchRacesRequestValidationError{
+			field:  "Query",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLimit() < 0 {
+		err := SearchRacesRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Visible != nil {
+		// no validation rules for Visible
+	}
+
+	if len(errors) > 0 {
+		return SearchRacesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchRacesRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchRacesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SearchRacesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchRacesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchRacesRequestMultiError) AllErrors() []error { return m }
+
+// SearchRacesRequestValidationError is the validation error returned by
+// SearchRacesRequest.Validate if the designated constraints aren't met.
+type SearchRacesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchRacesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchRacesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchRacesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchRacesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchRacesRequestValidationError) ErrorName() string {
+	return "SearchRacesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchRacesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchRacesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchRacesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchRacesRequestValidationError{}
+
+// Validate checks the field values on SearchRacesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchRacesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchRacesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchRacesResponseMultiError, or nil if none found.
+func (m *SearchRacesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchRacesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRaces() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchRacesResponseValidationError{
+						field:  fmt.Sprintf("Races[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchRacesResponseValidationError{
+						field:  fmt.Sprintf("Races[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchRacesResponseValidationError{
+					field:  fmt.Sprintf("Races[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchRacesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchRacesResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchRacesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchRacesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchRacesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchRacesResponseMultiError) AllErrors() []error { return m }
+
+// SearchRacesResponseValidationError is the validation error returned by
+// SearchRacesResponse.Validate if the designated constraints aren't met.
+type SearchRacesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchRacesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchRacesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchRacesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchRacesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchRacesResponseValidationError) ErrorName() string {
+	return "SearchRacesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchRacesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchRacesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchRacesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchRacesResponseValidationError{}
+
+// Validate checks the field values on ListRecentRequestsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRecentRequestsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRecentRequestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRecentRequestsRequestMultiError, or nil if none found.
+func (m *ListRecentRequestsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRecentRequestsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Method
+
+	if m.GetLimit() < 0 {
+		err := ListRecentRequestsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListRecentRequestsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRecentRequestsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRecentRequestsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListRecentRequestsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRecentRequestsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRecentRequestsRequestMultiError) AllErrors() []error { return m }
+
+// ListRecentRequestsRequestValidationError is the validation error returned by
+// ListRecentRequestsRequest.Validate if the designated constraints aren't met.
+type ListRecentRequestsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRecentRequestsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRecentRequestsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRecentRequestsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRecentRequestsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRecentRequestsRequestValidationError) ErrorName() string {
+	return "ListRecentRequestsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRecentRequestsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRecentRequestsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRecentRequestsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRecentRequestsRequestValidationError{}
+
+// Validate checks the field values on ListRecentRequestsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRecentRequestsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRecentRequestsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRecentRequestsResponseMultiError, or nil if none found.
+func (m *ListRecentRequestsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRecentRequestsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRequests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRecentRequestsResponseValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRecentRequestsResponseValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRecentRequestsResponseValidationError{
+					field:  fmt.Sprintf("Requests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRecentRequestsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRecentRequestsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListRecentRequestsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListRecentRequestsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRecentRequestsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRecentRequestsResponseMultiError) AllErrors() []error { return m }
+
+// ListRecentRequestsResponseValidationError is the validation error returned
+// by ListRecentRequestsResponse.Validate if the designated constraints aren't met.
+type ListRecentRequestsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRecentRequestsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRecentRequestsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRecentRequestsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRecentRequestsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRecentRequestsResponseValidationError) ErrorName() string {
+	return "ListRecentRequestsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRecentRequestsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRecentRequestsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRecentRequestsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRecentRequestsResponseValidationError{}
+
+// Validate checks the field values on GetOperationalSummaryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOperationalSummaryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOperationalSummaryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOperationalSummaryRequestMultiError, or nil if none found.
+func (m *GetOperationalSummaryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOperationalSummaryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetOperationalSummaryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOperationalSummaryRequestMultiError is an error wrapping multiple
+// validation errors returned by GetOperationalSummaryRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetOperationalSummaryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOperationalSummaryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOperationalSummaryRequestMultiError) AllErrors() []error { return m }
+
+// GetOperationalSummaryRequestValidationError is the validation error returned
+// by GetOperationalSummaryRequest.Validate if the designated constraints
+// aren't met.
+type GetOperationalSummaryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOperationalSummaryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOperationalSummaryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOperationalSummaryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOperationalSummaryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOperationalSummaryRequestValidationError) ErrorName() string {
+	return "GetOperationalSummaryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOperationalSummaryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOperationalSummaryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOperationalSummaryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOperationalSummaryRequestValidationError{}
+
+// Validate checks the field values on OperationalSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OperationalSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OperationalSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OperationalSummaryMultiError, or nil if none found.
+func (m *OperationalSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OperationalSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CountsByStatus
+
+	// no validation rules for UpcomingNextHour
+
+	if all {
+		switch v := interface{}(m.GetWindow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OperationalSummaryValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OperationalSummaryValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWindow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OperationalSummaryValidationError{
+				field:  "Window",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Requests
+
+	// no validation rules for Errors
+
+	// no validation rules for ErrorRate
+
+	if len(errors) > 0 {
+		return OperationalSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// OperationalSummaryMultiError is an error wrapping multiple validation errors
+// returned by OperationalSummary.ValidateAll() if the designated constraints
+// aren't met.
+type OperationalSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OperationalSummaryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OperationalSummaryMultiError) AllErrors() []error { return m }
+
+// OperationalSummaryValidationError is the validation error returned by
+// OperationalSummary.Validate if the designated constraints aren't met.
+type OperationalSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OperationalSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OperationalSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OperationalSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OperationalSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OperationalSummaryValidationError) ErrorName() string {
+	return "OperationalSummaryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OperationalSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOperationalSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OperationalSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OperationalSummaryValidationError{}
+
+// Validate checks the field values on Race with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Race) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Race with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RaceMultiError, or nil if none found.
+func (m *Race) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Race) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for MeetingId
+
+	// no validation rules for Name
+
+	// no validation rules for Number
+
+	// no validation rules for Visible
+
+	if all {
+		switch v := interface{}(m.GetAdvertisedStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RaceValidationError{
+					field:  "AdvertisedStartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RaceValidationError{
+					field:  "AdvertisedStartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAdvertisedStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RaceValidationError{
+				field:  "AdvertisedStartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Status
+
+	for idx, item := range m.GetRunners() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RaceValidationError{
+						field:  fmt.Sprintf("Runners[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RaceValidationError{
+						field:  fmt.Sprintf("Runners[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RaceValidationError{
+					field:  fmt.Sprintf("Runners[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetResult()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RaceValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RaceValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RaceValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSettledAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RaceValidationError{
+					field:  "SettledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RaceValidationError{
+					field:  "SettledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSettledAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RaceValidationError{
+				field:  "SettledAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RaceMultiError(errors)
+	}
+
+	return nil
+}
+
+// RaceMultiError is an error wrapping multiple validation errors returned by
+// Race.ValidateAll() if the designated constraints aren't met.
+type RaceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RaceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RaceMultiError) AllErrors() []error { return m }
+
+// RaceValidationError is the validation error returned by Race.Validate if the
+// designated constraints aren't met.
+type RaceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RaceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RaceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RaceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RaceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RaceValidationError) ErrorName() string { return "RaceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RaceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RaceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RaceValidationError{}
+
+// Validate checks the field values on RaceResult with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RaceResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RaceResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RaceResultMultiError, or
+// nil if none found.
+func (m *RaceResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RaceResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Winner
+
+	if len(errors) > 0 {
+		return RaceResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// RaceResultMultiError is an error wrapping multiple validation errors
+// returned by RaceResult.ValidateAll() if the designated constraints aren't met.
+type RaceResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RaceResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RaceResultMultiError) AllErrors() []error { return m }
+
+// RaceResultValidationError is the validation error returned by
+// RaceResult.Validate if the designated constraints aren't met.
+type RaceResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RaceResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RaceResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RaceResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RaceResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RaceResultValidationError) ErrorName() string { return "RaceResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RaceResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRaceResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RaceResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RaceResultValidationError{}
+
+// Validate checks the field values on Runner with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Runner) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Runner with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RunnerMultiError, or nil if none found.
+func (m *Runner) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Runner) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Number
+
+	// no validation rules for Barrier
+
+	// no validation rules for Name
+
+	// no validation rules for Scratched
+
+	if len(errors) > 0 {
+		return RunnerMultiError(errors)
+	}
+
+	return nil
+}
+
+// RunnerMultiError is an error wrapping multiple validation errors returned by
+// Runner.ValidateAll() if the designated constraints aren't met.
+type RunnerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RunnerMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RunnerMultiError) AllErrors() []error { return m }
+
+// RunnerValidationError is the validation error returned by Runner.Validate if
+// the designated constraints aren't met.
+type RunnerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RunnerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RunnerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RunnerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RunnerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RunnerValidationError) ErrorName() string { return "RunnerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RunnerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRunner.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RunnerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RunnerValidationError{}
+
+// Validate checks the field values on TableSchema with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TableSchema) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TableSchema with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TableSchemaMultiError, or
+// nil if none found.
+func (m *TableSchema) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TableSchema) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Table
+
+	// no validation rules for Ok
+
+	if len(errors) > 0 {
+		return TableSchemaMultiError(errors)
+	}
+
+	return nil
+}
+
+// TableSchemaMultiError is an error wrapping multiple validation errors
+// returned by TableSchema.ValidateAll() if the designated constraints aren't met.
+type TableSchemaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TableSchemaMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TableSchemaMultiError) AllErrors() []error { return m }
+
+// TableSchemaValidationError is the validation error returned by
+// TableSchema.Validate if the designated constraints aren't met.
+type TableSchemaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TableSchemaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TableSchemaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TableSchemaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TableSchemaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TableSchemaValidationError) ErrorName() string { return "TableSchemaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TableSchemaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTableSchema.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TableSchemaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TableSchemaValidationError{}
+
+// Validate checks the field values on FeatureFlag with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FeatureFlag) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FeatureFlag with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FeatureFlagMultiError, or
+// nil if none found.
+func (m *FeatureFlag) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FeatureFlag) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Enabled
+
+	if len(errors) > 0 {
+		return FeatureFlagMultiError(errors)
+	}
+
+	return nil
+}
+
+// FeatureFlagMultiError is an error wrapping multiple validation errors
+// returned by FeatureFlag.ValidateAll() if the designated constraints aren't met.
+type FeatureFlagMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FeatureFlagMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FeatureFlagMultiError) AllErrors() []error { return m }
+
+// FeatureFlagValidationError is the validation error returned by
+// FeatureFlag.Validate if the designated constraints aren't met.
+type FeatureFlagValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FeatureFlagValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FeatureFlagValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FeatureFlagValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FeatureFlagValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FeatureFlagValidationError) ErrorName() string { return "FeatureFlagValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FeatureFlagValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFeatureFlag.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FeatureFlagValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FeatureFlagValidationError{}
+
+// Validate checks the field values on LogSamplingRule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LogSamplingRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LogSamplingRule with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LogSamplingRuleMultiError, or nil if none found.
+func (m *LogSamplingRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LogSamplingRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Method
+
+	// no validation rules for Rate
+
+	if len(errors) > 0 {
+		return LogSamplingRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// LogSamplingRuleMultiError is an error wrapping multiple validation errors
+// returned by LogSamplingRule.ValidateAll() if the designated constraints
+// aren't met.
+type LogSamplingRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogSamplingRuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogSamplingRuleMultiError) AllErrors() []error { return m }
+
+// LogSamplingRuleValidationError is the validation error returned by
+// LogSamplingRule.Validate if the designated constraints aren't met.
+type LogSamplingRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogSamplingRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogSamplingRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogSamplingRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogSamplingRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogSamplingRuleValidationError) ErrorName() string { return "LogSamplingRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogSamplingRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogSamplingRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogSamplingRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogSamplingRuleValidationError{}
+
+// Validate checks the field values on Change with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Change) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Change with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ChangeMultiError, or nil if none found.
+func (m *Change) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Change) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Seq
+
+	// no validation rules for RaceId
+
+	// no validation rules for Operation
+
+	if all {
+		switch v := interface{}(m.GetChangedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChangeValidationError{
+					field:  "ChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChangeValidationError{
+					field:  "ChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChangedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChangeValidationError{
+				field:  "ChangedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangeMultiError is an error wrapping multiple validation errors returned by
+// Change.ValidateAll() if the designated constraints aren't met.
+type ChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangeMultiError) AllErrors() []error { return m }
+
+// ChangeValidationError is the validation error returned by Change.Validate if
+// the designated constraints aren't met.
+type ChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangeValidationError) ErrorName() string { return "ChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangeValidationError{}
+
+// Validate checks the field values on PayloadLoggingRule with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PayloadLoggingRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PayloadLoggingRule with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PayloadLoggingRuleMultiError, or nil if none found.
+func (m *PayloadLoggingRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PayloadLoggingRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Method
+
+	// no validation rules for Enabled
+
+	if all {
+		switch v := interface{}(m.GetRedact()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PayloadLoggingRuleValidationError{
+					field:  "Redact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PayloadLoggingRuleValidationError{
+					field:  "Redact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRedact()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PayloadLoggingRuleValidationError{
+				field:  "Redact",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MaxBytes
+
+	if len(errors) > 0 {
+		return PayloadLoggingRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// PayloadLoggingRuleMultiError is an error wrapping multiple validation errors
+// returned by PayloadLoggingRule.ValidateAll() if the designated constraints
+// aren't met.
+type PayloadLoggingRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PayloadLoggingRuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PayloadLoggingRuleMultiError) AllErrors() []error { return m }
+
+// PayloadLoggingRuleValidationError is the validation error returned by
+// PayloadLoggingRule.Validate if the designated constraints aren't met.
+type PayloadLoggingRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PayloadLoggingRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PayloadLoggingRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PayloadLoggingRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PayloadLoggingRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PayloadLoggingRuleValidationError) ErrorName() string {
+	return "PayloadLoggingRuleValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PayloadLoggingRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPayloadLoggingRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PayloadLoggingRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PayloadLoggingRuleValidationError{}
+
+// Validate checks the field values on RecentRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RecentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecentRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RecentRequestMultiError, or
+// nil if none found.
+func (m *RecentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Method
+
+	// no validation rules for Code
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecentRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecentRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecentRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLatency()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecentRequestValidationError{
+					field:  "Latency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecentRequestValidationError{
+					field:  "Latency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLatency()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecentRequestValidationError{
+				field:  "Latency",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RecentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecentRequestMultiError is an error wrapping multiple validation errors
+// returned by RecentRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RecentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecentRequestMultiError) AllErrors() []error { return m }
+
+// RecentRequestValidationError is the validation error returned by
+// RecentRequest.Validate if the designated constraints aren't met.
+type RecentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecentRequestValidationError) ErrorName() string { return "RecentRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RecentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecentRequestValidationError{}
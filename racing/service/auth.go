@@ -0,0 +1,93 @@
+package service
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"git.neds.sh/matty/entain/pkg/errorreason"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys carrying the client the gateway authenticated by its API key
+// or bearer token, and its roles comma separated. The services trust the
+// gateway, so must not be reachable without going through it when these are
+// enforced.
+const (
+	identityMetadataKey = "x-client-identity"
+	rolesMetadataKey    = "x-client-roles"
+)
+
+// mutatingPrefixes are the prefixes of the names of the RPCs which make
+// changes.
+var mutatingPrefixes = []string{"Create", "Update", "Patch", "Delete", "Set"}
+
+// NewAuthInterceptor rejects mutating unary requests made without a client
+// identity with Unauthenticated, when required or a role is. When a role is
+// given, e.g. admin, clients without it are rejected with PermissionDenied.
+// Reads are always allowed.
+func NewAuthInterceptor(required bool, role string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if (!required && role == "") || !isMutating(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		if clientIdentity(ctx) == "" {
+			return nil, errorreason.AuthenticationRequired.Error("an API key or bearer token is required to make changes", nil)
+		}
+
+		if role != "" && !hasRole(ctx, role) {
+			return nil, errorreason.RoleRequired.Error(fmt.Sprintf("the %s role is required to make changes", role), map[string]string{"role": role})
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// clientIdentity returns the client authenticated by the gateway, or "" for
+// anonymous requests.
+func clientIdentity(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if values := md.Get(identityMetadataKey); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// hasRole reports whether the client authenticated by the gateway was granted
+// the role.
+func hasRole(ctx context.Context, role string) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+
+	for _, value := range md.Get(rolesMetadataKey) {
+		for _, granted := range strings.Split(value, ",") {
+			if strings.TrimSpace(granted) == role {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func isMutating(fullMethod string) bool {
+	method := path.Base(fullMethod)
+
+	for _, prefix := range mutatingPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
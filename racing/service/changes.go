@@ -0,0 +1,62 @@
+package service
+
+import (
+	"time"
+
+	"git.neds.sh/matty/entain/pkg/sqlfilter"
+	"git.neds.sh/matty/entain/racing/proto/racing"
+	"golang.org/x/net/context"
+)
+
+// changesPollInterval is how often WatchChanges checks for new changes.
+const changesPollInterval = time.Second
+
+func (s *racingService) ListChanges(ctx context.Context, in *racing.ListChangesRequest) (*racing.ListChangesResponse, error) {
+	changes, err := s.racesRepo.ListChanges(ctx, in.AfterSeq, in.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	lastSeq := in.AfterSeq
+	if len(changes) > 0 {
+		lastSeq = changes[len(changes)-1].Seq
+	}
+
+	return &racing.ListChangesResponse{Changes: changes, LastSeq: lastSeq}, nil
+}
+
+// WatchChanges tails the changes, each subscriber polls from its own
+// position so none can hold up another.
+func (s *racingService) WatchChanges(in *racing.WatchChangesRequest, stream racing.Racing_WatchChangesServer) error {
+	ctx := stream.Context()
+
+	ticker := time.NewTicker(changesPollInterval)
+	defer ticker.Stop()
+
+	afterSeq := in.AfterSeq
+
+	for {
+		changes, err := s.racesRepo.ListChanges(ctx, afterSeq, sqlfilter.MaxPageSize)
+		if err != nil {
+			return err
+		}
+
+		for _, change := range changes {
+			if err := stream.Send(change); err != nil {
+				return err
+			}
+			afterSeq = change.Seq
+		}
+
+		// Keep reading while there is a backlog.
+		if len(changes) == sqlfilter.MaxPageSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// experimentsMetadataKey carries the experiment variants assigned to the
+	// client by the gateway, as comma separated "experiment=variant" values.
+	experimentsMetadataKey = "x-experiments"
+
+	// experimentDefaultOrdering varies the ordering of lists which do not
+	// request one.
+	experimentDefaultOrdering = "default_ordering"
+)
+
+// defaultOrderings maps the variants of the default ordering experiment to
+// the order_by used. Unknown variants, including control, are left unordered.
+var defaultOrderings = map[string]string{
+	"start_time": "advertised_start_time",
+}
+
+// experimentVariant returns the variant of an experiment assigned to the
+// caller, or "" if the caller is not in the experiment.
+func experimentVariant(ctx context.Context, experiment string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	for _, value := range md.Get(experimentsMetadataKey) {
+		for _, assignment := range strings.Split(value, ",") {
+			name, variant := splitAssignment(assignment)
+			if name == experiment {
+				return variant
+			}
+		}
+	}
+
+	return ""
+}
+
+// defaultOrderBy returns orderBy unless it is unset, in which case the
+// ordering from the caller's default ordering variant is used.
+func defaultOrderBy(ctx context.Context, orderBy *string) *string {
+	if orderBy != nil {
+		return orderBy
+	}
+
+	if ordering, ok := defaultOrderings[experimentVariant(ctx, experimentDefaultOrdering)]; ok {
+		return &ordering
+	}
+
+	return nil
+}
+
+func splitAssignment(assignment string) (string, string) {
+	parts := strings.SplitN(strings.TrimSpace(assignment), "=", 2)
+	if len(parts) != 2 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
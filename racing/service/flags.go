@@ -0,0 +1,38 @@
+package service
+
+import (
+	"git.neds.sh/matty/entain/pkg/featureflag"
+	"git.neds.sh/matty/entain/racing/proto/racing"
+	"golang.org/x/net/context"
+)
+
+// Feature flags gating behaviour of the racing service.
+const (
+	// FlagWatchRaces enables the WatchRaces stream.
+	FlagWatchRaces = "watch_races"
+)
+
+// NewFeatureFlags creates the racing feature flags in their default states.
+func NewFeatureFlags() *featureflag.Flags {
+	return featureflag.New(map[string]bool{
+		FlagWatchRaces: true,
+	})
+}
+
+func (s *racingService) ListFeatureFlags(ctx context.Context, in *racing.ListFeatureFlagsRequest) (*racing.ListFeatureFlagsResponse, error) {
+	var flags []*racing.FeatureFlag
+
+	for _, name := range s.flags.Names() {
+		flags = append(flags, &racing.FeatureFlag{Name: name, Enabled: s.flags.Enabled(name)})
+	}
+
+	return &racing.ListFeatureFlagsResponse{Flags: flags}, nil
+}
+
+func (s *racingService) SetFeatureFlag(ctx context.Context, in *racing.SetFeatureFlagRequest) (*racing.FeatureFlag, error) {
+	if err := s.flags.Set(in.Name, in.Enabled); err != nil {
+		return nil, err
+	}
+
+	return &racing.FeatureFlag{Name: in.Name, Enabled: s.flags.Enabled(in.Name)}, nil
+}
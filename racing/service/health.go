@@ -0,0 +1,60 @@
+package service
+
+import (
+	"time"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// dbPingTimeout bounds each ping so a hung database is reported as unhealthy
+// rather than holding up the checks.
+const dbPingTimeout = 2 * time.Second
+
+// Pinger is a database which can be checked for connectivity.
+type Pinger interface {
+	PingContext(ctx context.Context) error
+}
+
+// WatchHealth sets the serving status of the server, and the Racing service,
+// from pinging the database every interval until the context is cancelled.
+func WatchHealth(ctx context.Context, db Pinger, server *health.Server, interval time.Duration, logger *zap.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	previous := grpc_health_v1.HealthCheckResponse_UNKNOWN
+
+	for {
+		current := grpc_health_v1.HealthCheckResponse_SERVING
+
+		pingCtx, cancel := context.WithTimeout(ctx, dbPingTimeout)
+		err := db.PingContext(pingCtx)
+		cancel()
+
+		if err != nil {
+			current = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+		}
+
+		if current != previous {
+			if err != nil {
+				logger.Warn("database unreachable, not serving", zap.Error(err))
+			} else if previous != grpc_health_v1.HealthCheckResponse_UNKNOWN {
+				logger.Info("database reachable again, serving")
+			}
+
+			server.SetServingStatus("", current)
+			server.SetServingStatus(racing.Racing_ServiceDesc.ServiceName, current)
+			previous = current
+		}
+
+		select {
+		case <-ctx.Done():
+			server.Shutdown()
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"fmt"
+	"path"
+	"time"
+
+	"git.neds.sh/matty/entain/pkg/errorreason"
+	"git.neds.sh/matty/entain/pkg/logging"
+	"git.neds.sh/matty/entain/pkg/logsample"
+	"git.neds.sh/matty/entain/pkg/payloadlog"
+	"git.neds.sh/matty/entain/racing/proto/racing"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// NewLoggingInterceptor tags each unary request with its request ID, given in
+// the x-request-id metadata or generated, so every line logged for it can be
+// found together. A sample of requests, chosen by the rate of their method,
+// is logged with the status and how long they took. Requests to methods with
+// payload logging are always logged, with their payload.
+func NewLoggingInterceptor(logger *zap.Logger, sampler *logsample.Sampler, payloads *payloadlog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx = contextWithRequestID(ctx)
+		method := path.Base(info.FullMethod)
+
+		var (
+			payload    string
+			logPayload bool
+		)
+		if msg, ok := req.(proto.Message); ok {
+			payload, logPayload = payloads.Format(method, msg)
+		}
+
+		if !logPayload && !sampler.Sample(method) {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		fields := requestFields(info.FullMethod, err, time.Since(start))
+		if logPayload {
+			fields = append(fields, zap.String("payload", payload))
+		}
+		logging.ForRequest(ctx, logger).Info("handled request", fields...)
+
+		return resp, err
+	}
+}
+
+// NewStreamLoggingInterceptor tags each stream with its request ID, like
+// NewLoggingInterceptor, and logs every stream once it ends. Streams are long
+// lived and few so they aren't sampled.
+func NewStreamLoggingInterceptor(logger *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := contextWithRequestID(ss.Context())
+
+		start := time.Now()
+		err := handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+
+		logging.ForRequest(ctx, logger).Info("handled stream", requestFields(info.FullMethod, err, time.Since(start))...)
+
+		return err
+	}
+}
+
+// contextWithRequestID adds the request ID given in the metadata to the
+// context, or a new one if the caller didn't give a valid one. A new ID is
+// returned in the x-request-id header, so callers can quote it.
+func contextWithRequestID(ctx context.Context) context.Context {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(logging.RequestIDKey); len(values) > 0 && logging.ValidRequestID(values[0]) {
+			return logging.ContextWithRequestID(ctx, values[0])
+		}
+	}
+
+	id := logging.NewRequestID()
+	// The header can only fail to be set once sent, which it can't have been
+	// before the request is handled.
+	_ = grpc.SetHeader(ctx, metadata.Pairs(logging.RequestIDKey, id))
+
+	return logging.ContextWithRequestID(ctx, id)
+}
+
+func requestFields(fullMethod string, err error, elapsed time.Duration) []zap.Field {
+	return []zap.Field{
+		zap.String("method", fullMethod),
+		zap.String("code", status.Code(err).String()),
+		zap.Duration("latency", elapsed),
+	}
+}
+
+func (s *racingService) ListLogSampling(ctx context.Context, in *racing.ListLogSamplingRequest) (*racing.ListLogSamplingResponse, error) {
+	var rules []*racing.LogSamplingRule
+
+	for _, method := range s.logSampler.Keys() {
+		rules = append(rules, &racing.LogSamplingRule{Method: method, Rate: s.logSampler.Rate(method)})
+	}
+
+	return &racing.ListLogSamplingResponse{Rules: rules}, nil
+}
+
+func (s *racingService) SetLogSampling(ctx context.Context, in *racing.SetLogSamplingRequest) (*racing.LogSamplingRule, error) {
+	if in.Method != logsample.Default && !isMethod(in.Method) {
+		return nil, errorreason.UnknownMethod.Error(fmt.Sprintf("unknown method %q", in.Method), nil)
+	}
+
+	if err := s.logSampler.Set(in.Method, in.Rate); err != nil {
+		return nil, errorreason.InvalidValue.Error(err.Error(), nil)
+	}
+
+	return &racing.LogSamplingRule{Method: in.Method, Rate: s.logSampler.Rate(in.Method)}, nil
+}
+
+func (s *racingService) ListPayloadLogging(ctx context.Context, in *racing.ListPayloadLoggingRequest) (*racing.ListPayloadLoggingResponse, error) {
+	var rules []*racing.PayloadLoggingRule
+
+	for _, rule := range s.payloadLogger.Rules() {
+		rules = append(rules, payloadLoggingRule(rule))
+	}
+
+	return &racing.ListPayloadLoggingResponse{Rules: rules}, nil
+}
+
+func (s *racingService) SetPayloadLogging(ctx context.Context, in *racing.SetPayloadLoggingRequest) (*racing.PayloadLoggingRule, error) {
+	if !isMethod(in.Method) {
+		return nil, errorreason.UnknownMethod.Error(fmt.Sprintf("unknown method %q", in.Method), nil)
+	}
+
+	if !in.Enabled {
+		s.payloadLogger.Remove(in.Method)
+		return &racing.PayloadLoggingRule{Method: in.Method}, nil
+	}
+
+	input := racing.File_racing_racing_proto.Services().ByName("Racing").Methods().ByName(protoreflect.Name(in.Method)).Input()
+
+	rule := payloadlog.Rule{
+		Method:   in.Method,
+		Redact:   in.Redact.GetPaths(),
+		MaxBytes: int(in.MaxBytes),
+	}
+
+	if err := payloadlog.ValidatePaths(input, rule.Redact); err != nil {
+		return nil, errorreason.InvalidValue.Error(err.Error(), nil)
+	}
+
+	if err := s.payloadLogger.Set(rule); err != nil {
+		return nil, errorreason.InvalidValue.Error(err.Error(), nil)
+	}
+
+	rule, _ = s.payloadLogger.Rule(in.Method)
+
+	return payloadLoggingRule(rule), nil
+}
+
+func payloadLoggingRule(rule payloadlog.Rule) *racing.PayloadLoggingRule {
+	return &racing.PayloadLoggingRule{
+		Method:   rule.Method,
+		Enabled:  true,
+		Redact:   &fieldmaskpb.FieldMask{Paths: rule.Redact},
+		MaxBytes: int32(rule.MaxBytes),
+	}
+}
+
+// isMethod reports whether name is a unary RPC of the Racing service, streams
+// are not logged.
+func isMethod(name string) bool {
+	for _, method := range racing.Racing_ServiceDesc.Methods {
+		if method.MethodName == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -1,41 +1,213 @@
 package service
 
 import (
+	"time"
+
+	"git.neds.sh/matty/entain/pkg/errorreason"
+	"git.neds.sh/matty/entain/pkg/fanout"
+	"git.neds.sh/matty/entain/pkg/featureflag"
+	"git.neds.sh/matty/entain/pkg/logsample"
+	"git.neds.sh/matty/entain/pkg/metrics"
+	"git.neds.sh/matty/entain/pkg/payloadlog"
+	"git.neds.sh/matty/entain/pkg/sqlfilter"
 	"git.neds.sh/matty/entain/racing/db"
 	"git.neds.sh/matty/entain/racing/proto/racing"
 	"golang.org/x/net/context"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type Racing interface {
 	// ListRaces will return a collection of races.
 	ListRaces(ctx context.Context, in *racing.ListRacesRequest) (*racing.ListRacesResponse, error)
 	GetRace(ctx context.Context, in *racing.GetRaceRequest) (*racing.Race, error)
+	// CreateRace will create a new race.
+	CreateRace(ctx context.Context, in *racing.CreateRaceRequest) (*racing.Race, error)
+	// UpdateRace will replace an existing race.
+	UpdateRace(ctx context.Context, in *racing.UpdateRaceRequest) (*racing.Race, error)
+	// DeleteRace will delete a race.
+	DeleteRace(ctx context.Context, in *racing.DeleteRaceRequest) (*emptypb.Empty, error)
+	// SetRaceResult will record the winner of a race, settling it.
+	SetRaceResult(ctx context.Context, in *racing.SetRaceResultRequest) (*racing.Race, error)
+	// GetRaceResult will return the result of a settled race.
+	GetRaceResult(ctx context.Context, in *racing.GetRaceResultRequest) (*racing.RaceResult, error)
+	// CheckSchema will report any drift between the database schema and
+	// the columns the service requires.
+	CheckSchema(ctx context.Context, in *racing.CheckSchemaRequest) (*racing.CheckSchemaResponse, error)
+	// WatchRaces will stream races as their status or visibility changes.
+	WatchRaces(in *racing.WatchRacesRequest, stream racing.Racing_WatchRacesServer) error
+	// ListFeatureFlags will return the feature flags of the service.
+	ListFeatureFlags(ctx context.Context, in *racing.ListFeatureFlagsRequest) (*racing.ListFeatureFlagsResponse, error)
+	// SetFeatureFlag will turn a feature flag on or off.
+	SetFeatureFlag(ctx context.Context, in *racing.SetFeatureFlagRequest) (*racing.FeatureFlag, error)
+	// ListLogSampling will return the fraction of requests logged by method.
+	ListLogSampling(ctx context.Context, in *racing.ListLogSamplingRequest) (*racing.ListLogSamplingResponse, error)
+	// SetLogSampling will change the fraction of requests to a method which
+	// are logged.
+	SetLogSampling(ctx context.Context, in *racing.SetLogSamplingRequest) (*racing.LogSamplingRule, error)
+	// ListPayloadLogging will return the methods whose request payloads are
+	// logged.
+	ListPayloadLogging(ctx context.Context, in *racing.ListPayloadLoggingRequest) (*racing.ListPayloadLoggingResponse, error)
+	// SetPayloadLogging will start or stop logging the request payloads of a
+	// method.
+	SetPayloadLogging(ctx context.Context, in *racing.SetPayloadLoggingRequest) (*racing.PayloadLoggingRule, error)
+	// ListChanges will return the changes to races after a sequence number.
+	ListChanges(ctx context.Context, in *racing.ListChangesRequest) (*racing.ListChangesResponse, error)
+	// WatchChanges will stream changes to races as they are made.
+	WatchChanges(in *racing.WatchChangesRequest, stream racing.Racing_WatchChangesServer) error
+	// SearchRaces will return the races whose name contains the query.
+	SearchRaces(ctx context.Context, in *racing.SearchRacesRequest) (*racing.SearchRacesResponse, error)
+	// GetOperationalSummary will return counts of the races and the recent
+	// request and error rates of the service.
+	GetOperationalSummary(ctx context.Context, in *racing.GetOperationalSummaryRequest) (*racing.OperationalSummary, error)
+	// ListRecentRequests will return the last requests completed by the
+	// service, newest first.
+	ListRecentRequests(ctx context.Context, in *racing.ListRecentRequestsRequest) (*racing.ListRecentRequestsResponse, error)
 }
 
 // racingService implements the Racing interface.
 type racingService struct {
-	racesRepo db.RacesRepo
+	racesRepo     db.RacesRepo
+	raceHub       *RaceHub
+	flags         *featureflag.Flags
+	logSampler    *logsample.Sampler
+	payloadLogger *payloadlog.Logger
+	rpcMetrics    *metrics.RPC
+	requestLog    *metrics.RequestLog
+	// queryTimeout bounds each of the queries run concurrently for a
+	// response.
+	queryTimeout time.Duration
 }
 
 // NewRacingService instantiates and returns a new racingService.
-func NewRacingService(racesRepo db.RacesRepo) Racing {
-	return &racingService{racesRepo}
+func NewRacingService(racesRepo db.RacesRepo, raceHub *RaceHub, flags *featureflag.Flags, logSampler *logsample.Sampler, payloadLogger *payloadlog.Logger, rpcMetrics *metrics.RPC, requestLog *metrics.RequestLog, queryTimeout time.Duration) Racing {
+	return &racingService{racesRepo, raceHub, flags, logSampler, payloadLogger, rpcMetrics, requestLog, queryTimeout}
 }
 
 func (s *racingService) ListRaces(ctx context.Context, in *racing.ListRacesRequest) (*racing.ListRacesResponse, error) {
-	races, err := s.racesRepo.List(in.Filter, in.OrderBy)
+	page, err := sqlfilter.ParsePage(in.PageSize, in.PageToken)
 	if err != nil {
+		return nil, errorreason.InvalidValue.Error(err.Error(), nil)
+	}
+
+	var (
+		count int64
+		races []*racing.Race
+	)
+
+	// The page doesn't depend on the count so they are queried together.
+	queries := fanout.New(ctx, s.queryTimeout)
+	queries.Go(func(ctx context.Context) (err error) {
+		count, err = s.racesRepo.Count(ctx, in.Filter)
+		return err
+	})
+	if !in.CountOnly {
+		queries.Go(func(ctx context.Context) (err error) {
+			races, err = s.racesRepo.List(ctx, in.Filter, defaultOrderBy(ctx, in.OrderBy), page)
+			return err
+		})
+	}
+	if err := queries.Wait(); err != nil {
 		return nil, err
 	}
 
-	return &racing.ListRacesResponse{Races: races}, nil
+	if in.CountOnly {
+		return &racing.ListRacesResponse{TotalCount: count, TotalSize: count}, nil
+	}
+
+	return &racing.ListRacesResponse{
+		Races:         races,
+		TotalSize:     count,
+		NextPageToken: page.NextToken(len(races), count),
+	}, nil
 }
 
 func (s *racingService) GetRace(ctx context.Context, in *racing.GetRaceRequest) (*racing.Race, error) {
-	race, err := s.racesRepo.Get(in.Id)
+	var (
+		race    *racing.Race
+		runners []*racing.Runner
+	)
+
+	queries := fanout.New(ctx, s.queryTimeout)
+	queries.Go(func(ctx context.Context) (err error) {
+		race, err = s.racesRepo.Get(ctx, in.Id)
+		return err
+	})
+	queries.Go(func(ctx context.Context) (err error) {
+		runners, err = s.racesRepo.ListRunners(ctx, in.Id)
+		return err
+	})
+	if err := queries.Wait(); err != nil {
+		return nil, err
+	}
+
+	race.Runners = runners
+
+	return race, nil
+}
+
+func (s *racingService) CreateRace(ctx context.Context, in *racing.CreateRaceRequest) (*racing.Race, error) {
+	race, err := s.racesRepo.Create(ctx, in.Race)
+	if err != nil {
+		return nil, err
+	}
+
+	return race, nil
+}
+
+func (s *racingService) UpdateRace(ctx context.Context, in *racing.UpdateRaceRequest) (*racing.Race, error) {
+	race, err := s.racesRepo.Update(ctx, in.Race)
 	if err != nil {
 		return nil, err
 	}
 
 	return race, nil
 }
+
+func (s *racingService) DeleteRace(ctx context.Context, in *racing.DeleteRaceRequest) (*emptypb.Empty, error) {
+	if err := s.racesRepo.Delete(ctx, in.Id); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *racingService) SetRaceResult(ctx context.Context, in *racing.SetRaceResultRequest) (*racing.Race, error) {
+	return s.racesRepo.SetResult(ctx, in.Id, &racing.RaceResult{Winner: in.Winner}, time.Now())
+}
+
+func (s *racingService) GetRaceResult(ctx context.Context, in *racing.GetRaceResultRequest) (*racing.RaceResult, error) {
+	return s.racesRepo.GetResult(ctx, in.Id)
+}
+
+func (s *racingService) CheckSchema(ctx context.Context, in *racing.CheckSchemaRequest) (*racing.CheckSchemaResponse, error) {
+	tables, err := s.racesRepo.CheckSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &racing.CheckSchemaResponse{Tables: tables}, nil
+}
+
+func (s *racingService) WatchRaces(in *racing.WatchRacesRequest, stream racing.Racing_WatchRacesServer) error {
+	if !s.flags.Enabled(FlagWatchRaces) {
+		return errorreason.FeatureDisabled.Error("watching races is disabled", map[string]string{"flag": FlagWatchRaces})
+	}
+
+	races, unsubscribe := s.raceHub.Subscribe(in.Filter)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case race, ok := <-races:
+			if !ok {
+				return errorreason.WatchClosed.Error("race watch closed, subscriber too slow or server shutting down", nil)
+			}
+
+			if err := stream.Send(race.(*racing.Race)); err != nil {
+				return err
+			}
+		}
+	}
+}
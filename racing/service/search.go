@@ -0,0 +1,15 @@
+package service
+
+import (
+	"git.neds.sh/matty/entain/racing/proto/racing"
+	"golang.org/x/net/context"
+)
+
+func (s *racingService) SearchRaces(ctx context.Context, in *racing.SearchRacesRequest) (*racing.SearchRacesResponse, error) {
+	races, err := s.racesRepo.Search(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &racing.SearchRacesResponse{Races: races}, nil
+}
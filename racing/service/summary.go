@@ -0,0 +1,67 @@
+package service
+
+import (
+	"time"
+
+	"git.neds.sh/matty/entain/pkg/fanout"
+	"git.neds.sh/matty/entain/pkg/metrics"
+	"git.neds.sh/matty/entain/racing/proto/racing"
+	"golang.org/x/net/context"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// upcomingWithin is how soon races must start to count as upcoming in the
+// operational summary.
+const upcomingWithin = time.Hour
+
+func (s *racingService) GetOperationalSummary(ctx context.Context, in *racing.GetOperationalSummaryRequest) (*racing.OperationalSummary, error) {
+	var (
+		counts   map[string]int64
+		upcoming int64
+	)
+
+	queries := fanout.New(ctx, s.queryTimeout)
+	queries.Go(func(ctx context.Context) (err error) {
+		counts, err = s.racesRepo.CountByStatus(ctx)
+		return err
+	})
+	queries.Go(func(ctx context.Context) (err error) {
+		upcoming, err = s.racesRepo.Count(ctx, &racing.ListRacesRequestFilter{StartingWithin: durationpb.New(upcomingWithin)})
+		return err
+	})
+	if err := queries.Wait(); err != nil {
+		return nil, err
+	}
+
+	requests, errors := s.rpcMetrics.Recent("racing.Racing")
+
+	summary := &racing.OperationalSummary{
+		CountsByStatus:   counts,
+		UpcomingNextHour: upcoming,
+		Window:           durationpb.New(metrics.RecentSpan),
+		Requests:         requests,
+		Errors:           errors,
+	}
+	if requests > 0 {
+		summary.ErrorRate = float64(errors) / float64(requests)
+	}
+
+	return summary, nil
+}
+
+func (s *racingService) ListRecentRequests(ctx context.Context, in *racing.ListRecentRequestsRequest) (*racing.ListRecentRequestsResponse, error) {
+	var requests []*racing.RecentRequest
+
+	for _, request := range s.requestLog.List(in.Method, int(in.Limit)) {
+		requests = append(requests, &racing.RecentRequest{
+			Method:    request.Method,
+			Fields:    request.Fields,
+			Code:      request.Code,
+			StartTime: timestamppb.New(request.Start),
+			Latency:   durationpb.New(request.Elapsed),
+		})
+	}
+
+	return &racing.ListRecentRequestsResponse{Requests: requests}, nil
+}
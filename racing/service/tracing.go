@@ -0,0 +1,82 @@
+package service
+
+import (
+	"path"
+	"strconv"
+	"strings"
+
+	"git.neds.sh/matty/entain/pkg/tracing"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// NewUnaryTracingInterceptor records a span for each unary request,
+// continuing the trace of the caller given in the traceparent metadata. The
+// database queries of the request are recorded as its children.
+func NewUnaryTracingInterceptor(tracer *tracing.Tracer) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, span := startServerSpan(ctx, tracer, info.FullMethod)
+		resp, err := handler(ctx, req)
+		endServerSpan(span, err)
+
+		return resp, err
+	}
+}
+
+// NewStreamTracingInterceptor records a span for each stream, like
+// NewUnaryTracingInterceptor.
+func NewStreamTracingInterceptor(tracer *tracing.Tracer) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, span := startServerSpan(ss.Context(), tracer, info.FullMethod)
+		err := handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+		endServerSpan(span, err)
+
+		return err
+	}
+}
+
+// contextServerStream replaces the context of a stream, to carry its span
+// or request ID.
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// startServerSpan starts the span of a request, adding the remote parent and
+// baggage given in the metadata to the context. The baggage is added even if
+// tracing is disabled, as it annotates the log lines of the request too.
+func startServerSpan(ctx context.Context, tracer *tracing.Tracer, fullMethod string) (context.Context, *tracing.Span) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(tracing.TraceparentKey); len(values) > 0 {
+			if remote, ok := tracing.ParseTraceparent(values[0]); ok {
+				ctx = tracing.ContextWithRemote(ctx, remote)
+			}
+		}
+
+		if values := md.Get(tracing.BaggageKey); len(values) > 0 {
+			ctx = tracing.ContextWithBaggage(ctx, tracing.ParseBaggage(strings.Join(values, ",")))
+		}
+	}
+
+	ctx, span := tracer.Start(ctx, fullMethod[1:], tracing.KindServer)
+	span.SetAttribute("rpc.system", "grpc")
+	span.SetAttribute("rpc.service", path.Dir(fullMethod)[1:])
+	span.SetAttribute("rpc.method", path.Base(fullMethod))
+
+	return ctx, span
+}
+
+func endServerSpan(span *tracing.Span, err error) {
+	span.SetAttribute("rpc.grpc.status_code", strconv.Itoa(int(status.Code(err))))
+	if err != nil {
+		span.SetStatus(tracing.StatusError, status.Convert(err).Message())
+	}
+
+	span.End()
+}
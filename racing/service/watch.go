@@ -0,0 +1,116 @@
+package service
+
+import (
+	"strings"
+	"time"
+
+	"git.neds.sh/matty/entain/pkg/watchhub"
+	"git.neds.sh/matty/entain/racing/db"
+	"git.neds.sh/matty/entain/racing/proto/racing"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+	"google.golang.org/protobuf/proto"
+)
+
+// RaceHub periodically polls the races repository and fans out any races
+// whose status or visibility changed since the last poll to subscribers.
+type RaceHub struct {
+	hub *watchhub.Hub
+	now func() time.Time
+}
+
+// NewRaceHub creates a new hub polling the races repository every interval.
+// Subscribers filtering on starting_within are matched by the clock now.
+func NewRaceHub(racesRepo db.RacesRepo, interval time.Duration, now func() time.Time, logger *zap.Logger) *RaceHub {
+	list := func(ctx context.Context) ([]watchhub.Item, error) {
+		races, err := racesRepo.List(ctx, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]watchhub.Item, len(races))
+		for i, race := range races {
+			items[i] = watchhub.Item{
+				ID:      race.Id,
+				State:   &racing.Race{Status: race.Status, Visible: race.Visible},
+				Message: race,
+			}
+		}
+
+		return items, nil
+	}
+
+	return &RaceHub{hub: watchhub.New(list, interval, logger.With(zap.String("watched", "races"))), now: now}
+}
+
+// Run polls for changes until the context is cancelled.
+func (h *RaceHub) Run(ctx context.Context) {
+	h.hub.Run(ctx)
+}
+
+// Subscribe registers interest in races matching the filter, see
+// watchhub.Hub.Subscribe. The races delivered are *racing.Race.
+func (h *RaceHub) Subscribe(filter *racing.ListRacesRequestFilter) (<-chan proto.Message, func()) {
+	return h.hub.Subscribe(func(message proto.Message) bool {
+		return matchesFilter(message.(*racing.Race), filter, h.now())
+	})
+}
+
+// matchesFilter applies the same filtering as the races repository to a
+// single race in memory.
+func matchesFilter(race *racing.Race, filter *racing.ListRacesRequestFilter, now time.Time) bool {
+	if filter == nil {
+		return true
+	}
+
+	if len(filter.MeetingIds) > 0 && !containsID(filter.MeetingIds, race.MeetingId) {
+		return false
+	}
+
+	if len(filter.Ids) > 0 && !containsID(filter.Ids, race.Id) {
+		return false
+	}
+
+	if filter.Number != nil && *filter.Number != race.Number {
+		return false
+	}
+
+	if filter.NameContains != nil && !strings.Contains(strings.ToLower(race.Name), strings.ToLower(*filter.NameContains)) {
+		return false
+	}
+
+	if filter.Visible != nil && *filter.Visible != race.Visible {
+		return false
+	}
+
+	if filter.Settled != nil && *filter.Settled != (race.Result != nil) {
+		return false
+	}
+
+	start := race.AdvertisedStartTime.AsTime()
+
+	if filter.AdvertisedStartAfter != nil && start.Before(filter.AdvertisedStartAfter.AsTime()) {
+		return false
+	}
+
+	if filter.AdvertisedStartBefore != nil && !start.Before(filter.AdvertisedStartBefore.AsTime()) {
+		return false
+	}
+
+	if filter.StartingWithin != nil {
+		if start.Before(now) || !start.Before(now.Add(filter.StartingWithin.AsDuration())) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsID(ids []int64, id int64) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
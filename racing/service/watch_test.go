@@ -0,0 +1,37 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMatchesFilterStartingWithin(t *testing.T) {
+	now := time.Date(2022, 1, 3, 12, 0, 0, 0, time.UTC)
+	filter := &racing.ListRacesRequestFilter{StartingWithin: durationpb.New(time.Hour)}
+
+	tests := []struct {
+		name     string
+		startsIn time.Duration
+		want     bool
+	}{
+		{name: "started", startsIn: -time.Second, want: false},
+		{name: "starting now", startsIn: 0, want: true},
+		{name: "within", startsIn: 59 * time.Minute, want: true},
+		{name: "at the end", startsIn: time.Hour, want: false},
+		{name: "after", startsIn: 2 * time.Hour, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			race := &racing.Race{Id: 1, AdvertisedStartTime: timestamppb.New(now.Add(tt.startsIn))}
+
+			if got := matchesFilter(race, filter, now); got != tt.want {
+				t.Errorf("matchesFilter = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+// Command export writes the closed events to a Parquet file, so they can be
+// loaded into the analytics warehouse without going through the API. It is
+// meant to be run by a scheduler, e.g. nightly, or by hand.
+//
+//	go run ./cmd/export -out /var/exports/events.parquet
+//
+// The file is written alongside the output path and renamed into place once
+// complete, so a loader never picks up a partial export.
+package main
+
+import (
+	"context"
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"git.neds.sh/jmassey/entain/sports/db"
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"git.neds.sh/matty/entain/pkg/fieldlimit"
+	"git.neds.sh/matty/entain/pkg/sqldialect"
+	"git.neds.sh/matty/entain/pkg/sqlfilter"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+var (
+	dbDriver = flag.String("db-driver", "sqlite3", "Database driver, one of: "+strings.Join(sqldialect.Drivers(), ", "))
+	dsn      = flag.String("dsn", "./db/sports.db", "Data source name of the database for the driver")
+	out      = flag.String("out", "events.parquet", "Path of the Parquet file written")
+)
+
+// eventRow is the schema of the exported events. Times are milliseconds since
+// the epoch, which every warehouse loads as a timestamp. Multi eligibility is
+// left out as it comes from the parlay rules in force when an event is read,
+// not the database.
+type eventRow struct {
+	ID                  int64  `parquet:"name=id, type=INT64"`
+	Sport               string `parquet:"name=sport, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	League              int64  `parquet:"name=league, type=INT64"`
+	HomeSideName        string `parquet:"name=home_side_name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	AwaySideName        string `parquet:"name=away_side_name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Name                string `parquet:"name=name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Visible             bool   `parquet:"name=visible, type=BOOLEAN"`
+	AdvertisedStartTime int64  `parquet:"name=advertised_start_time, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+	Status              string `parquet:"name=status, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+}
+
+func main() {
+	flag.Parse()
+
+	if err := run(); err != nil {
+		log.Fatalf("failed exporting events: %s\n", err)
+	}
+}
+
+func run() error {
+	sportsDB, err := sqldialect.Open(*dbDriver, *dsn)
+	if err != nil {
+		return err
+	}
+	defer sportsDB.Close()
+
+	// The repository is only listed from, it isn't initialised so an empty
+	// database isn't seeded.
+	eventsRepo := db.NewEventsRepo(sportsDB, fieldlimit.New("events", fieldlimit.Truncate, nil), nil, 0, db.SeedUniform, time.Now)
+
+	closedBefore, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+
+	started, err := eventsRepo.List(context.Background(), &sports.ListEventsRequestFilter{AdvertisedStartBefore: closedBefore}, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	// Events which have started may still be live, they are exported once
+	// closed or settled.
+	var events []*sports.Event
+	for _, event := range started {
+		if event.Status != sqlfilter.StatusLive {
+			events = append(events, event)
+		}
+	}
+
+	if err := write(*out, events); err != nil {
+		return err
+	}
+
+	log.Printf("exported %d events to %s\n", len(events), *out)
+
+	return nil
+}
+
+// write writes the events to a Parquet file at path, replacing it.
+func write(path string, events []*sports.Event) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".export-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	// Temporary files are only readable by their owner, the loader may not
+	// be.
+	if err := tmp.Chmod(0644); err != nil {
+		return err
+	}
+
+	pw, err := writer.NewParquetWriterFromWriter(tmp, new(eventRow), 1)
+	if err != nil {
+		return err
+	}
+	pw.CompressionType = parquet.CompressionCodec_SNAPPY
+
+	for _, event := range events {
+		row := eventRow{
+			ID:                  event.Id,
+			Sport:               event.Sport,
+			League:              event.League,
+			HomeSideName:        event.HomeSideName,
+			AwaySideName:        event.AwaySideName,
+			Name:                event.Name,
+			Visible:             event.Visible,
+			AdvertisedStartTime: event.AdvertisedStartTime.AsTime().UnixNano() / int64(time.Millisecond),
+			Status:              event.Status,
+		}
+		if err := pw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	if err := pw.WriteStop(); err != nil {
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,219 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"git.neds.sh/matty/entain/pkg/sqldialect"
+	"git.neds.sh/matty/entain/pkg/sqlfilter"
+	"google.golang.org/protobuf/proto"
+)
+
+const teamAliasesTable = "team_aliases"
+
+// teamAliasColumns are the columns of the team aliases table, each row being
+// another name of a team such as an abbreviation or a localised name.
+var teamAliasColumns = []sqldialect.Column{
+	{Name: "team_id", Type: sqldialect.Integer},
+	{Name: "alias", Type: sqldialect.Text},
+}
+
+const (
+	teamAliasesSelect = `SELECT team_id, alias FROM team_aliases`
+	teamAliasesDelete = `DELETE FROM team_aliases WHERE team_id = ?`
+	teamAliasesInsert = `INSERT INTO team_aliases (team_id, alias) VALUES (?,?)`
+	teamsExists       = `SELECT COUNT(*) FROM teams WHERE id = ?`
+
+	// teamsByAlias selects the names of the teams with an alias, compared
+	// in lower case, to which the condition on alias is appended.
+	teamsByAlias = `
+		SELECT DISTINCT teams.name FROM team_aliases
+		JOIN teams ON teams.id = team_aliases.team_id
+		WHERE `
+)
+
+func (r *eventsRepo) createTeamAliasesTable() error {
+	return r.db.CreateTable(context.Background(), teamAliasesTable, teamAliasColumns)
+}
+
+// withSideAliases returns the filter with the names of the teams any of its
+// sides is an alias of added to them, so events are found by the other names
+// of their sides. The filter is returned as it is when no side is an alias.
+func (r *eventsRepo) withSideAliases(ctx context.Context, filter *sports.ListEventsRequestFilter) (*sports.ListEventsRequestFilter, error) {
+	if len(filter.GetSides()) == 0 {
+		return filter, nil
+	}
+
+	args := make([]interface{}, len(filter.Sides))
+	for i, side := range filter.Sides {
+		args[i] = strings.ToLower(side)
+	}
+
+	names, err := r.teamNames(ctx, teamsByAlias+"LOWER(alias) IN ("+placeholders(len(args))+")", args...)
+	if err != nil || len(names) == 0 {
+		return filter, err
+	}
+
+	filter = proto.Clone(filter).(*sports.ListEventsRequestFilter)
+	filter.Sides = append(filter.Sides, names...)
+
+	return filter, nil
+}
+
+// matchupsClause returns the condition matching the events between the sides
+// of any of the matchups, home and away, by their names or those of the teams
+// they are an alias of.
+func (r *eventsRepo) matchupsClause(ctx context.Context, matchups []*sports.Matchup) (string, []interface{}, error) {
+	var (
+		conditions []string
+		args       []interface{}
+	)
+
+	for _, matchup := range matchups {
+		home, err := r.sideNames(ctx, matchup.HomeSide)
+		if err != nil {
+			return "", nil, err
+		}
+
+		away, err := r.sideNames(ctx, matchup.AwaySide)
+		if err != nil {
+			return "", nil, err
+		}
+
+		conditions = append(conditions, "(home_side_name IN ("+placeholders(len(home))+") AND away_side_name IN ("+placeholders(len(away))+"))")
+		args = append(args, home...)
+		args = append(args, away...)
+	}
+
+	return "(" + strings.Join(conditions, " OR ") + ")", args, nil
+}
+
+// sideNames returns the name of a side with those of the teams it is an alias
+// of, as arguments of a query.
+func (r *eventsRepo) sideNames(ctx context.Context, side string) ([]interface{}, error) {
+	names, err := r.teamNames(ctx, teamsByAlias+"LOWER(alias) = ?", strings.ToLower(side))
+	if err != nil {
+		return nil, err
+	}
+
+	args := []interface{}{side}
+	for _, name := range names {
+		args = append(args, name)
+	}
+
+	return args, nil
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?,", n), ",")
+}
+
+// searchAliases returns the names of the teams with an alias containing the
+// query, ignoring case.
+func (r *eventsRepo) searchAliases(ctx context.Context, query string) ([]string, error) {
+	return r.teamNames(ctx, teamsByAlias+"LOWER(alias) LIKE ? ESCAPE '!'", sqlfilter.ContainsPattern(query))
+}
+
+func (r *eventsRepo) teamNames(ctx context.Context, query string, args ...interface{}) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("finding teams by alias: %w", err)
+	}
+	defer rows.Close()
+
+	var names []string
+
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("finding teams by alias: %w", err)
+		}
+		names = append(names, name)
+	}
+
+	return names, rows.Err()
+}
+
+func (r *teamsRepo) ListAliases(ctx context.Context, teamIDs []int64) ([]*sports.TeamAliases, error) {
+	query := teamAliasesSelect
+
+	args := make([]interface{}, len(teamIDs))
+	for i, id := range teamIDs {
+		args[i] = id
+	}
+	if len(args) > 0 {
+		query += " WHERE team_id IN (" + strings.TrimSuffix(strings.Repeat("?,", len(args)), ",") + ")"
+	}
+
+	rows, err := r.db.QueryContext(ctx, query+" ORDER BY team_id, alias", args...)
+	if err != nil {
+		return nil, fmt.Errorf("listing aliases of teams: %w", err)
+	}
+	defer rows.Close()
+
+	var teams []*sports.TeamAliases
+
+	for rows.Next() {
+		var (
+			teamID int64
+			alias  string
+		)
+		if err := rows.Scan(&teamID, &alias); err != nil {
+			return nil, fmt.Errorf("listing aliases of teams: %w", err)
+		}
+
+		if len(teams) == 0 || teams[len(teams)-1].TeamId != teamID {
+			teams = append(teams, &sports.TeamAliases{TeamId: teamID})
+		}
+		teams[len(teams)-1].Aliases = append(teams[len(teams)-1].Aliases, alias)
+	}
+
+	return teams, rows.Err()
+}
+
+func (r *teamsRepo) SetAliases(ctx context.Context, teamID int64, aliases []string) (*sports.TeamAliases, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("setting aliases of team %d: %w", teamID, err)
+	}
+	defer tx.Rollback()
+
+	var exists int
+	if err := tx.QueryRowContext(ctx, teamsExists, teamID).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("setting aliases of team %d: %w", teamID, err)
+	}
+	if exists == 0 {
+		return nil, &NotFoundError{Resource: ResourceTeam, ID: teamID}
+	}
+
+	if _, err := tx.ExecContext(ctx, teamAliasesDelete, teamID); err != nil {
+		return nil, fmt.Errorf("setting aliases of team %d: %w", teamID, err)
+	}
+
+	// Aliases differing only by case match the same sides so only the
+	// first is kept.
+	seen := make(map[string]bool, len(aliases))
+	kept := []string{}
+	for _, alias := range aliases {
+		if seen[strings.ToLower(alias)] {
+			continue
+		}
+		seen[strings.ToLower(alias)] = true
+
+		if _, err := tx.ExecContext(ctx, teamAliasesInsert, teamID, alias); err != nil {
+			return nil, fmt.Errorf("setting aliases of team %d: %w", teamID, err)
+		}
+		kept = append(kept, alias)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("setting aliases of team %d: %w", teamID, err)
+	}
+
+	sort.Strings(kept)
+
+	return &sports.TeamAliases{TeamId: teamID, Aliases: kept}, nil
+}
@@ -0,0 +1,78 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"git.neds.sh/matty/entain/pkg/sqldialect"
+)
+
+const auditTable = "event_audit"
+
+// Actions recorded in the audit table.
+const (
+	auditHidden = "HIDDEN"
+)
+
+// auditColumns are the columns of the audit table, which records changes
+// made to events by the service itself rather than by a caller.
+var auditColumns = []sqldialect.Column{
+	{Name: "id", Type: sqldialect.Serial},
+	{Name: "event_id", Type: sqldialect.Integer},
+	{Name: "action", Type: sqldialect.Text},
+	{Name: "reason", Type: sqldialect.Text},
+	{Name: "created_at", Type: sqldialect.Timestamp},
+}
+
+func (r *eventsRepo) createAuditTable() error {
+	return r.db.CreateTable(context.Background(), auditTable, auditColumns)
+}
+
+// HideStale hides the visible events of a sport which started before the
+// time, recording each in the audit table with the reason. Events have no
+// results recorded yet so any event started before the time is stale.
+func (r *eventsRepo) HideStale(ctx context.Context, sport string, startedBefore time.Time, reason string) ([]int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("hiding stale %s events: %w", sport, err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, getEventQueries()[eventsStale], sport, true, startedBefore)
+	if err != nil {
+		return nil, fmt.Errorf("hiding stale %s events: %w", sport, err)
+	}
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("hiding stale %s events: %w", sport, err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("hiding stale %s events: %w", sport, err)
+	}
+
+	now := time.Now()
+
+	for _, id := range ids {
+		if _, err := tx.ExecContext(ctx, getEventQueries()[eventsHide], false, id); err != nil {
+			return nil, fmt.Errorf("hiding stale %s events: %w", sport, err)
+		}
+
+		if _, err := tx.ExecContext(ctx, getEventQueries()[auditInsert], id, auditHidden, reason, now); err != nil {
+			return nil, fmt.Errorf("hiding stale %s events: %w", sport, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("hiding stale %s events: %w", sport, err)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,105 @@
+package db
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"git.neds.sh/matty/entain/pkg/rediscache"
+)
+
+// cachedEventsRepo serves events by ID from a cache in front of the
+// repository, dropping them from the cache as they are written. Events are
+// cached with their status, so it can lag behind the start and end of an
+// event by the TTL of the cache.
+type cachedEventsRepo struct {
+	EventsRepo
+	cache *rediscache.Cache
+}
+
+// NewCachedEventsRepo caches the events got by ID from the repository.
+// Errors of the cache are logged and the event read from the repository
+// instead.
+func NewCachedEventsRepo(repo EventsRepo, cache *rediscache.Cache) EventsRepo {
+	return &cachedEventsRepo{EventsRepo: repo, cache: cache}
+}
+
+func (r *cachedEventsRepo) Get(ctx context.Context, id int64) (*sports.Event, error) {
+	var event sports.Event
+
+	cached, err := r.cache.Get(ctx, eventKey(id), &event)
+	if err != nil {
+		log.Printf("warning: reading event %d from cache: %s\n", id, err)
+	}
+	if cached {
+		return &event, nil
+	}
+
+	got, err := r.EventsRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.cache.Set(ctx, eventKey(id), got); err != nil {
+		log.Printf("warning: caching event %d: %s\n", id, err)
+	}
+
+	return got, nil
+}
+
+func (r *cachedEventsRepo) Update(ctx context.Context, event *sports.Event) (*sports.Event, error) {
+	defer r.invalidate(ctx, event.GetId())
+
+	return r.EventsRepo.Update(ctx, event)
+}
+
+func (r *cachedEventsRepo) Patch(ctx context.Context, event *sports.Event, paths []string) (*sports.Event, error) {
+	defer r.invalidate(ctx, event.GetId())
+
+	return r.EventsRepo.Patch(ctx, event, paths)
+}
+
+func (r *cachedEventsRepo) Delete(ctx context.Context, id int64) error {
+	defer r.invalidate(ctx, id)
+
+	return r.EventsRepo.Delete(ctx, id)
+}
+
+func (r *cachedEventsRepo) SetResult(ctx context.Context, id int64, result *sports.EventResult, settledAt time.Time) (*sports.Event, error) {
+	defer r.invalidate(ctx, id)
+
+	return r.EventsRepo.SetResult(ctx, id, result, settledAt)
+}
+
+func (r *cachedEventsRepo) SetScore(ctx context.Context, id int64, score *sports.Score, updatedAt time.Time) (*sports.Event, error) {
+	defer r.invalidate(ctx, id)
+
+	return r.EventsRepo.SetScore(ctx, id, score, updatedAt)
+}
+
+func (r *cachedEventsRepo) HideStale(ctx context.Context, sport string, startedBefore time.Time, reason string) ([]int64, error) {
+	hidden, err := r.EventsRepo.HideStale(ctx, sport, startedBefore, reason)
+
+	r.invalidate(ctx, hidden...)
+
+	return hidden, err
+}
+
+// invalidate drops events written from the cache. They are dropped even when
+// the write fails, as it may have been made.
+func (r *cachedEventsRepo) invalidate(ctx context.Context, ids ...int64) {
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = eventKey(id)
+	}
+
+	if err := r.cache.Delete(ctx, keys...); err != nil {
+		log.Printf("warning: dropping events %v from cache: %s\n", ids, err)
+	}
+}
+
+func eventKey(id int64) string {
+	return "event:" + strconv.FormatInt(id, 10)
+}
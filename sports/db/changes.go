@@ -0,0 +1,84 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"git.neds.sh/matty/entain/pkg/changelog"
+	"git.neds.sh/matty/entain/pkg/sqlfilter"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/protobuf/proto"
+)
+
+// ChangesTable is the table whose changes are captured, for publishing them
+// with a changelog.Publisher.
+const ChangesTable = eventsTable
+
+// enableChanges starts capturing changes to events. Databases without change
+// capture still serve events, only ListChanges and WatchChanges fail.
+func (r *eventsRepo) enableChanges() error {
+	err := changelog.Enable(context.Background(), r.db, eventsTable)
+	if errors.Is(err, changelog.ErrUnsupported) {
+		log.Printf("warning: changes to %s are not captured: %s\n", eventsTable, err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := changelog.EnableColumn(context.Background(), r.db, eventsTable, "visible", changelog.Visibility); err != nil {
+		return err
+	}
+
+	// Changes are partitioned by sport so they can be published apart.
+	return changelog.EnablePartition(context.Background(), r.db, eventsTable, "sport")
+}
+
+func (r *eventsRepo) ListChanges(ctx context.Context, afterSeq int64, limit int32) ([]*sports.Change, error) {
+	if limit <= 0 {
+		limit = sqlfilter.DefaultPageSize
+	}
+	if limit > sqlfilter.MaxPageSize {
+		limit = sqlfilter.MaxPageSize
+	}
+
+	changes, err := changelog.List(ctx, r.db, eventsTable, afterSeq, int(limit))
+	if err != nil {
+		return nil, fmt.Errorf("listing changes to events after %d: %w", afterSeq, err)
+	}
+
+	eventChanges := make([]*sports.Change, 0, len(changes))
+	for _, change := range changes {
+		message, err := changeMessage(change)
+		if err != nil {
+			return nil, fmt.Errorf("listing changes to events after %d: %w", afterSeq, err)
+		}
+
+		eventChanges = append(eventChanges, message)
+	}
+
+	return eventChanges, nil
+}
+
+// ChangeMessage converts a captured change to a event into its protobuf
+// message, for publishing changes as protobuf.
+func ChangeMessage(change changelog.Change) (proto.Message, error) {
+	return changeMessage(change)
+}
+
+func changeMessage(change changelog.Change) (*sports.Change, error) {
+	changedAt, err := ptypes.TimestampProto(change.ChangedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sports.Change{
+		Seq:       change.Seq,
+		EventId:   change.RowID,
+		Operation: change.Operation,
+		ChangedAt: changedAt,
+	}, nil
+}
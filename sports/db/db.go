@@ -1,30 +1,107 @@
 package db
 
 import (
-	"math/rand"
+	"context"
+	"fmt"
 	"time"
 
+	"git.neds.sh/matty/entain/pkg/sqldialect"
 	"syreclabs.com/go/faker"
 )
 
 // Randomly selects a home and away team making sure the same team isn't
 // playing itself.
 func select_away_and_home(sides []string) (home string, away string) {
-	home = sides[rand.Intn(len(sides))]
-	away = sides[rand.Intn(len(sides))]
+	home = faker.RandomChoice(sides)
+	away = faker.RandomChoice(sides)
 	for away == home {
-		away = sides[rand.Intn(len(sides))]
+		away = faker.RandomChoice(sides)
 	}
 
 	return home, away
 }
 
+// seedBatchSize is the number of rows inserted by each statement when seeding.
+const seedBatchSize = 500
+
+// SetRandomSeed makes the random dummy data seeded into an empty database the
+// same on every run given the same seed. Start times are still relative to
+// when the service starts.
+func SetRandomSeed(seed int64) {
+	faker.Seed(seed)
+}
+
 func (r *eventsRepo) seed() error {
-	statement, err := r.db.Prepare(`CREATE TABLE IF NOT EXISTS events (id INTEGER PRIMARY KEY, sport TEXT, league INTEGER, home_side_name TEXT, away_side_name TEXT, visible INTEGER, advertised_start_time DATETIME)`)
-	if err == nil {
-		_, err = statement.Exec()
+	ctx := context.Background()
+
+	if err := r.db.CreateTable(ctx, eventsTable, eventColumns); err != nil {
+		return err
 	}
 
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	batch := tx.NewBatch(eventsTable, []string{"id", "sport", "league", "home_side_name", "away_side_name", "visible", "advertised_start_time"}, seedBatchSize)
+
+	switch {
+	case r.fixtures != nil:
+		err = r.seedFixtures(ctx, batch)
+	case r.seedProfile == SeedDemo:
+		err = r.seedDemo(ctx, batch)
+	default:
+		err = r.seedRandom(ctx, batch)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := batch.Flush(ctx); err != nil {
+		return err
+	}
+
+	for _, statement := range backfillSides {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+
+	// The seeded rows have explicit ids so make sure created events don't
+	// collide with them.
+	if sync := r.db.Dialect.SyncSequence(eventsTable, "id"); sync != "" {
+		if _, err := tx.ExecContext(ctx, sync); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// seedFixtures inserts the events loaded from a fixtures file.
+func (r *eventsRepo) seedFixtures(ctx context.Context, batch *sqldialect.Batch) error {
+	for _, event := range r.fixtures {
+		if err := r.limitFields(event); err != nil {
+			return fmt.Errorf("fixture event %d: %w", event.Id, err)
+		}
+
+		values, err := eventColumnValues(event)
+		if err != nil {
+			return fmt.Errorf("fixture event %d: %w", event.Id, err)
+		}
+
+		if err := batch.Add(ctx, append([]interface{}{event.Id}, values...)...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// seedRandom inserts seedCount events with fake sides and start times around
+// now.
+func (r *eventsRepo) seedRandom(ctx context.Context, batch *sqldialect.Batch) error {
 	// Pre-generate teams and players so that the same side can appear in
 	// different events to test filtering.
 	var football_teams []string
@@ -37,36 +114,39 @@ func (r *eventsRepo) seed() error {
 		hockey_teams = append(hockey_teams, faker.Team().Name())
 	}
 
-	for i := 1; i <= 100; i++ {
-		statement, err = r.db.Prepare(`INSERT OR IGNORE INTO events(id, sport, league, home_side_name, away_side_name, visible, advertised_start_time) VALUES (?,?,?,?,?,?,?)`)
-		if err == nil {
-			var sport string = faker.RandomChoice([]string{"football", "tennis", "hockey"})
-			var league, home_side_name, away_side_name string
-			rand.Seed(time.Now().UnixNano())
-
-			switch sport {
-			case "football":
-				league = faker.Number().Between(0, 9)
-				home_side_name, away_side_name = select_away_and_home(football_teams)
-			case "tennis":
-				league = faker.Number().Between(10, 20)
-				home_side_name, away_side_name = select_away_and_home(tennis_players)
-			case "hockey":
-				league = faker.Number().Between(20, 30)
-				home_side_name, away_side_name = select_away_and_home(hockey_teams)
-			}
-
-			_, err = statement.Exec(
-				i,
-				sport,
-				league,
-				home_side_name,
-				away_side_name,
-				faker.Number().Between(0, 1),
-				faker.Time().Between(time.Now().AddDate(0, 0, -1), time.Now().AddDate(0, 0, 2)).Format(time.RFC3339),
-			)
+	// Start times are relative to a single now so they are reproducible.
+	now := time.Now()
+
+	for i := 1; i <= r.seedCount; i++ {
+		var sport string = faker.RandomChoice([]string{"football", "tennis", "hockey"})
+		var league, home_side_name, away_side_name string
+
+		// Leagues are those seeded into the leagues table for the sport.
+		switch sport {
+		case "football":
+			league = faker.Number().Between(1, 9)
+			home_side_name, away_side_name = select_away_and_home(football_teams)
+		case "tennis":
+			league = faker.Number().Between(10, 19)
+			home_side_name, away_side_name = select_away_and_home(tennis_players)
+		case "hockey":
+			league = faker.Number().Between(20, 30)
+			home_side_name, away_side_name = select_away_and_home(hockey_teams)
+		}
+
+		err := batch.Add(ctx,
+			i,
+			sport,
+			league,
+			home_side_name,
+			away_side_name,
+			faker.Number().Between(0, 1),
+			faker.Time().Between(now.AddDate(0, 0, -1), now.AddDate(0, 0, 2)),
+		)
+		if err != nil {
+			return err
 		}
 	}
 
-	return err
+	return nil
 }
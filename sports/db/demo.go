@@ -0,0 +1,191 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"git.neds.sh/matty/entain/pkg/sqldialect"
+	"syreclabs.com/go/faker"
+)
+
+// Profiles of the random events an empty database is seeded with.
+const (
+	// SeedUniform seeds events of random leagues and sides starting at any
+	// time from a day ago to two days ahead.
+	SeedUniform = "uniform"
+	// SeedDemo seeds leagues playing rounds on weekends around now, capped
+	// per league, plus a few live events so demos see every status.
+	SeedDemo = "demo"
+)
+
+// SeedProfiles returns the names of the seed profiles.
+func SeedProfiles() []string {
+	return []string{SeedUniform, SeedDemo}
+}
+
+// CheckSeedProfile returns an error if there is no seed profile by the name.
+func CheckSeedProfile(name string) error {
+	for _, profile := range SeedProfiles() {
+		if name == profile {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown seed profile %q, expected one of: %s", name, strings.Join(SeedProfiles(), ", "))
+}
+
+const (
+	// demoSidesPerLeague is the number of sides in each demo league, each
+	// plays once per round.
+	demoSidesPerLeague = 10
+
+	// demoRoundsBefore and demoRoundsAfter are the number of weekends of
+	// rounds seeded before and after this one, which caps the events of
+	// each league.
+	demoRoundsBefore = 1
+	demoRoundsAfter  = 2
+
+	// demoLiveEvents is the number of events seeded in progress.
+	demoLiveEvents = 5
+)
+
+// demoLeague is a league of the demo seed profile.
+type demoLeague struct {
+	sport  string
+	league int64
+}
+
+var demoLeagues = []demoLeague{
+	{sport: "football", league: 1},
+	{sport: "football", league: 2},
+	{sport: "tennis", league: 10},
+	{sport: "tennis", league: 11},
+	{sport: "hockey", league: 21},
+	{sport: "hockey", league: 22},
+}
+
+// demoStartTimes are the local times of day the events of a round start at.
+var demoStartTimes = []time.Duration{
+	13 * time.Hour,
+	15*time.Hour + 30*time.Minute,
+	19*time.Hour + 30*time.Minute,
+}
+
+// demoEvent is an event to be seeded, ids are assigned in start order.
+type demoEvent struct {
+	league   demoLeague
+	home     string
+	away     string
+	visible  bool
+	startsAt time.Time
+}
+
+// seedDemo inserts up to seedCount events of the demo profile. The rounds are
+// sampled at random when the leagues have more events than seedCount, the
+// live events are always seeded.
+func (r *eventsRepo) seedDemo(ctx context.Context, batch *sqldialect.Batch) error {
+	// Start times are relative to a single now so they are reproducible.
+	now := time.Now()
+
+	sides := make(map[demoLeague][]string, len(demoLeagues))
+	for _, league := range demoLeagues {
+		for i := 0; i < demoSidesPerLeague; i++ {
+			if league.sport == "tennis" {
+				sides[league] = append(sides[league], faker.Name().Name())
+			} else {
+				sides[league] = append(sides[league], faker.Team().Name())
+			}
+		}
+	}
+
+	var live []demoEvent
+	for i := 0; i < demoLiveEvents && i < r.seedCount; i++ {
+		league := demoLeagues[faker.RandomInt(0, len(demoLeagues)-1)]
+		home, away := select_away_and_home(sides[league])
+
+		// Start within the first half of the event so it stays live for a
+		// while after seeding.
+		started := time.Duration(faker.RandomInt64(int64(time.Minute), int64(eventDurations[league.sport]/2)))
+
+		live = append(live, demoEvent{league: league, home: home, away: away, visible: true, startsAt: now.Add(-started)})
+	}
+
+	scheduled := demoRounds(now, sides)
+	if limit := r.seedCount - len(live); len(scheduled) > limit {
+		// Sample evenly from every round rather than truncating, so there
+		// are both finished and upcoming events.
+		for i := range scheduled {
+			j := faker.RandomInt(i, len(scheduled)-1)
+			scheduled[i], scheduled[j] = scheduled[j], scheduled[i]
+		}
+		scheduled = scheduled[:limit]
+	} else if len(scheduled) < limit {
+		log.Printf("demo leagues are capped at %d events, fewer than the %d requested\n", len(scheduled)+len(live), r.seedCount)
+	}
+
+	events := append(live, scheduled...)
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].startsAt.Before(events[j].startsAt)
+	})
+
+	for i, event := range events {
+		err := batch.Add(ctx,
+			i+1,
+			event.league.sport,
+			event.league.league,
+			event.home,
+			event.away,
+			event.visible,
+			event.startsAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// demoRounds schedules a round of every league on each weekend around now,
+// pairing the sides so none plays twice in a round.
+func demoRounds(now time.Time, sides map[demoLeague][]string) []demoEvent {
+	// Saturday of this weekend, or of the last one on a Sunday.
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	saturday := today.AddDate(0, 0, int(time.Saturday-now.Weekday()))
+	if now.Weekday() == time.Sunday {
+		saturday = today.AddDate(0, 0, -1)
+	}
+
+	var events []demoEvent
+
+	for round := -demoRoundsBefore; round <= demoRoundsAfter; round++ {
+		weekend := saturday.AddDate(0, 0, 7*round)
+
+		for _, league := range demoLeagues {
+			order := append([]string(nil), sides[league]...)
+			for i := range order {
+				j := faker.RandomInt(i, len(order)-1)
+				order[i], order[j] = order[j], order[i]
+			}
+
+			for i := 0; i+1 < len(order); i += 2 {
+				day := weekend.AddDate(0, 0, faker.RandomInt(0, 1))
+				startsAt := day.Add(demoStartTimes[faker.RandomInt(0, len(demoStartTimes)-1)])
+
+				events = append(events, demoEvent{
+					league:   league,
+					home:     order[i],
+					away:     order[i+1],
+					visible:  faker.RandomInt(0, 9) > 0,
+					startsAt: startsAt,
+				})
+			}
+		}
+	}
+
+	return events
+}
@@ -0,0 +1,50 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotFound is returned, wrapped, when the requested event, result, league
+// or team does not exist.
+var ErrNotFound = errors.New("not found")
+
+// Resources reported by NotFoundError.
+const (
+	ResourceEvent  = "event"
+	ResourceLeague = "league"
+	ResourceTeam   = "team"
+	// ResourceResult is the result of an event, identified by the ID of
+	// the event.
+	ResourceResult = "event result"
+)
+
+// NotFoundError is returned when no resource has the requested ID, it matches
+// ErrNotFound.
+type NotFoundError struct {
+	Resource string
+	ID       int64
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("no %s with id %v", e.Resource, e.ID)
+}
+
+// Is reports a NotFoundError as ErrNotFound for errors.Is.
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// ValidationError is returned when a event or request is invalid, the
+// message is safe to return to the caller.
+type ValidationError struct {
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Reason
+}
+
+func invalidf(format string, args ...interface{}) error {
+	return &ValidationError{Reason: fmt.Sprintf(format, args...)}
+}
@@ -1,15 +1,21 @@
 package db
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"github.com/golang/protobuf/ptypes"
-	_ "github.com/mattn/go-sqlite3"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"git.neds.sh/matty/entain/pkg/fieldlimit"
+	"git.neds.sh/matty/entain/pkg/sqldialect"
+	"git.neds.sh/matty/entain/pkg/sqlfilter"
+	"git.neds.sh/matty/entain/pkg/sqlscan"
 )
 
 // EventsRepo provides repository access to events.
@@ -17,20 +23,77 @@ type EventsRepo interface {
 	// Init will initialise our events repository.
 	Init() error
 
-	// List will return a list of events.
-	List(filter *sports.ListEventsRequestFilter, orderBy *string) ([]*sports.Event, error)
+	// List will return a list of events, limited to the page if one is given.
+	// The events of the sports in sportPriority come first, in its order,
+	// before those ordered by orderBy.
+	List(ctx context.Context, filter *sports.ListEventsRequestFilter, orderBy *string, sportPriority []string, page *sqlfilter.Page) ([]*sports.Event, error)
+	// Count will return the number of events matching the filter.
+	Count(ctx context.Context, filter *sports.ListEventsRequestFilter) (int64, error)
+	// CountByStatus will return the number of events of each status.
+	CountByStatus(ctx context.Context) (map[string]int64, error)
+	// ListSports will return the sports of the events matching the filter,
+	// with the number of events of each, by name.
+	ListSports(ctx context.Context, filter *sports.ListEventsRequestFilter) ([]*sports.Sport, error)
 	// Get will return an event by ID.
-	Get(id int64) (*sports.Event, error)
+	Get(ctx context.Context, id int64) (*sports.Event, error)
+	// Create will insert a new event and return it with its assigned ID.
+	Create(ctx context.Context, event *sports.Event) (*sports.Event, error)
+	// Update will replace the event with the same ID.
+	Update(ctx context.Context, event *sports.Event) (*sports.Event, error)
+	// Patch will update only the given fields of the event with the same ID.
+	Patch(ctx context.Context, event *sports.Event, paths []string) (*sports.Event, error)
+	// Delete will remove an event by ID.
+	Delete(ctx context.Context, id int64) error
+	// CheckSchema will compare the database schema against the columns
+	// required by the repository.
+	CheckSchema(ctx context.Context) ([]*sports.TableSchema, error)
+	// ListChanges will return up to limit changes to events after the
+	// sequence number afterSeq, oldest first.
+	ListChanges(ctx context.Context, afterSeq int64, limit int32) ([]*sports.Change, error)
+	// Search will return the events whose sides' names contain the query, by
+	// advertised start time.
+	Search(ctx context.Context, in *sports.SearchEventsRequest) ([]*sports.Event, error)
+	// SetResult will record the result of an event, settled at the time.
+	SetResult(ctx context.Context, id int64, result *sports.EventResult, settledAt time.Time) (*sports.Event, error)
+	// GetResult will return the result of a settled event.
+	GetResult(ctx context.Context, id int64) (*sports.EventResult, error)
+	// SetScore will record the current score of an event, updated at the
+	// time.
+	SetScore(ctx context.Context, id int64, score *sports.Score, updatedAt time.Time) (*sports.Event, error)
+	// ListMarkets will return the markets of an event with their prices.
+	ListMarkets(ctx context.Context, eventID int64) ([]*sports.Market, error)
+	// SetPrice will set the head to head price of a side of an event,
+	// updated at the time.
+	SetPrice(ctx context.Context, eventID int64, side string, price float64, updatedAt time.Time) (*sports.Price, error)
+	// HideStale will hide the visible events of a sport which started
+	// before the time, auditing each with the reason, and return their IDs.
+	HideStale(ctx context.Context, sport string, startedBefore time.Time, reason string) ([]int64, error)
 }
 
 type eventsRepo struct {
-	db   *sql.DB
-	init sync.Once
+	db          *sqldialect.DB
+	limits      *fieldlimit.Limits
+	fixtures    []*sports.Event
+	seedCount   int
+	seedProfile string
+	now         func() time.Time
+	init        sync.Once
+
+	// getByID is prepared by Init, as Get is called far more than the
+	// other queries.
+	getByID *sqldialect.Stmt
+
+	// fullText is set by Init when the names of sides are indexed for
+	// Search.
+	fullText bool
 }
 
-// NewEventsRepo creates a new events repository.
-func NewEventsRepo(db *sql.DB) EventsRepo {
-	return &eventsRepo{db: db}
+// NewEventsRepo creates a new events repository. String fields longer than the
+// limits are truncated or rejected when written. The database is seeded with
+// the fixtures, or seedCount random events of the seed profile when nil.
+// Relative filters, such as starting_within, are resolved by the clock now.
+func NewEventsRepo(db *sqldialect.DB, limits *fieldlimit.Limits, fixtures []*sports.Event, seedCount int, seedProfile string, now func() time.Time) EventsRepo {
+	return &eventsRepo{db: db, limits: limits, fixtures: fixtures, seedCount: seedCount, seedProfile: seedProfile, now: now}
 }
 
 // Init prepares the event repository dummy data.
@@ -38,14 +101,72 @@ func (r *eventsRepo) Init() error {
 	var err error
 
 	r.init.Do(func() {
+		if err = r.addSideColumns(); err != nil {
+			return
+		}
+
+		// Catch a partially migrated database before seeding as inserts
+		// and scans fail in confusing ways when columns are missing.
+		if err = r.verifySchema(); err != nil {
+			return
+		}
+
+		if err = r.createTeamsTable(); err != nil {
+			return
+		}
+
+		if err = r.createTeamAliasesTable(); err != nil {
+			return
+		}
+
 		// For test/example purposes, we seed the DB with some dummy events.
-		err = r.seed()
+		if err = r.seed(); err != nil {
+			return
+		}
+
+		if err = r.createAuditTable(); err != nil {
+			return
+		}
+
+		if err = r.createResultsTable(); err != nil {
+			return
+		}
+
+		if err = r.createPricesTable(); err != nil {
+			return
+		}
+
+		if err = r.createScoresTable(); err != nil {
+			return
+		}
+
+		if err = r.enableChanges(); err != nil {
+			return
+		}
+
+		if err = r.enableSettlementChanges(); err != nil {
+			return
+		}
+
+		if err = r.enableScoreChanges(); err != nil {
+			return
+		}
+
+		if err = r.enableFullText(); err != nil {
+			return
+		}
+
+		r.getByID, err = r.db.PrepareContext(context.Background(), getEventQueries()[eventsGet])
 	})
 
-	return err
+	if err != nil {
+		return fmt.Errorf("initialising events: %w", err)
+	}
+
+	return nil
 }
 
-func (r *eventsRepo) List(filter *sports.ListEventsRequestFilter, orderBy *string) ([]*sports.Event, error) {
+func (r *eventsRepo) List(ctx context.Context, filter *sports.ListEventsRequestFilter, orderBy *string, sportPriority []string, page *sqlfilter.Page) ([]*sports.Event, error) {
 	var (
 		err   error
 		query string
@@ -54,155 +175,481 @@ func (r *eventsRepo) List(filter *sports.ListEventsRequestFilter, orderBy *strin
 
 	query = getEventQueries()[eventsList]
 
-	query, args = r.applyFilter(query, filter)
-	query = r.applyOrdering(query, orderBy)
+	query, args, err = r.applyFilter(ctx, query, filter)
+	if err != nil {
+		return nil, fmt.Errorf("listing events filtered by %s: %w", filterFields.Shape(filter), err)
+	}
+	query, args, err = r.applyOrdering(query, args, orderBy, sportPriority)
+	if err != nil {
+		return nil, err
+	}
+
+	query = page.Apply(query)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("listing events filtered by %s: %w", filterFields.Shape(filter), err)
+	}
+	defer rows.Close()
+
+	events, err := r.scanEvents(rows)
+	if err != nil {
+		return nil, fmt.Errorf("listing events filtered by %s: %w", filterFields.Shape(filter), err)
+	}
+
+	return events, nil
+}
+
+func (r *eventsRepo) Count(ctx context.Context, filter *sports.ListEventsRequestFilter) (int64, error) {
+	var count int64
+
+	query, args, err := r.applyFilter(ctx, getEventQueries()[eventsCount], filter)
+	if err != nil {
+		return 0, fmt.Errorf("counting events filtered by %s: %w", filterFields.Shape(filter), err)
+	}
+
+	if err := r.db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("counting events filtered by %s: %w", filterFields.Shape(filter), err)
+	}
+
+	return count, nil
+}
 
-	rows, err := r.db.Query(query, args...)
+func (r *eventsRepo) ListSports(ctx context.Context, filter *sports.ListEventsRequestFilter) ([]*sports.Sport, error) {
+	query, args, err := r.applyFilter(ctx, getEventQueries()[eventsSports], filter)
+	if err != nil {
+		return nil, fmt.Errorf("listing sports of events filtered by %s: %w", filterFields.Shape(filter), err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query+" GROUP BY sport ORDER BY sport", args...)
+	if err != nil {
+		return nil, fmt.Errorf("listing sports of events filtered by %s: %w", filterFields.Shape(filter), err)
+	}
+	defer rows.Close()
+
+	var result []*sports.Sport
+
+	for rows.Next() {
+		var sport sports.Sport
+		if err := rows.Scan(&sport.Name, &sport.EventCount); err != nil {
+			return nil, fmt.Errorf("scanning sport: %w", err)
+		}
+		result = append(result, &sport)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing sports of events filtered by %s: %w", filterFields.Shape(filter), err)
+	}
+
+	return result, nil
+}
+
+func (r *eventsRepo) Get(ctx context.Context, id int64) (*sports.Event, error) {
+	// Look up by primary key with the prepared statement rather than
+	// building a filtered List query each call, the scan is shared so
+	// events are the same either way.
+	rows, err := r.getByID.QueryContext(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting event %d: %w", id, err)
+	}
+	defer rows.Close()
+
+	events, err := r.scanEvents(rows)
+	if err != nil {
+		return nil, fmt.Errorf("getting event %d: %w", id, err)
+	}
+
+	switch len(events) {
+	case 0:
+		return nil, &NotFoundError{Resource: ResourceEvent, ID: id}
+	case 1:
+		return events[0], nil
+	default:
+		// IDs are the primary key so this is a broken database rather
+		// than something the caller can act on.
+		return nil, fmt.Errorf("found %d events with id %v, expected one", len(events), id)
+	}
+}
+
+func (r *eventsRepo) Create(ctx context.Context, event *sports.Event) (*sports.Event, error) {
+	if err := r.limitFields(event); err != nil {
+		return nil, err
+	}
+
+	args, err := eventColumnValues(event)
 	if err != nil {
 		return nil, err
 	}
 
-	return r.scanEvents(rows)
+	homeID, awayID, err := r.sideIDs(ctx, event.Sport, event.HomeSideName, event.AwaySideName)
+	if err != nil {
+		return nil, fmt.Errorf("creating event: %w", err)
+	}
+	args = append(args, homeID, awayID)
+
+	id, err := r.db.InsertID(ctx, getEventQueries()[eventsInsert], args...)
+	if err != nil {
+		return nil, fmt.Errorf("creating event: %w", err)
+	}
+
+	return r.Get(ctx, id)
 }
 
-func (r *eventsRepo) Get(id int64) (*sports.Event, error) {
-	// Repurpose listing functionality with an additional filter for
-	// consistancy.
-	filter := sports.ListEventsRequestFilter{Ids: []int64{id}}
-	events, err := r.List(&filter, nil)
+func (r *eventsRepo) Update(ctx context.Context, event *sports.Event) (*sports.Event, error) {
+	if err := r.limitFields(event); err != nil {
+		return nil, err
+	}
+
+	args, err := eventColumnValues(event)
 	if err != nil {
 		return nil, err
 	}
-	if len(events) != 1 {
-		// From the uber style guide fmt.Errorf is appropriate
-		return nil, fmt.Errorf("no event with id: %v", id)
+
+	homeID, awayID, err := r.sideIDs(ctx, event.Sport, event.HomeSideName, event.AwaySideName)
+	if err != nil {
+		return nil, fmt.Errorf("updating event %d: %w", event.Id, err)
+	}
+	args = append(args, homeID, awayID, event.Id)
+
+	result, err := r.db.ExecContext(ctx, getEventQueries()[eventsUpdate], args...)
+	if err != nil {
+		return nil, fmt.Errorf("updating event %d: %w", event.Id, err)
+	}
+
+	if err := expectRowAffected(result, event.Id); err != nil {
+		return nil, err
 	}
-	return events[0], nil
+
+	return r.Get(ctx, event.Id)
 }
 
-func (r *eventsRepo) applyFilter(query string, filter *sports.ListEventsRequestFilter) (string, []interface{}) {
+func (r *eventsRepo) Patch(ctx context.Context, event *sports.Event, paths []string) (*sports.Event, error) {
+	if event == nil {
+		return nil, invalidf("event is required")
+	}
+	if len(paths) == 0 {
+		return nil, invalidf("update mask is required")
+	}
+	if err := r.limitFields(event); err != nil {
+		return nil, err
+	}
+
 	var (
-		clauses []string
-		args    []interface{}
+		sets []string
+		args []interface{}
 	)
 
-	if filter == nil {
-		return query, args
+	seen := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		// A column set twice is an error in some databases and ambiguous
+		// in the rest.
+		if seen[path] {
+			return nil, invalidf("field %q is in the update mask more than once", path)
+		}
+		seen[path] = true
+
+		column, value, err := patchableEventColumn(event, path)
+		if err != nil {
+			return nil, err
+		}
+
+		sets = append(sets, column+" = ?")
+		args = append(args, value)
 	}
 
-	if len(filter.Sports) > 0 {
-		clauses = append(clauses, "sport IN ("+strings.Repeat("?,", len(filter.Sports)-1)+"?)")
+	sideSets, sideArgs, err := r.patchSides(ctx, event, paths)
+	if err != nil {
+		return nil, fmt.Errorf("patching event %d: %w", event.Id, err)
+	}
+	sets = append(sets, sideSets...)
+	args = append(args, sideArgs...)
+	args = append(args, event.Id)
 
-		for _, sport := range filter.Sports {
-			args = append(args, sport)
-		}
+	// Columns come from patchableEventColumn, never the request, so are
+	// safe to concatenate.
+	query := "UPDATE events SET " + strings.Join(sets, ", ") + " WHERE id = ?"
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("patching event %d: %w", event.Id, err)
 	}
 
-	if len(filter.Leagues) > 0 {
-		clauses = append(clauses, "league IN ("+strings.Repeat("?,", len(filter.Leagues)-1)+"?)")
+	if err := expectRowAffected(result, event.Id); err != nil {
+		return nil, err
+	}
 
-		for _, league := range filter.Leagues {
-			args = append(args, league)
-		}
+	return r.Get(ctx, event.Id)
+}
+
+// patchSides returns the columns and values to set the IDs of the sides of the
+// event to when the patch changes the sport or the name of a side, resolving
+// the teams from the event as it will be once patched.
+func (r *eventsRepo) patchSides(ctx context.Context, event *sports.Event, paths []string) ([]string, []interface{}, error) {
+	patched := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		patched[path] = true
+	}
+
+	if !patched["sport"] && !patched["home_side_name"] && !patched["away_side_name"] {
+		return nil, nil, nil
 	}
 
-	if len(filter.Sides) > 0 {
-		clauses = append(clauses, "(home_side_name IN ("+strings.Repeat("?,", len(filter.Sides)-1)+"?) OR away_side_name IN ("+strings.Repeat("?,", len(filter.Sides)-1)+"?))")
+	current, err := r.Get(ctx, event.Id)
+	if err != nil {
+		return nil, nil, err
+	}
 
-		for _, side := range filter.Sides {
-			args = append(args, side)
+	sport, homeSideName, awaySideName := current.Sport, current.HomeSideName, current.AwaySideName
+	if patched["sport"] {
+		sport = event.Sport
+	}
+	if patched["home_side_name"] {
+		homeSideName = event.HomeSideName
+	}
+	if patched["away_side_name"] {
+		awaySideName = event.AwaySideName
+	}
+
+	homeID, awayID, err := r.sideIDs(ctx, sport, homeSideName, awaySideName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return []string{"home_side_id = ?", "away_side_id = ?"}, []interface{}{homeID, awayID}, nil
+}
+
+// patchableEventColumn maps a field mask path to its column and the value to
+// store from the event. Derived and identifying fields cannot be patched.
+func patchableEventColumn(event *sports.Event, path string) (string, interface{}, error) {
+	switch path {
+	case "sport":
+		if event.Sport == "" {
+			return "", nil, invalidf("event sport is required")
+		}
+		return "sport", event.Sport, nil
+	case "league":
+		return "league", event.League, nil
+	case "home_side_name":
+		if event.HomeSideName == "" {
+			return "", nil, invalidf("event home side name is required")
 		}
-		for _, side := range filter.Sides {
-			args = append(args, side)
+		return "home_side_name", event.HomeSideName, nil
+	case "away_side_name":
+		if event.AwaySideName == "" {
+			return "", nil, invalidf("event away side name is required")
+		}
+		return "away_side_name", event.AwaySideName, nil
+	case "visible":
+		return "visible", event.Visible, nil
+	case "advertised_start_time":
+		if event.AdvertisedStartTime == nil {
+			return "", nil, invalidf("event advertised start time is required")
+		}
+		advertisedStart, err := ptypes.Timestamp(event.AdvertisedStartTime)
+		if err != nil {
+			return "", nil, invalidf("event advertised start time is invalid: %s", err)
 		}
+		return "advertised_start_time", advertisedStart, nil
 	}
 
-	if len(filter.Ids) > 0 {
-		clauses = append(clauses, "id IN ("+strings.Repeat("?,", len(filter.Ids)-1)+"?)")
+	return "", nil, invalidf("field %q cannot be updated", path)
+}
 
-		for _, ID := range filter.Ids {
-			args = append(args, ID)
-		}
+func (r *eventsRepo) Delete(ctx context.Context, id int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("deleting event %d: %w", id, err)
 	}
+	defer tx.Rollback()
 
-	if filter.Visible != nil {
-		clauses = append(clauses, "visible = ?")
-		args = append(args, *filter.Visible)
+	result, err := tx.ExecContext(ctx, getEventQueries()[eventsDelete], id)
+	if err != nil {
+		return fmt.Errorf("deleting event %d: %w", id, err)
 	}
 
-	if len(clauses) != 0 {
-		query += " WHERE " + strings.Join(clauses, " AND ")
+	if err := expectRowAffected(result, id); err != nil {
+		return err
 	}
 
-	return query, args
-}
+	if _, err := tx.ExecContext(ctx, getEventQueries()[resultDelete], id); err != nil {
+		return fmt.Errorf("deleting result of event %d: %w", id, err)
+	}
 
-func convertOrderByFieldToSql(orderByField string) (orderByFieldSql string, ok bool) {
-	// Important to verify against allowed field names to protect from SQL
-	// injection.
-	sortableFields := map[string]string{
-		"home_side_name":        "home_side_name",
-		"away_side_name":        "away_side_name",
-		"league":                "league",
-		"sport":                 "sport",
-		"advertised_start_time": "advertised_start_time",
+	if _, err := tx.ExecContext(ctx, pricesDelete, id); err != nil {
+		return fmt.Errorf("deleting prices of event %d: %w", id, err)
 	}
 
-	orderByFieldSplit := strings.Fields(orderByField)
-	if len(orderByFieldSplit) > 2 {
-		return "", false
+	if _, err := tx.ExecContext(ctx, scoreDelete, id); err != nil {
+		return fmt.Errorf("deleting score of event %d: %w", id, err)
 	}
-	field := orderByFieldSplit[0]
-	databaseField, ok := sortableFields[field]
-	if !ok {
-		return "", false
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("deleting event %d: %w", id, err)
+	}
+
+	return nil
+}
+
+// limitFields enforces the length limits of the string fields of an event.
+func (r *eventsRepo) limitFields(event *sports.Event) error {
+	if event == nil {
+		return nil
 	}
-	orderByFieldSql += databaseField
-	if len(orderByFieldSplit) == 2 {
-		desc := strings.ToLower(orderByFieldSplit[1])
-		if strings.Contains(desc, "desc") {
-			orderByFieldSql += " DESC"
+
+	for _, field := range []struct {
+		name  string
+		value *string
+	}{
+		{"sport", &event.Sport},
+		{"home_side_name", &event.HomeSideName},
+		{"away_side_name", &event.AwaySideName},
+	} {
+		if err := r.limits.Apply(field.name, field.value); err != nil {
+			return invalidf("event %s", err)
 		}
 	}
-	return orderByFieldSql, true
+
+	return nil
 }
 
-func convertOrderByToSql(orderBy string) (orderBySql string) {
-	var sqls []string
-	orderBySql = ""
+// eventColumnValues validates an event and returns the values for the
+// writable columns in the order used by the insert and update queries. The
+// name and status are derived so are not stored.
+func eventColumnValues(event *sports.Event) ([]interface{}, error) {
+	if event == nil {
+		return nil, invalidf("event is required")
+	}
+	if event.Sport == "" {
+		return nil, invalidf("event sport is required")
+	}
+	if event.HomeSideName == "" || event.AwaySideName == "" {
+		return nil, invalidf("event home and away side names are required")
+	}
+	if event.AdvertisedStartTime == nil {
+		return nil, invalidf("event advertised start time is required")
+	}
 
-	for _, orderByField := range strings.Split(orderBy, ",") {
-		orderByField = strings.TrimSpace(orderByField)
-		if orderByFieldSql, ok := convertOrderByFieldToSql(orderByField); ok {
-			sqls = append(sqls, orderByFieldSql)
-		}
+	advertisedStart, err := ptypes.Timestamp(event.AdvertisedStartTime)
+	if err != nil {
+		return nil, invalidf("event advertised start time is invalid: %s", err)
 	}
-	if len(sqls) != 0 {
-		orderBySql = " ORDER BY " + strings.Join(sqls, ", ")
+
+	return []interface{}{
+		event.Sport,
+		event.League,
+		event.HomeSideName,
+		event.AwaySideName,
+		event.Visible,
+		advertisedStart,
+	}, nil
+}
+
+// expectRowAffected returns an error if a write by ID matched no rows.
+func expectRowAffected(result sql.Result, id int64) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("checking event %d was written: %w", id, err)
+	}
+	if affected == 0 {
+		return &NotFoundError{Resource: ResourceEvent, ID: id}
 	}
-	return orderBySql
+	return nil
+}
+
+// filterFields maps the fields of the ListEventsRequestFilter onto the columns
+// they filter.
+var filterFields = sqlfilter.Filters{
+	{Name: "sports", Columns: []string{"sport"}},
+	{Name: "leagues", Columns: []string{"league"}},
+	{Name: "sides", Columns: []string{"home_side_name", "away_side_name"}},
+	{Name: "ids", Columns: []string{"id"}},
+	{Name: "visible", Columns: []string{"visible"}},
+	{Name: "advertised_start_after", Columns: []string{"advertised_start_time"}, Op: sqlfilter.AtOrAfter},
+	{Name: "advertised_start_before", Columns: []string{"advertised_start_time"}, Op: sqlfilter.Before},
+	{Name: "starting_within", Columns: []string{"advertised_start_time"}, Op: sqlfilter.Within},
+	{Name: "settled", Columns: []string{"settled_at"}, Op: sqlfilter.IsSet},
+	{Name: "not_ids", Columns: []string{"id"}, Op: sqlfilter.NotEqual},
+	{Name: "not_sports", Columns: []string{"sport"}, Op: sqlfilter.NotEqual},
+}
+
+// sortableFields maps the fields which can be given in an order_by to their
+// columns.
+var sortableFields = sqlfilter.Sortable{
+	"home_side_name":        "home_side_name",
+	"away_side_name":        "away_side_name",
+	"league":                "league",
+	"sport":                 "sport",
+	"advertised_start_time": "advertised_start_time",
+	"settled_at":            "settled_at",
+}
+
+// applyFilter appends the WHERE clause of the filter to the query, matching
+// sides and matchups by their aliases as well as their names.
+func (r *eventsRepo) applyFilter(ctx context.Context, query string, filter *sports.ListEventsRequestFilter) (string, []interface{}, error) {
+	filter, err := r.withSideAliases(ctx, filter)
+	if err != nil {
+		return "", nil, err
+	}
+
+	where, args := filterFields.Apply(r.db.Dialect, r.now, "", filter)
+
+	if len(filter.GetMatchups()) > 0 {
+		clause, clauseArgs, err := r.matchupsClause(ctx, filter.Matchups)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if where == "" {
+			where = " WHERE " + clause
+		} else {
+			where += " AND " + clause
+		}
+		args = append(args, clauseArgs...)
+	}
+
+	return query + where, args, nil
 }
 
 // If specified this will apply the ordering specified in the request to the
-// SQL SELECT query. The format of the order_by in the query is a comma
-// seperated list of fields with "desc" as a suffix to change the ordering.
-// e.g. "advertised_start_time, name desc"
-// https://cloud.google.com/apis/design/design_patterns#sorting_order
-func (r *eventsRepo) applyOrdering(query string, orderBy *string) string {
-	if orderBy == nil {
-		return query
+// SQL SELECT query, after putting the sports in sportPriority first. Events
+// are ordered by id last so pages don't overlap, see sqlfilter.OrderBy.
+func (r *eventsRepo) applyOrdering(query string, args []interface{}, orderBy *string, sportPriority []string) (string, []interface{}, error) {
+	columns, err := sortableFields.Columns(orderBy)
+	if err != nil {
+		return "", nil, invalidf("%s", err)
 	}
 
-	orderBySql := convertOrderByToSql(*orderBy)
+	if len(sportPriority) > 0 {
+		priority, priorityArgs := sportPriorityOrder(sportPriority)
+		args = append(args, priorityArgs...)
+
+		if columns == "" {
+			columns = priority
+		} else {
+			columns = priority + ", " + columns
+		}
+	}
 
-	return query + orderBySql
+	return query + sqlfilter.OrderBy(columns), args, nil
 }
 
-func getEventStatus(advertisedStart time.Time) string {
-	if advertisedStart.Before(time.Now()) {
-		return "CLOSED"
+// sportPriorityOrder returns an ORDER BY expression ranking events by the
+// position of their sport in the priority, those of other sports last, and
+// its arguments.
+func sportPriorityOrder(sportPriority []string) (string, []interface{}) {
+	var (
+		expr strings.Builder
+		args = make([]interface{}, len(sportPriority))
+	)
+
+	expr.WriteString("CASE sport")
+	for i, sport := range sportPriority {
+		expr.WriteString(" WHEN ? THEN " + strconv.Itoa(i))
+		args[i] = sport
 	}
-	return "OPEN"
+	expr.WriteString(" ELSE " + strconv.Itoa(len(sportPriority)) + " END")
+
+	return expr.String(), args
 }
 
 func (m *eventsRepo) scanEvents(
@@ -213,16 +660,46 @@ func (m *eventsRepo) scanEvents(
 	for rows.Next() {
 		var event sports.Event
 		var advertisedStart time.Time
+		var homeSideID, awaySideID sql.NullInt64
+		var homeScore, awayScore sql.NullInt32
+		var settledAt sql.NullTime
+		var liveHomeScore, liveAwayScore sql.NullInt32
+		var scoreUpdatedAt sql.NullTime
+
+		// Scan by column name so the SELECT can change without breaking
+		// the scan.
+		targets, err := sqlscan.Targets(rows, map[string]interface{}{
+			"id":                    &event.Id,
+			"sport":                 &event.Sport,
+			"league":                &event.League,
+			"home_side_id":          &homeSideID,
+			"away_side_id":          &awaySideID,
+			"home_side_name":        &event.HomeSideName,
+			"away_side_name":        &event.AwaySideName,
+			"visible":               &event.Visible,
+			"advertised_start_time": &advertisedStart,
+			"home_score":            &homeScore,
+			"away_score":            &awayScore,
+			"settled_at":            &settledAt,
+			"live_home_score":       &liveHomeScore,
+			"live_away_score":       &liveAwayScore,
+			"score_updated_at":      &scoreUpdatedAt,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("scanning event: %w", err)
+		}
 
-		if err := rows.Scan(&event.Id, &event.Sport, &event.League, &event.HomeSideName, &event.AwaySideName, &event.Visible, &advertisedStart); err != nil {
-			if err == sql.ErrNoRows {
+		if err := rows.Scan(targets...); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
 				return nil, nil
 			}
 
-			return nil, err
+			return nil, fmt.Errorf("scanning event: %w", err)
 		}
 
 		event.Name = event.HomeSideName + " vs " + event.AwaySideName
+		event.HomeSideId = homeSideID.Int64
+		event.AwaySideId = awaySideID.Int64
 
 		ts, err := ptypes.TimestampProto(advertisedStart)
 		if err != nil {
@@ -231,10 +708,32 @@ func (m *eventsRepo) scanEvents(
 
 		event.AdvertisedStartTime = ts
 
-		event.Status = getEventStatus(advertisedStart)
+		event.Status = eventStatus(event.Sport, advertisedStart)
+
+		if settledAt.Valid {
+			event.Status = sqlfilter.StatusFinished
+			event.Result = &sports.EventResult{HomeScore: homeScore.Int32, AwayScore: awayScore.Int32}
+			event.Result.WinningSide = winningSide(event.Result)
+
+			if event.SettledAt, err = ptypes.TimestampProto(settledAt.Time); err != nil {
+				return nil, err
+			}
+		}
+
+		if scoreUpdatedAt.Valid {
+			event.Score = &sports.Score{HomeScore: liveHomeScore.Int32, AwayScore: liveAwayScore.Int32}
+
+			if event.Score.UpdatedAt, err = ptypes.TimestampProto(scoreUpdatedAt.Time); err != nil {
+				return nil, err
+			}
+		}
 
 		events = append(events, &event)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("scanning events: %w", err)
+	}
+
 	return events, nil
 }
@@ -0,0 +1,186 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"git.neds.sh/matty/entain/pkg/fieldlimit"
+	"git.neds.sh/matty/entain/pkg/sqlfilter"
+	"git.neds.sh/matty/entain/pkg/testutil"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPatchRejectsRepeatedPaths(t *testing.T) {
+	eventsRepo := NewEventsRepo(testutil.OpenDB(t), fieldlimit.New("events", fieldlimit.Truncate, nil), nil, 1, SeedUniform, time.Now)
+	if err := eventsRepo.Init(); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		paths   []string
+		invalid bool
+	}{
+		{name: "once", paths: []string{"visible"}},
+		{name: "each once", paths: []string{"visible", "league"}},
+		{name: "repeated", paths: []string{"visible", "visible"}, invalid: true},
+		{name: "repeated apart", paths: []string{"visible", "league", "visible"}, invalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := eventsRepo.Patch(ctx, &sports.Event{Id: 1, League: 2, Visible: true}, tt.paths)
+
+			var validationErr *ValidationError
+			if got := errors.As(err, &validationErr); got != tt.invalid {
+				t.Errorf("err = %v, want invalid %t", err, tt.invalid)
+			}
+			if !tt.invalid && err != nil {
+				t.Errorf("err = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestListStartingWithinUsesClock(t *testing.T) {
+	now := time.Date(2022, 1, 3, 12, 0, 0, 0, time.UTC)
+
+	var fixtures []*sports.Event
+	for id, startsIn := range []time.Duration{-time.Minute, 0, 30 * time.Minute, time.Hour, 2 * time.Hour} {
+		fixtures = append(fixtures, &sports.Event{
+			Id:                  int64(id + 1),
+			Sport:               "tennis",
+			HomeSideName:        "Home",
+			AwaySideName:        "Away",
+			AdvertisedStartTime: timestamppb.New(now.Add(startsIn)),
+		})
+	}
+
+	clock := func() time.Time { return now }
+	eventsRepo := NewEventsRepo(testutil.OpenDB(t), fieldlimit.New("events", fieldlimit.Truncate, nil), fixtures, 0, SeedUniform, clock)
+	if err := eventsRepo.Init(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		within time.Duration
+		want   []int64
+	}{
+		{name: "none", within: 0, want: nil},
+		{name: "up to the hour", within: time.Hour, want: []int64{2, 3}},
+		{name: "up to two hours", within: 2*time.Hour + time.Second, want: []int64{2, 3, 4, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := eventsRepo.List(context.Background(), &sports.ListEventsRequestFilter{StartingWithin: durationpb.New(tt.within)}, nil, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []int64
+			for _, event := range events {
+				got = append(got, event.Id)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("ids = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("ids = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestListPagesTiedEventsOnce(t *testing.T) {
+	start := timestamppb.New(time.Date(2022, 1, 3, 12, 0, 0, 0, time.UTC))
+
+	var fixtures []*sports.Event
+	for id := int64(1); id <= 7; id++ {
+		fixtures = append(fixtures, &sports.Event{Id: id, Sport: "tennis", HomeSideName: "Home", AwaySideName: "Away", AdvertisedStartTime: start})
+	}
+
+	eventsRepo := NewEventsRepo(testutil.OpenDB(t), fieldlimit.New("events", fieldlimit.Truncate, nil), fixtures, 0, SeedUniform, time.Now)
+	if err := eventsRepo.Init(); err != nil {
+		t.Fatal(err)
+	}
+
+	orderBy := "advertised_start_time desc"
+	seen := make(map[int64]bool)
+
+	for page := (&sqlfilter.Page{Size: 2}); ; page.Offset += int64(page.Size) {
+		events, err := eventsRepo.List(context.Background(), nil, &orderBy, nil, page)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(events) == 0 {
+			break
+		}
+
+		for _, event := range events {
+			if seen[event.Id] {
+				t.Errorf("event %d listed on more than one page", event.Id)
+			}
+			seen[event.Id] = true
+		}
+	}
+
+	if len(seen) != len(fixtures) {
+		t.Errorf("listed %d events across the pages, want %d", len(seen), len(fixtures))
+	}
+}
+
+// benchSeedCount is how many random events the scratch database of the
+// benchmarks is seeded with.
+const benchSeedCount = 10000
+
+// BenchmarkGet benchmarks looking events up by ID with Get, which runs a
+// prepared primary key query, against the List filtered to the ID which Get
+// used to run, building the query each call.
+func BenchmarkGet(b *testing.B) {
+	sportsDB := testutil.OpenDB(b)
+
+	SetRandomSeed(1)
+	eventsRepo := NewEventsRepo(sportsDB, fieldlimit.New("events", fieldlimit.Truncate, nil), nil, benchSeedCount, SeedUniform, time.Now)
+	if err := eventsRepo.Init(); err != nil {
+		b.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	benchmarks := []struct {
+		name string
+		get  func(id int64) error
+	}{
+		{"Get", func(id int64) error {
+			_, err := eventsRepo.Get(ctx, id)
+			return err
+		}},
+		{"ListByID", func(id int64) error {
+			_, err := eventsRepo.List(ctx, &sports.ListEventsRequestFilter{Ids: []int64{id}}, nil, nil, nil)
+			return err
+		}},
+	}
+
+	for _, benchmark := range benchmarks {
+		b.Run(benchmark.name, func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				if err := benchmark.get(rand.Int63n(benchSeedCount) + 1); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
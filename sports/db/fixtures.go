@@ -0,0 +1,157 @@
+package db
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// LoadFixtures reads the events to seed the database with from a JSON or CSV
+// file, picked by the extension. The JSON has the same format as the
+// ListEvents response, e.g.
+//
+//	{"events": [{"id": 1, "sport": "tennis", "league": 10, ...}]}
+//
+// and the CSV has a header row naming the columns, in any order, e.g.
+//
+//	id,sport,league,home_side_name,away_side_name,visible,advertised_start_time
+//	1,tennis,10,Ash Barty,Naomi Osaka,true,2022-01-02T03:04:05Z
+//
+// Events without an id are numbered by their position in the file.
+func LoadFixtures(path string) ([]*sports.Event, error) {
+	var (
+		events []*sports.Event
+		err    error
+	)
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		events, err = loadJSONFixtures(path)
+	case ".csv":
+		events, err = loadCSVFixtures(path)
+	default:
+		return nil, fmt.Errorf("unknown fixtures file type %s, expected .json or .csv", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid fixtures file %s: %w", path, err)
+	}
+
+	for i, event := range events {
+		if event.Id == 0 {
+			event.Id = int64(i + 1)
+		}
+	}
+
+	return events, nil
+}
+
+func loadJSONFixtures(path string) ([]*sports.Event, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var fixtures sports.ListEventsResponse
+	if err := protojson.Unmarshal(data, &fixtures); err != nil {
+		return nil, err
+	}
+
+	return fixtures.Events, nil
+}
+
+// csvFields parses a CSV value into the field of an event.
+var csvFields = map[string]func(event *sports.Event, value string) error{
+	"id": func(event *sports.Event, value string) (err error) {
+		event.Id, err = strconv.ParseInt(value, 10, 64)
+		return err
+	},
+	"sport": func(event *sports.Event, value string) error {
+		event.Sport = value
+		return nil
+	},
+	"league": func(event *sports.Event, value string) (err error) {
+		event.League, err = strconv.ParseInt(value, 10, 64)
+		return err
+	},
+	"home_side_name": func(event *sports.Event, value string) error {
+		event.HomeSideName = value
+		return nil
+	},
+	"away_side_name": func(event *sports.Event, value string) error {
+		event.AwaySideName = value
+		return nil
+	},
+	"visible": func(event *sports.Event, value string) (err error) {
+		event.Visible, err = strconv.ParseBool(value)
+		return err
+	},
+	"advertised_start_time": func(event *sports.Event, value string) error {
+		start, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return err
+		}
+		event.AdvertisedStartTime, err = ptypes.TimestampProto(start)
+		return err
+	},
+}
+
+func loadCSVFixtures(path string) ([]*sports.Event, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, column := range header {
+		if _, ok := csvFields[column]; !ok {
+			return nil, fmt.Errorf("unknown column %q", column)
+		}
+	}
+
+	var events []*sports.Event
+
+	// The header is line 1.
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		var event sports.Event
+		for i, column := range header {
+			// Blank values leave the field unset.
+			if record[i] == "" {
+				continue
+			}
+			if err := csvFields[column](&event, record[i]); err != nil {
+				return nil, fmt.Errorf("line %d: invalid %s: %w", line, column, err)
+			}
+		}
+
+		events = append(events, &event)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,209 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"git.neds.sh/matty/entain/pkg/sqldialect"
+	"git.neds.sh/matty/entain/pkg/sqlfilter"
+)
+
+const leaguesTable = "leagues"
+
+// LeaguesRepo provides repository access to the leagues events are in.
+type LeaguesRepo interface {
+	// Init will create and seed the leagues table.
+	Init() error
+
+	// List will return the leagues matching the request, by name.
+	List(ctx context.Context, in *sports.ListLeaguesRequest) ([]*sports.League, error)
+	// Get will return a league by ID.
+	Get(ctx context.Context, id int64) (*sports.League, error)
+	// GetMany will return the leagues with the IDs by ID, leaving out those
+	// which don't exist.
+	GetMany(ctx context.Context, ids []int64) (map[int64]*sports.League, error)
+}
+
+type leaguesRepo struct {
+	db   *sqldialect.DB
+	init sync.Once
+}
+
+// NewLeaguesRepo creates a new leagues repository.
+func NewLeaguesRepo(db *sqldialect.DB) LeaguesRepo {
+	return &leaguesRepo{db: db}
+}
+
+// leagueColumns are the columns of the leagues table.
+var leagueColumns = []sqldialect.Column{
+	{Name: "id", Type: sqldialect.Serial},
+	{Name: "name", Type: sqldialect.Text},
+	{Name: "sport", Type: sqldialect.Text},
+	{Name: "country", Type: sqldialect.Text},
+}
+
+// seedLeagues are the leagues of the seeded events, by the league IDs used when
+// seeding.
+var seedLeagues = []*sports.League{
+	{Id: 1, Name: "Premier League", Sport: "football", Country: "England"},
+	{Id: 2, Name: "A-League Men", Sport: "football", Country: "Australia"},
+	{Id: 3, Name: "La Liga", Sport: "football", Country: "Spain"},
+	{Id: 4, Name: "Serie A", Sport: "football", Country: "Italy"},
+	{Id: 5, Name: "Bundesliga", Sport: "football", Country: "Germany"},
+	{Id: 6, Name: "Ligue 1", Sport: "football", Country: "France"},
+	{Id: 7, Name: "Eredivisie", Sport: "football", Country: "Netherlands"},
+	{Id: 8, Name: "Primeira Liga", Sport: "football", Country: "Portugal"},
+	{Id: 9, Name: "Major League Soccer", Sport: "football", Country: "United States"},
+	{Id: 10, Name: "Australian Open", Sport: "tennis", Country: "Australia"},
+	{Id: 11, Name: "Wimbledon", Sport: "tennis", Country: "England"},
+	{Id: 12, Name: "French Open", Sport: "tennis", Country: "France"},
+	{Id: 13, Name: "US Open", Sport: "tennis", Country: "United States"},
+	{Id: 14, Name: "Indian Wells Masters", Sport: "tennis", Country: "United States"},
+	{Id: 15, Name: "Miami Open", Sport: "tennis", Country: "United States"},
+	{Id: 16, Name: "Madrid Open", Sport: "tennis", Country: "Spain"},
+	{Id: 17, Name: "Italian Open", Sport: "tennis", Country: "Italy"},
+	{Id: 18, Name: "Canadian Open", Sport: "tennis", Country: "Canada"},
+	{Id: 19, Name: "Brisbane International", Sport: "tennis", Country: "Australia"},
+	{Id: 20, Name: "Australian Ice Hockey League", Sport: "hockey", Country: "Australia"},
+	{Id: 21, Name: "National Hockey League", Sport: "hockey", Country: "United States"},
+	{Id: 22, Name: "Kontinental Hockey League", Sport: "hockey", Country: "Russia"},
+	{Id: 23, Name: "Swedish Hockey League", Sport: "hockey", Country: "Sweden"},
+	{Id: 24, Name: "Liiga", Sport: "hockey", Country: "Finland"},
+	{Id: 25, Name: "Deutsche Eishockey Liga", Sport: "hockey", Country: "Germany"},
+	{Id: 26, Name: "National League", Sport: "hockey", Country: "Switzerland"},
+	{Id: 27, Name: "Czech Extraliga", Sport: "hockey", Country: "Czech Republic"},
+	{Id: 28, Name: "American Hockey League", Sport: "hockey", Country: "United States"},
+	{Id: 29, Name: "Elite Ice Hockey League", Sport: "hockey", Country: "United Kingdom"},
+	{Id: 30, Name: "ICE Hockey League", Sport: "hockey", Country: "Austria"},
+}
+
+// leagueFilterFields maps the fields of the ListLeaguesRequest onto the columns
+// they filter.
+var leagueFilterFields = sqlfilter.Filters{
+	{Name: "sports", Columns: []string{"sport"}},
+}
+
+const leaguesSelect = `SELECT id, name, sport, country FROM leagues`
+
+// Init creates the leagues table and inserts the seed leagues missing from
+// it, leaving those already there as they are.
+func (r *leaguesRepo) Init() error {
+	var err error
+
+	r.init.Do(func() {
+		err = r.seed()
+	})
+
+	return err
+}
+
+func (r *leaguesRepo) seed() error {
+	ctx := context.Background()
+
+	if err := r.db.CreateTable(ctx, leaguesTable, leagueColumns); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	batch := tx.NewBatch(leaguesTable, []string{"id", "name", "sport", "country"}, seedBatchSize)
+
+	for _, league := range seedLeagues {
+		if err := batch.Add(ctx, league.Id, league.Name, league.Sport, league.Country); err != nil {
+			return err
+		}
+	}
+
+	if err := batch.Flush(ctx); err != nil {
+		return err
+	}
+
+	if sync := r.db.Dialect.SyncSequence(leaguesTable, "id"); sync != "" {
+		if _, err := tx.ExecContext(ctx, sync); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *leaguesRepo) List(ctx context.Context, in *sports.ListLeaguesRequest) ([]*sports.League, error) {
+	query, args := leagueFilterFields.Apply(r.db.Dialect, nil, leaguesSelect, in)
+
+	rows, err := r.db.QueryContext(ctx, query+" ORDER BY name", args...)
+	if err != nil {
+		return nil, fmt.Errorf("listing leagues: %w", err)
+	}
+	defer rows.Close()
+
+	return scanLeagues(rows)
+}
+
+func (r *leaguesRepo) Get(ctx context.Context, id int64) (*sports.League, error) {
+	leagues, err := r.GetMany(ctx, []int64{id})
+	if err != nil {
+		return nil, err
+	}
+
+	league, ok := leagues[id]
+	if !ok {
+		return nil, &NotFoundError{Resource: ResourceLeague, ID: id}
+	}
+
+	return league, nil
+}
+
+func (r *leaguesRepo) GetMany(ctx context.Context, ids []int64) (map[int64]*sports.League, error) {
+	leagues := make(map[int64]*sports.League, len(ids))
+	if len(ids) == 0 {
+		return leagues, nil
+	}
+
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+
+	query := leaguesSelect + " WHERE id IN (" + strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",") + ")"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("getting leagues: %w", err)
+	}
+	defer rows.Close()
+
+	found, err := scanLeagues(rows)
+	if err != nil {
+		return nil, fmt.Errorf("getting leagues: %w", err)
+	}
+
+	for _, league := range found {
+		leagues[league.Id] = league
+	}
+
+	return leagues, nil
+}
+
+func scanLeagues(rows *sql.Rows) ([]*sports.League, error) {
+	var leagues []*sports.League
+
+	for rows.Next() {
+		var league sports.League
+
+		if err := rows.Scan(&league.Id, &league.Name, &league.Sport, &league.Country); err != nil {
+			return nil, fmt.Errorf("scanning league: %w", err)
+		}
+
+		leagues = append(leagues, &league)
+	}
+
+	return leagues, rows.Err()
+}
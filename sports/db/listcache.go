@@ -0,0 +1,134 @@
+package db
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"git.neds.sh/matty/entain/pkg/sqlfilter"
+	"git.neds.sh/matty/entain/pkg/stalecache"
+	"google.golang.org/protobuf/proto"
+)
+
+// listCachedEventsRepo memoizes the lists and counts of events in memory, as
+// the same list is asked for many times over with the same result. Every
+// list cached is dropped when an event is written, and otherwise once it is
+// older than the TTL of the cache, so statuses, and the leagues and sides
+// written apart from events, lag by at most the TTL.
+type listCachedEventsRepo struct {
+	EventsRepo
+	cache *stalecache.Cache
+}
+
+// NewListCachedEventsRepo memoizes the lists and counts of events from the
+// repository, keyed by their filter, order, sport priority and page.
+func NewListCachedEventsRepo(repo EventsRepo, cache *stalecache.Cache) EventsRepo {
+	return &listCachedEventsRepo{EventsRepo: repo, cache: cache}
+}
+
+func (r *listCachedEventsRepo) List(ctx context.Context, filter *sports.ListEventsRequestFilter, orderBy *string, sportPriority []string, page *sqlfilter.Page) ([]*sports.Event, error) {
+	key, err := stalecache.Key("List", filterOrEmpty(filter), listKey(orderBy, sportPriority, page)...)
+	if err != nil {
+		return r.EventsRepo.List(ctx, filter, orderBy, sportPriority, page)
+	}
+
+	if cached, _, ok := r.cache.Get(key); ok {
+		return proto.Clone(cached).(*sports.ListEventsResponse).Events, nil
+	}
+
+	events, err := r.EventsRepo.List(ctx, filter, orderBy, sportPriority, page)
+	if err != nil {
+		return nil, err
+	}
+
+	// The events are cloned as they are stored, as the caller is free to
+	// change them.
+	r.cache.Put(key, proto.Clone(&sports.ListEventsResponse{Events: events}))
+
+	return events, nil
+}
+
+func (r *listCachedEventsRepo) Count(ctx context.Context, filter *sports.ListEventsRequestFilter) (int64, error) {
+	key, err := stalecache.Key("Count", filterOrEmpty(filter))
+	if err != nil {
+		return r.EventsRepo.Count(ctx, filter)
+	}
+
+	if cached, _, ok := r.cache.Get(key); ok {
+		return cached.(*sports.ListEventsResponse).TotalSize, nil
+	}
+
+	count, err := r.EventsRepo.Count(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	r.cache.Put(key, &sports.ListEventsResponse{TotalSize: count})
+
+	return count, nil
+}
+
+func (r *listCachedEventsRepo) Create(ctx context.Context, event *sports.Event) (*sports.Event, error) {
+	defer r.cache.Purge()
+
+	return r.EventsRepo.Create(ctx, event)
+}
+
+func (r *listCachedEventsRepo) Update(ctx context.Context, event *sports.Event) (*sports.Event, error) {
+	defer r.cache.Purge()
+
+	return r.EventsRepo.Update(ctx, event)
+}
+
+func (r *listCachedEventsRepo) Delete(ctx context.Context, id int64) error {
+	defer r.cache.Purge()
+
+	return r.EventsRepo.Delete(ctx, id)
+}
+
+func (r *listCachedEventsRepo) SetResult(ctx context.Context, id int64, result *sports.EventResult, settledAt time.Time) (*sports.Event, error) {
+	defer r.cache.Purge()
+
+	return r.EventsRepo.SetResult(ctx, id, result, settledAt)
+}
+
+func (r *listCachedEventsRepo) Patch(ctx context.Context, event *sports.Event, paths []string) (*sports.Event, error) {
+	defer r.cache.Purge()
+
+	return r.EventsRepo.Patch(ctx, event, paths)
+}
+
+func (r *listCachedEventsRepo) SetScore(ctx context.Context, id int64, score *sports.Score, updatedAt time.Time) (*sports.Event, error) {
+	defer r.cache.Purge()
+
+	return r.EventsRepo.SetScore(ctx, id, score, updatedAt)
+}
+
+func (r *listCachedEventsRepo) HideStale(ctx context.Context, sport string, startedBefore time.Time, reason string) ([]int64, error) {
+	defer r.cache.Purge()
+
+	return r.EventsRepo.HideStale(ctx, sport, startedBefore, reason)
+}
+
+func filterOrEmpty(filter *sports.ListEventsRequestFilter) *sports.ListEventsRequestFilter {
+	if filter == nil {
+		return &sports.ListEventsRequestFilter{}
+	}
+
+	return filter
+}
+
+// listKey is what a list depends on besides its filter.
+func listKey(orderBy *string, sportPriority []string, page *sqlfilter.Page) []string {
+	key := []string{"", strings.Join(sportPriority, ","), ""}
+	if orderBy != nil {
+		key[0] = "order:" + *orderBy
+	}
+	if page != nil {
+		key[2] = strconv.Itoa(int(page.Size)) + "+" + strconv.FormatInt(page.Offset, 10)
+	}
+
+	return key
+}
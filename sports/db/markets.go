@@ -0,0 +1,237 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"git.neds.sh/matty/entain/pkg/sqldialect"
+	"github.com/golang/protobuf/ptypes"
+	"syreclabs.com/go/faker"
+)
+
+const pricesTable = "prices"
+
+// headToHead is the name of the market pricing each side of an event to win,
+// the only market events have.
+const headToHead = "Head to Head"
+
+// The sides of an event a head to head price can be for.
+const (
+	sideHome = "HOME"
+	sideAway = "AWAY"
+)
+
+// seedMargin is the bookmaker's margin built into seeded prices, the implied
+// probabilities of the sides adding up to it.
+const seedMargin = 1.05
+
+// priceColumns are the columns of the prices table, kept apart from events so
+// existing databases need no migration. Each side of an event has at most one
+// head to head price.
+var priceColumns = []sqldialect.Column{
+	{Name: "event_id", Type: sqldialect.Integer},
+	{Name: "side", Type: sqldialect.Text},
+	{Name: "price", Type: sqldialect.Real},
+	{Name: "updated_at", Type: sqldialect.Timestamp},
+}
+
+const (
+	// pricesList selects the prices of an event with the names of their
+	// sides, HOME sorting before AWAY.
+	pricesList = `
+		SELECT prices.side,
+			CASE prices.side WHEN 'HOME' THEN events.home_side_name ELSE events.away_side_name END,
+			prices.price, prices.updated_at
+		FROM prices JOIN events ON events.id = prices.event_id
+		WHERE prices.event_id = ?
+		ORDER BY prices.side DESC
+	`
+	pricesUpdate = `UPDATE prices SET price = ?, updated_at = ? WHERE event_id = ? AND side = ?`
+	pricesInsert = `INSERT INTO prices (event_id, side, price, updated_at) VALUES (?,?,?,?)`
+	pricesDelete = `DELETE FROM prices WHERE event_id = ?`
+	eventIDs     = `SELECT id FROM events ORDER BY id`
+)
+
+// createPricesTable creates the prices table, pricing every event already in
+// the database when it is new. Events added afterwards have no prices until
+// they are set.
+func (r *eventsRepo) createPricesTable() error {
+	ctx := context.Background()
+
+	columns, err := r.db.TableColumns(ctx, pricesTable)
+	if err != nil {
+		return err
+	}
+
+	if err := r.db.CreateTable(ctx, pricesTable, priceColumns); err != nil {
+		return err
+	}
+
+	if len(columns) > 0 {
+		return nil
+	}
+
+	return r.seedPrices(ctx)
+}
+
+// seedPrices gives every event fake head to head prices, drawing the chance
+// of the home side winning and pricing both sides from it with a margin.
+func (r *eventsRepo) seedPrices(ctx context.Context) error {
+	rows, err := r.db.QueryContext(ctx, eventIDs)
+	if err != nil {
+		return err
+	}
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	batch := tx.NewBatch(pricesTable, []string{"event_id", "side", "price", "updated_at"}, seedBatchSize)
+	now := time.Now()
+
+	for _, id := range ids {
+		home := float64(faker.RandomInt(20, 80)) / 100
+
+		if err := batch.Add(ctx, id, sideHome, roundPrice(1/(home*seedMargin)), now); err != nil {
+			return err
+		}
+		if err := batch.Add(ctx, id, sideAway, roundPrice(1/((1-home)*seedMargin)), now); err != nil {
+			return err
+		}
+	}
+
+	if err := batch.Flush(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// roundPrice rounds a price to the cent, as prices are displayed.
+func roundPrice(price float64) float64 {
+	return math.Round(price*100) / 100
+}
+
+// ListMarkets returns the markets of the event, none when it has no prices.
+func (r *eventsRepo) ListMarkets(ctx context.Context, eventID int64) ([]*sports.Market, error) {
+	var exists int
+	if err := r.db.QueryRowContext(ctx, getEventQueries()[eventsExists], eventID).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("listing markets of event %d: %w", eventID, err)
+	}
+	if exists == 0 {
+		return nil, &NotFoundError{Resource: ResourceEvent, ID: eventID}
+	}
+
+	rows, err := r.db.QueryContext(ctx, pricesList, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("listing markets of event %d: %w", eventID, err)
+	}
+	defer rows.Close()
+
+	prices, err := scanPrices(rows)
+	if err != nil || len(prices) == 0 {
+		return nil, fmt.Errorf("listing markets of event %d: %w", eventID, err)
+	}
+
+	return []*sports.Market{{Name: headToHead, Prices: prices}}, nil
+}
+
+// SetPrice sets the head to head price of a side of the event as updated at
+// the time, replacing any price already set.
+func (r *eventsRepo) SetPrice(ctx context.Context, eventID int64, side string, price float64, updatedAt time.Time) (*sports.Price, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("setting %s price of event %d: %w", side, eventID, err)
+	}
+	defer tx.Rollback()
+
+	var exists int
+	if err := tx.QueryRowContext(ctx, getEventQueries()[eventsExists], eventID).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("setting %s price of event %d: %w", side, eventID, err)
+	}
+	if exists == 0 {
+		return nil, &NotFoundError{Resource: ResourceEvent, ID: eventID}
+	}
+
+	updated, err := tx.ExecContext(ctx, pricesUpdate, price, updatedAt, eventID, side)
+	if err != nil {
+		return nil, fmt.Errorf("setting %s price of event %d: %w", side, eventID, err)
+	}
+
+	affected, err := updated.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("setting %s price of event %d: %w", side, eventID, err)
+	}
+
+	if affected == 0 {
+		if _, err := tx.ExecContext(ctx, pricesInsert, eventID, side, price, updatedAt); err != nil {
+			return nil, fmt.Errorf("setting %s price of event %d: %w", side, eventID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("setting %s price of event %d: %w", side, eventID, err)
+	}
+
+	event, err := r.Get(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("setting %s price of event %d: %w", side, eventID, err)
+	}
+
+	ts, err := ptypes.TimestampProto(updatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("setting %s price of event %d: %w", side, eventID, err)
+	}
+
+	name := event.HomeSideName
+	if side == sideAway {
+		name = event.AwaySideName
+	}
+
+	return &sports.Price{Side: side, Name: name, Price: price, UpdatedAt: ts}, nil
+}
+
+func scanPrices(rows *sql.Rows) ([]*sports.Price, error) {
+	var prices []*sports.Price
+
+	for rows.Next() {
+		var (
+			price     sports.Price
+			updatedAt time.Time
+		)
+
+		if err := rows.Scan(&price.Side, &price.Name, &price.Price, &updatedAt); err != nil {
+			return nil, fmt.Errorf("scanning price: %w", err)
+		}
+
+		ts, err := ptypes.TimestampProto(updatedAt)
+		if err != nil {
+			return nil, err
+		}
+		price.UpdatedAt = ts
+
+		prices = append(prices, &price)
+	}
+
+	return prices, rows.Err()
+}
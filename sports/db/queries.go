@@ -1,21 +1,143 @@
 package db
 
 const (
-	eventsList = "list"
+	eventsList   = "list"
+	eventsGet    = "get"
+	eventsCount  = "count"
+	eventsInsert = "insert"
+	eventsUpdate = "update"
+	eventsDelete = "delete"
+	eventsStale  = "stale"
+	eventsHide   = "hide"
+	eventsExists = "exists"
+	eventsSports = "sports"
+	auditInsert  = "audit_insert"
+	resultInsert = "result_insert"
+	resultUpdate = "result_update"
+	resultDelete = "result_delete"
 )
 
+// eventsFrom joins the results of events, which are null until settled, their
+// scores, null until reported, and the teams of their sides. The scores and
+// teams are joined through subqueries renaming their columns so those of
+// events and results, such as id and home_score, stay unambiguous.
+const eventsFrom = `FROM events
+	LEFT JOIN event_results ON event_results.event_id = events.id
+	LEFT JOIN (
+		SELECT event_id AS score_event_id, home_score AS live_home_score, away_score AS live_away_score, updated_at AS score_updated_at FROM event_scores
+	) scores ON score_event_id = events.id
+	LEFT JOIN (SELECT id AS home_team_id, name AS home_team_name FROM teams) home_teams ON home_team_id = events.home_side_id
+	LEFT JOIN (SELECT id AS away_team_id, name AS away_team_name FROM teams) away_teams ON away_team_id = events.away_side_id`
+
 func getEventQueries() map[string]string {
 	return map[string]string{
 		eventsList: `
 			SELECT 
 				id, 
+				sport, 
+				league, 
+				home_side_id,
+				away_side_id,
+				COALESCE(home_team_name, home_side_name) AS home_side_name,
+				COALESCE(away_team_name, away_side_name) AS away_side_name,
+				visible, 
+				advertised_start_time,
+				home_score,
+				away_score,
+				settled_at,
+				live_home_score,
+				live_away_score,
+				score_updated_at
+			` + eventsFrom + `
+		`,
+		eventsGet: `
+			SELECT 
+				id, 
+				sport, 
+				league, 
+				home_side_id,
+				away_side_id,
+				COALESCE(home_team_name, home_side_name) AS home_side_name,
+				COALESCE(away_team_name, away_side_name) AS away_side_name,
+				visible, 
+				advertised_start_time,
+				home_score,
+				away_score,
+				settled_at,
+				live_home_score,
+				live_away_score,
+				score_updated_at
+			` + eventsFrom + `
+			WHERE id = ?
+		`,
+		eventsCount: `
+			SELECT COUNT(*) ` + eventsFrom + `
+		`,
+		eventsInsert: `
+			INSERT INTO events (
 				sport, 
 				league, 
 				home_side_name, 
-				away_side_name,
+				away_side_name, 
 				visible, 
-				advertised_start_time 
-			FROM events
+				advertised_start_time,
+				home_side_id,
+				away_side_id
+			) VALUES (?,?,?,?,?,?,?,?)
+		`,
+		eventsUpdate: `
+			UPDATE events SET 
+				sport = ?, 
+				league = ?, 
+				home_side_name = ?, 
+				away_side_name = ?, 
+				visible = ?, 
+				advertised_start_time = ?,
+				home_side_id = ?,
+				away_side_id = ?
+			WHERE id = ?
+		`,
+		eventsDelete: `
+			DELETE FROM events WHERE id = ?
+		`,
+		eventsSports: `
+			SELECT sport, COUNT(*) ` + eventsFrom + `
+		`,
+		eventsExists: `
+			SELECT COUNT(*) FROM events WHERE id = ?
+		`,
+		eventsStale: `
+			SELECT id FROM events
+			WHERE sport = ? AND visible = ? AND advertised_start_time < ?
+		`,
+		eventsHide: `
+			UPDATE events SET visible = ? WHERE id = ?
+		`,
+		auditInsert: `
+			INSERT INTO event_audit (
+				event_id,
+				action,
+				reason,
+				created_at
+			) VALUES (?,?,?,?)
+		`,
+		resultInsert: `
+			INSERT INTO event_results (
+				event_id,
+				home_score,
+				away_score,
+				settled_at
+			) VALUES (?,?,?,?)
+		`,
+		resultUpdate: `
+			UPDATE event_results SET
+				home_score = ?,
+				away_score = ?,
+				settled_at = ?
+			WHERE event_id = ?
+		`,
+		resultDelete: `
+			DELETE FROM event_results WHERE event_id = ?
 		`,
 	}
 }
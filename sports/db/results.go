@@ -0,0 +1,115 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"git.neds.sh/matty/entain/pkg/changelog"
+	"git.neds.sh/matty/entain/pkg/sqldialect"
+)
+
+const resultsTable = "event_results"
+
+// changeSettle is the operation of the change recorded when the result of an
+// event is set, so settlement can be followed apart from other updates.
+const changeSettle = "SETTLE"
+
+// sideDraw is the winning side of an event whose scores are level.
+const sideDraw = "DRAW"
+
+// resultColumns are the columns of the results table, kept apart from events
+// so existing databases need no migration. Each event has at most one result,
+// keyed by its ID.
+var resultColumns = []sqldialect.Column{
+	{Name: "event_id", Type: sqldialect.Serial},
+	{Name: "home_score", Type: sqldialect.Integer},
+	{Name: "away_score", Type: sqldialect.Integer},
+	{Name: "settled_at", Type: sqldialect.Timestamp},
+}
+
+func (r *eventsRepo) createResultsTable() error {
+	return r.db.CreateTable(context.Background(), resultsTable, resultColumns)
+}
+
+// enableSettlementChanges records a settle change to an event whenever its
+// result is set.
+func (r *eventsRepo) enableSettlementChanges() error {
+	err := changelog.EnableRelated(context.Background(), r.db, eventsTable, resultsTable, "event_id", changeSettle)
+	if errors.Is(err, changelog.ErrUnsupported) {
+		log.Printf("warning: settlement of %s is not captured: %s\n", eventsTable, err)
+		return nil
+	}
+
+	return err
+}
+
+// SetResult records the result of the event as settled at the time,
+// replacing any result already recorded.
+func (r *eventsRepo) SetResult(ctx context.Context, id int64, result *sports.EventResult, settledAt time.Time) (*sports.Event, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("setting result of event %d: %w", id, err)
+	}
+	defer tx.Rollback()
+
+	var exists int
+	if err := tx.QueryRowContext(ctx, getEventQueries()[eventsExists], id).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("setting result of event %d: %w", id, err)
+	}
+	if exists == 0 {
+		return nil, &NotFoundError{Resource: ResourceEvent, ID: id}
+	}
+
+	updated, err := tx.ExecContext(ctx, getEventQueries()[resultUpdate], result.HomeScore, result.AwayScore, settledAt, id)
+	if err != nil {
+		return nil, fmt.Errorf("setting result of event %d: %w", id, err)
+	}
+
+	affected, err := updated.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("setting result of event %d: %w", id, err)
+	}
+
+	if affected == 0 {
+		if _, err := tx.ExecContext(ctx, getEventQueries()[resultInsert], id, result.HomeScore, result.AwayScore, settledAt); err != nil {
+			return nil, fmt.Errorf("setting result of event %d: %w", id, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("setting result of event %d: %w", id, err)
+	}
+
+	return r.Get(ctx, id)
+}
+
+// GetResult returns the result of the event, or a NotFoundError for the
+// result when the event hasn't been settled.
+func (r *eventsRepo) GetResult(ctx context.Context, id int64) (*sports.EventResult, error) {
+	event, err := r.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if event.Result == nil {
+		return nil, &NotFoundError{Resource: ResourceResult, ID: id}
+	}
+
+	return event.Result, nil
+}
+
+// winningSide returns the side of the event with the higher score.
+func winningSide(result *sports.EventResult) string {
+	switch {
+	case result.HomeScore > result.AwayScore:
+		return sideHome
+	case result.AwayScore > result.HomeScore:
+		return sideAway
+	}
+
+	return sideDraw
+}
@@ -0,0 +1,106 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"git.neds.sh/matty/entain/pkg/sqldialect"
+)
+
+const eventsTable = "events"
+
+// eventColumns are the columns of the events table created when seeding.
+var eventColumns = []sqldialect.Column{
+	{Name: "id", Type: sqldialect.Serial},
+	{Name: "sport", Type: sqldialect.Text},
+	{Name: "league", Type: sqldialect.Integer},
+	{Name: "home_side_name", Type: sqldialect.Text},
+	{Name: "away_side_name", Type: sqldialect.Text},
+	{Name: "visible", Type: sqldialect.Boolean},
+	{Name: "advertised_start_time", Type: sqldialect.Timestamp},
+	{Name: "home_side_id", Type: sqldialect.Integer},
+	{Name: "away_side_id", Type: sqldialect.Integer},
+}
+
+// sideColumns are the columns of the events table referencing the teams of
+// the sides, added to existing tables at startup by addSideColumns.
+var sideColumns = eventColumns[len(eventColumns)-2:]
+
+// requiredEventColumns are the columns of the events table which are read or
+// written by the repository. Keep this in sync with the queries.
+var requiredEventColumns = []string{
+	"id",
+	"sport",
+	"league",
+	"home_side_name",
+	"away_side_name",
+	"visible",
+	"advertised_start_time",
+	"home_side_id",
+	"away_side_id",
+}
+
+func (r *eventsRepo) CheckSchema(ctx context.Context) ([]*sports.TableSchema, error) {
+	columns, err := r.db.TableColumns(ctx, eventsTable)
+	if err != nil {
+		return nil, fmt.Errorf("reading columns of %s: %w", eventsTable, err)
+	}
+
+	return []*sports.TableSchema{compareColumns(eventsTable, columns, requiredEventColumns)}, nil
+}
+
+// verifySchema makes sure the events table has every column the repository
+// needs so a partially migrated database fails at startup with a useful
+// message rather than on the first query. Unknown columns are only warned
+// about as they do no harm.
+func (r *eventsRepo) verifySchema() error {
+	ctx := context.Background()
+
+	columns, err := r.db.TableColumns(ctx, eventsTable)
+	if err != nil {
+		return err
+	}
+
+	if len(columns) == 0 {
+		// A fresh database, the table will be created when seeding.
+		return nil
+	}
+
+	table := compareColumns(eventsTable, columns, requiredEventColumns)
+
+	if len(table.UnknownColumns) > 0 {
+		log.Printf("warning: %s table has unknown columns: %s\n", table.Table, strings.Join(table.UnknownColumns, ", "))
+	}
+
+	if !table.Ok {
+		return fmt.Errorf("%s table is missing columns: %s (has the database been migrated?)", table.Table, strings.Join(table.MissingColumns, ", "))
+	}
+
+	return nil
+}
+
+// compareColumns compares the columns of a table in the database against the
+// expected columns.
+func compareColumns(name string, columns map[string]bool, expected []string) *sports.TableSchema {
+	table := sports.TableSchema{Table: name}
+
+	for _, column := range expected {
+		if !columns[column] {
+			table.MissingColumns = append(table.MissingColumns, column)
+		}
+		delete(columns, column)
+	}
+
+	for column := range columns {
+		table.UnknownColumns = append(table.UnknownColumns, column)
+	}
+	sort.Strings(table.UnknownColumns)
+
+	table.Ok = len(table.MissingColumns) == 0
+
+	return &table
+}
@@ -0,0 +1,91 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"git.neds.sh/matty/entain/pkg/changelog"
+	"git.neds.sh/matty/entain/pkg/sqldialect"
+)
+
+const scoresTable = "event_scores"
+
+// changeScore is the operation of the change recorded when the score of an
+// event is updated, so scores can be followed as they change.
+const changeScore = "SCORE"
+
+// scoreColumns are the columns of the scores table, kept apart from events so
+// existing databases need no migration. Each event has at most one score,
+// keyed by its ID.
+var scoreColumns = []sqldialect.Column{
+	{Name: "event_id", Type: sqldialect.Serial},
+	{Name: "home_score", Type: sqldialect.Integer},
+	{Name: "away_score", Type: sqldialect.Integer},
+	{Name: "updated_at", Type: sqldialect.Timestamp},
+}
+
+const (
+	scoreInsert = `INSERT INTO event_scores (event_id, home_score, away_score, updated_at) VALUES (?,?,?,?)`
+	scoreUpdate = `UPDATE event_scores SET home_score = ?, away_score = ?, updated_at = ? WHERE event_id = ?`
+	scoreDelete = `DELETE FROM event_scores WHERE event_id = ?`
+)
+
+func (r *eventsRepo) createScoresTable() error {
+	return r.db.CreateTable(context.Background(), scoresTable, scoreColumns)
+}
+
+// enableScoreChanges records a score change to an event whenever its score
+// is updated.
+func (r *eventsRepo) enableScoreChanges() error {
+	err := changelog.EnableRelated(context.Background(), r.db, eventsTable, scoresTable, "event_id", changeScore)
+	if errors.Is(err, changelog.ErrUnsupported) {
+		log.Printf("warning: scores of %s are not captured: %s\n", eventsTable, err)
+		return nil
+	}
+
+	return err
+}
+
+// SetScore records the current score of the event as updated at the time,
+// replacing the score before it.
+func (r *eventsRepo) SetScore(ctx context.Context, id int64, score *sports.Score, updatedAt time.Time) (*sports.Event, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("setting score of event %d: %w", id, err)
+	}
+	defer tx.Rollback()
+
+	var exists int
+	if err := tx.QueryRowContext(ctx, getEventQueries()[eventsExists], id).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("setting score of event %d: %w", id, err)
+	}
+	if exists == 0 {
+		return nil, &NotFoundError{Resource: ResourceEvent, ID: id}
+	}
+
+	updated, err := tx.ExecContext(ctx, scoreUpdate, score.HomeScore, score.AwayScore, updatedAt, id)
+	if err != nil {
+		return nil, fmt.Errorf("setting score of event %d: %w", id, err)
+	}
+
+	affected, err := updated.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("setting score of event %d: %w", id, err)
+	}
+
+	if affected == 0 {
+		if _, err := tx.ExecContext(ctx, scoreInsert, id, score.HomeScore, score.AwayScore, updatedAt); err != nil {
+			return nil, fmt.Errorf("setting score of event %d: %w", id, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("setting score of event %d: %w", id, err)
+	}
+
+	return r.Get(ctx, id)
+}
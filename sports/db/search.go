@@ -0,0 +1,132 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"git.neds.sh/matty/entain/pkg/fulltext"
+	"git.neds.sh/matty/entain/pkg/sqlfilter"
+	"google.golang.org/protobuf/proto"
+)
+
+// searchFields maps the fields of the SearchEventsRequest onto the columns they
+// match, scanning the names of the sides for the query.
+var searchFields = sqlfilter.Filters{
+	{Name: "query", Columns: []string{"home_side_name", "away_side_name"}, Op: sqlfilter.Contains},
+	{Name: "visible", Columns: []string{"visible"}},
+}
+
+// fullTextSearchFields match the words of the query in the full-text index
+// of the names of the sides instead.
+var fullTextSearchFields = sqlfilter.Filters{
+	{Name: "query", Columns: []string{fulltext.Table(eventsTable)}, Op: sqlfilter.Matches},
+	{Name: "visible", Columns: []string{"visible"}},
+}
+
+// enableFullText indexes the names of the sides of events for Search. Databases without
+// full-text search scan the names instead.
+func (r *eventsRepo) enableFullText() error {
+	err := fulltext.Enable(context.Background(), r.db, eventsTable, []string{"home_side_name", "away_side_name"})
+	if errors.Is(err, fulltext.ErrUnsupported) {
+		log.Printf("warning: event sides are not indexed for search: %s\n", err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	r.fullText = true
+
+	return nil
+}
+
+func (r *eventsRepo) Search(ctx context.Context, in *sports.SearchEventsRequest) ([]*sports.Event, error) {
+	limit := in.GetLimit()
+	if limit <= 0 {
+		limit = sqlfilter.DefaultPageSize
+	}
+	if limit > sqlfilter.MaxPageSize {
+		limit = sqlfilter.MaxPageSize
+	}
+
+	events, err := r.searchNames(ctx, in, limit)
+	if err != nil {
+		return nil, fmt.Errorf("searching events: %w", err)
+	}
+
+	names, err := r.searchAliases(ctx, in.GetQuery())
+	if err != nil {
+		return nil, fmt.Errorf("searching events: %w", err)
+	}
+	if len(names) == 0 {
+		return events, nil
+	}
+
+	// The events of teams with a matching alias are found apart from those
+	// matching by name and merged in, as the names may not match at all.
+	orderBy := "advertised_start_time"
+	aliased, err := r.List(ctx, &sports.ListEventsRequestFilter{Sides: names, Visible: in.Visible}, &orderBy, nil, &sqlfilter.Page{Size: limit})
+	if err != nil {
+		return nil, fmt.Errorf("searching events: %w", err)
+	}
+
+	return mergeEvents(events, aliased, int(limit)), nil
+}
+
+// searchNames returns the events the names of whose sides match the query.
+func (r *eventsRepo) searchNames(ctx context.Context, in *sports.SearchEventsRequest, limit int32) ([]*sports.Event, error) {
+	fields := searchFields
+
+	if r.fullText {
+		match := fulltext.Query(in.GetQuery())
+		if match == "" {
+			return nil, nil
+		}
+
+		in = proto.Clone(in).(*sports.SearchEventsRequest)
+		in.Query = match
+		fields = fullTextSearchFields
+	}
+
+	query, args := fields.Apply(r.db.Dialect, nil, getEventQueries()[eventsList], in)
+	query += " ORDER BY advertised_start_time"
+	query = (&sqlfilter.Page{Size: limit}).Apply(query)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("searching names of sides: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanEvents(rows)
+}
+
+// mergeEvents merges two lists of events by advertised start time, dropping
+// the duplicates of the second, up to limit events.
+func mergeEvents(events, more []*sports.Event, limit int) []*sports.Event {
+	seen := make(map[int64]bool, len(events))
+	for _, event := range events {
+		seen[event.Id] = true
+	}
+
+	for _, event := range more {
+		if !seen[event.Id] {
+			seen[event.Id] = true
+			events = append(events, event)
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].AdvertisedStartTime.AsTime().Before(events[j].AdvertisedStartTime.AsTime())
+	})
+
+	if len(events) > limit {
+		events = events[:limit]
+	}
+
+	return events
+}
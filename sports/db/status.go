@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"git.neds.sh/matty/entain/pkg/sqlfilter"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// eventDurations are how long events of each sport typically last. Events
+// are live for this long after they start, events of other sports are closed
+// as soon as they start.
+var eventDurations = map[string]time.Duration{
+	"football": 2 * time.Hour,
+	"tennis":   3 * time.Hour,
+	"hockey":   150 * time.Minute,
+}
+
+// ClosesAt returns when an event of the sport starting at the time closes,
+// once it has been live for the duration of its sport.
+func ClosesAt(sport string, advertisedStart time.Time) time.Time {
+	return advertisedStart.Add(eventDurations[sport])
+}
+
+// eventStatus derives the status of an event from when it starts.
+func eventStatus(sport string, advertisedStart time.Time) string {
+	if elapsed := time.Since(advertisedStart); elapsed >= 0 && elapsed < eventDurations[sport] {
+		return sqlfilter.StatusLive
+	}
+
+	return sqlfilter.Status(advertisedStart)
+}
+
+// CountByStatus counts the settled events as finished, the others yet to
+// start as open, those of each sport started less than its duration ago as
+// live and the rest as closed, as their status is derived.
+func (r *eventsRepo) CountByStatus(ctx context.Context) (map[string]int64, error) {
+	now := time.Now()
+
+	total, err := r.Count(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	finished, err := r.Count(ctx, &sports.ListEventsRequestFilter{Settled: proto.Bool(true)})
+	if err != nil {
+		return nil, err
+	}
+
+	open, err := r.Count(ctx, &sports.ListEventsRequestFilter{AdvertisedStartAfter: timestamppb.New(now), Settled: proto.Bool(false)})
+	if err != nil {
+		return nil, err
+	}
+
+	var live int64
+	for sport, duration := range eventDurations {
+		count, err := r.Count(ctx, &sports.ListEventsRequestFilter{
+			Sports:                []string{sport},
+			AdvertisedStartAfter:  timestamppb.New(now.Add(-duration)),
+			AdvertisedStartBefore: timestamppb.New(now),
+			Settled:               proto.Bool(false),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		live += count
+	}
+
+	return map[string]int64{
+		sqlfilter.StatusOpen:     open,
+		sqlfilter.StatusLive:     live,
+		sqlfilter.StatusClosed:   total - open - live - finished,
+		sqlfilter.StatusFinished: finished,
+	}, nil
+}
@@ -0,0 +1,194 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"git.neds.sh/matty/entain/pkg/sqldialect"
+	"git.neds.sh/matty/entain/pkg/sqlfilter"
+)
+
+const teamsTable = "teams"
+
+// TeamsRepo provides repository access to the teams and players which are the
+// sides of events.
+type TeamsRepo interface {
+	// List will return the teams matching the request, by name.
+	List(ctx context.Context, in *sports.ListTeamsRequest) ([]*sports.Team, error)
+	// Get will return a team by ID.
+	Get(ctx context.Context, id int64) (*sports.Team, error)
+	// ListAliases will return the aliases of the teams with the IDs, or of
+	// every team with aliases when none are given, by team ID.
+	ListAliases(ctx context.Context, teamIDs []int64) ([]*sports.TeamAliases, error)
+	// SetAliases will replace the aliases of a team.
+	SetAliases(ctx context.Context, teamID int64, aliases []string) (*sports.TeamAliases, error)
+}
+
+type teamsRepo struct {
+	db *sqldialect.DB
+}
+
+// NewTeamsRepo creates a new teams repository. The teams table is created and
+// kept up to date by the events repository, as teams are added for the sides
+// of events written.
+func NewTeamsRepo(db *sqldialect.DB) TeamsRepo {
+	return &teamsRepo{db: db}
+}
+
+// teamColumns are the columns of the teams table. A team is identified by its
+// name within a sport, the same name in two sports being two teams.
+var teamColumns = []sqldialect.Column{
+	{Name: "id", Type: sqldialect.Serial},
+	{Name: "name", Type: sqldialect.Text},
+	{Name: "sport", Type: sqldialect.Text},
+}
+
+// teamFilterFields maps the fields of the ListTeamsRequest onto the columns
+// they filter.
+var teamFilterFields = sqlfilter.Filters{
+	{Name: "sports", Columns: []string{"sport"}},
+}
+
+const (
+	teamsSelect = `SELECT id, name, sport FROM teams`
+	teamsFind   = `SELECT id FROM teams WHERE sport = ? AND name = ? ORDER BY id LIMIT 1`
+	teamsInsert = `INSERT INTO teams (sport, name) VALUES (?,?)`
+)
+
+// backfillSides adds the teams of the sides of events missing from the teams
+// table and sets the IDs of the sides still without one, i.e. those of events
+// seeded or written before the sides had IDs.
+var backfillSides = []string{
+	`INSERT INTO teams (sport, name)
+		SELECT DISTINCT sport, home_side_name FROM events
+		WHERE home_side_id IS NULL AND NOT EXISTS (
+			SELECT 1 FROM teams WHERE teams.sport = events.sport AND teams.name = events.home_side_name
+		)`,
+	`INSERT INTO teams (sport, name)
+		SELECT DISTINCT sport, away_side_name FROM events
+		WHERE away_side_id IS NULL AND NOT EXISTS (
+			SELECT 1 FROM teams WHERE teams.sport = events.sport AND teams.name = events.away_side_name
+		)`,
+	`UPDATE events SET home_side_id = (
+			SELECT MIN(id) FROM teams WHERE teams.sport = events.sport AND teams.name = events.home_side_name
+		) WHERE home_side_id IS NULL`,
+	`UPDATE events SET away_side_id = (
+			SELECT MIN(id) FROM teams WHERE teams.sport = events.sport AND teams.name = events.away_side_name
+		) WHERE away_side_id IS NULL`,
+}
+
+func (r *eventsRepo) createTeamsTable() error {
+	return r.db.CreateTable(context.Background(), teamsTable, teamColumns)
+}
+
+// addSideColumns adds the columns referencing the teams of the sides to an
+// events table created before they existed, so the database needs no manual
+// migration. The IDs are filled in by backfillSides when seeding.
+func (r *eventsRepo) addSideColumns() error {
+	ctx := context.Background()
+
+	columns, err := r.db.TableColumns(ctx, eventsTable)
+	if err != nil {
+		return err
+	}
+
+	if len(columns) == 0 {
+		// A fresh database, the table will be created when seeding.
+		return nil
+	}
+
+	for _, column := range sideColumns {
+		if columns[column.Name] {
+			continue
+		}
+
+		statement := fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", eventsTable, column.Name, r.db.Dialect.ColumnType(column.Type))
+		if _, err := r.db.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("adding column %s to %s: %w", column.Name, eventsTable, err)
+		}
+	}
+
+	return nil
+}
+
+// sideIDs returns the IDs of the teams of the sides of an event of the sport,
+// adding the teams which don't exist yet.
+func (r *eventsRepo) sideIDs(ctx context.Context, sport, homeSideName, awaySideName string) (int64, int64, error) {
+	homeID, err := r.teamID(ctx, sport, homeSideName)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	awayID, err := r.teamID(ctx, sport, awaySideName)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return homeID, awayID, nil
+}
+
+// teamID returns the ID of the team of the sport with the name, adding it
+// when there is none.
+func (r *eventsRepo) teamID(ctx context.Context, sport, name string) (int64, error) {
+	var id int64
+
+	err := r.db.QueryRowContext(ctx, teamsFind, sport, name).Scan(&id)
+	if err == nil {
+		return id, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return 0, err
+	}
+
+	return r.db.InsertID(ctx, teamsInsert, sport, name)
+}
+
+func (r *teamsRepo) List(ctx context.Context, in *sports.ListTeamsRequest) ([]*sports.Team, error) {
+	query, args := teamFilterFields.Apply(r.db.Dialect, nil, teamsSelect, in)
+
+	rows, err := r.db.QueryContext(ctx, query+" ORDER BY name", args...)
+	if err != nil {
+		return nil, fmt.Errorf("listing teams: %w", err)
+	}
+	defer rows.Close()
+
+	return scanTeams(rows)
+}
+
+func (r *teamsRepo) Get(ctx context.Context, id int64) (*sports.Team, error) {
+	rows, err := r.db.QueryContext(ctx, teamsSelect+" WHERE id = ?", id)
+	if err != nil {
+		return nil, fmt.Errorf("getting team %d: %w", id, err)
+	}
+	defer rows.Close()
+
+	teams, err := scanTeams(rows)
+	if err != nil {
+		return nil, fmt.Errorf("getting team %d: %w", id, err)
+	}
+
+	if len(teams) == 0 {
+		return nil, &NotFoundError{Resource: ResourceTeam, ID: id}
+	}
+
+	return teams[0], nil
+}
+
+func scanTeams(rows *sql.Rows) ([]*sports.Team, error) {
+	var teams []*sports.Team
+
+	for rows.Next() {
+		var team sports.Team
+
+		if err := rows.Scan(&team.Id, &team.Name, &team.Sport); err != nil {
+			return nil, fmt.Errorf("scanning team: %w", err)
+		}
+
+		teams = append(teams, &team)
+	}
+
+	return teams, rows.Err()
+}
@@ -0,0 +1,8809 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: sports/sports.proto
+
+package sports
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ListEventsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEventsRequestMultiError, or nil if none found.
+func (m *ListEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListEventsRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CountOnly
+
+	if m.GetPageSize() < 0 {
+		err := ListEventsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPageToken()) > 256 {
+		err := ListEventsRequestValidationError{
+			field:  "PageToken",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IncludeLeague
+
+	if m.OrderBy != nil {
+
+		if utf8.RuneCountInString(m.GetOrderBy()) > 256 {
+			err := ListEventsRequestValidationError{
+				field:  "OrderBy",
+				reason: "value length must be at most 256 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEventsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListEventsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEventsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEventsRequestMultiError) AllErrors() []error { return m }
+
+// ListEventsRequestValidationError is the validation error returned by
+// ListEventsRequest.Validate if the designated constraints aren't met.
+type ListEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEventsRequestValidationError) ErrorName() string {
+	return "ListEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEventsRequestValidationError{}
+
+// Validate checks the field values on ListEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEventsResponseMultiError, or nil if none found.
+func (m *ListEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for TotalSize
+
+	if len(errors) > 0 {
+		return ListEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEventsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListEventsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEventsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEventsResponseMultiError) AllErrors() []error { return m }
+
+// ListEventsResponseValidationError is the validation error returned by
+// ListEventsResponse.Validate if the designated constraints aren't met.
+type ListEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEventsResponseValidationError) ErrorName() string {
+	return "ListEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEventsResponseValidationError{}
+
+// Validate checks the field values on ListEventsRequestFilter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListEventsRequestFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEventsRequestFilter with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEventsRequestFilterMultiError, or nil if none found.
+func (m *ListEventsRequestFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEventsRequestFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := ListEventsRequestFilterValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetAdvertisedStartAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListEventsRequestFilterValidationError{
+					field:  "AdvertisedStartAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListEventsRequestFilterValidationError{
+					field:  "AdvertisedStartAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAdvertisedStartAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListEventsRequestFilterValidationError{
+				field:  "AdvertisedStartAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAdvertisedStartBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListEventsRequestFilterValidationError{
+					field:  "AdvertisedStartBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListEventsRequestFilterValidationError{
+					field:  "AdvertisedStartBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAdvertisedStartBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListEventsRequestFilterValidationError{
+				field:  "AdvertisedStartBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if d := m.GetStartingWithin(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = ListEventsRequestFilterValidationError{
+				field:  "StartingWithin",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := ListEventsRequestFilterValidationError{
+					field:  "StartingWithin",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	for idx, item := range m.GetNotIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := ListEventsRequestFilterValidationError{
+				field:  fmt.Sprintf("NotIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	for idx, item := range m.GetMatchups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListEventsRequestFilterValidationError{
+						field:  fmt.Sprintf("Matchups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListEventsRequestFilterValidationError{
+						field:  fmt.Sprintf("Matchups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListEventsRequestFilterValidationError{
+					field:  fmt.Sprintf("Matchups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Visible != nil {
+		// no validation rules for Visible
+	}
+
+	if m.Settled != nil {
+		// no validation rules for Settled
+	}
+
+	if len(errors) > 0 {
+		return ListEventsRequestFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEventsRequestFilterMultiError is an error wrapping multiple validation
+// errors returned by ListEventsRequestFilter.ValidateAll() if the designated
+// constraints aren't met.
+type ListEventsRequestFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEventsRequestFilterMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEventsRequestFilterMultiError) AllErrors() []error { return m }
+
+// ListEventsRequestFilterValidationError is the validation error returned by
+// ListEventsRequestFilter.Validate if the designated constraints aren't met.
+type ListEventsRequestFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEventsRequestFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEventsRequestFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEventsRequestFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEventsRequestFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEventsRequestFilterValidationError) ErrorName() string {
+	return "ListEventsRequestFilterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEventsRequestFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEventsRequestFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEventsRequestFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEventsRequestFilterValidationError{}
+
+// Validate checks the field values on Matchup with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Matchup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Matchup with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MatchupMultiError, or nil if none found.
+func (m *Matchup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Matchup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetHomeSide()) < 1 {
+		err := MatchupValidationError{
+			field:  "HomeSide",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAwaySide()) < 1 {
+		err := MatchupValidationError{
+			field:  "AwaySide",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MatchupMultiError(errors)
+	}
+
+	return nil
+}
+
+// MatchupMultiError is an error wrapping multiple validation errors returned
+// by Matchup.ValidateAll() if the designated constraints aren't met.
+type MatchupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MatchupMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MatchupMultiError) AllErrors() []error { return m }
+
+// MatchupValidationError is the validation error returned by Matchup.Validate
+// if the designated constraints aren't met.
+type MatchupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MatchupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MatchupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MatchupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MatchupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MatchupValidationError) ErrorName() string { return "MatchupValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MatchupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMatchup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MatchupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MatchupValidationError{}
+
+// Validate checks the field values on GetEventRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetEventRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetEventRequestMultiError, or nil if none found.
+func (m *GetEventRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetEventRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetEventRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IncludeLeague
+
+	if len(errors) > 0 {
+		return GetEventRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetEventRequestMultiError is an error wrapping multiple validation errors
+// returned by GetEventRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetEventRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetEventRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetEventRequestMultiError) AllErrors() []error { return m }
+
+// GetEventRequestValidationError is the validation error returned by
+// GetEventRequest.Validate if the designated constraints aren't met.
+type GetEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEventRequestValidationError) ErrorName() string { return "GetEventRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEventRequestValidationError{}
+
+// Validate checks the field values on CreateEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateEventRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateEventRequestMultiError, or nil if none found.
+func (m *CreateEventRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateEventRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateEventRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateEventRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateEventRequestValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateEventRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateEventRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateEventRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateEventRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateEventRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateEventRequestMultiError) AllErrors() []error { return m }
+
+// CreateEventRequestValidationError is the validation error returned by
+// CreateEventRequest.Validate if the designated constraints aren't met.
+type CreateEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateEventRequestValidationError) ErrorName() string {
+	return "CreateEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateEventRequestValidationError{}
+
+// Validate checks the field values on UpdateEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateEventRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateEventRequestMultiError, or nil if none found.
+func (m *UpdateEventRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateEventRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateEventRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateEventRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateEventRequestValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateEventRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateEventRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateEventRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateEventRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateEventRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateEventRequestMultiError) AllErrors() []error { return m }
+
+// UpdateEventRequestValidationError is the validation error returned by
+// UpdateEventRequest.Validate if the designated constraints aren't met.
+type UpdateEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateEventRequestValidationError) ErrorName() string {
+	return "UpdateEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateEventRequestValidationError{}
+
+// Validate checks the field values on PatchEventRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PatchEventRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PatchEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PatchEventRequestMultiError, or nil if none found.
+func (m *PatchEventRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PatchEventRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PatchEventRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PatchEventRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PatchEventRequestValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PatchEventRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PatchEventRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PatchEventRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PatchEventRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PatchEventRequestMultiError is an error wrapping multiple validation errors
+// returned by PatchEventRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PatchEventRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PatchEventRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PatchEventRequestMultiError) AllErrors() []error { return m }
+
+// PatchEventRequestValidationError is the validation error returned by
+// PatchEventRequest.Validate if the designated constraints aren't met.
+type PatchEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PatchEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PatchEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PatchEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PatchEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PatchEventRequestValidationError) ErrorName() string {
+	return "PatchEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PatchEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPatchEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PatchEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PatchEventRequestValidationError{}
+
+// Validate checks the field values on DeleteEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteEventRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteEventRequestMultiError, or nil if none found.
+func (m *DeleteEventRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteEventRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := DeleteEventRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteEventRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteEventRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteEventRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteEventRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteEventRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteEventRequestMultiError) AllErrors() []error { return m }
+
+// DeleteEventRequestValidationError is the validation error returned by
+// DeleteEventRequest.Validate if the designated constraints aren't met.
+type DeleteEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteEventRequestValidationError) ErrorName() string {
+	return "DeleteEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteEventRequestValidationError{}
+
+// Validate checks the field values on SetEventResultRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetEventResultRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetEventResultRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetEventResultRequestMultiError, or nil if none found.
+func (m *SetEventResultRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetEventResultRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := SetEventResultRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetHomeScore() < 0 {
+		err := SetEventResultRequestValidationError{
+			field:  "HomeScore",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAwayScore() < 0 {
+		err := SetEventResultRequestValidationError{
+			field:  "AwayScore",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetEventResultRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetEventResultRequestMultiError is an error wrapping multiple validation
+// errors returned by SetEventResultRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetEventResultRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetEventResultRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetEventResultRequestMultiError) AllErrors() []error { return m }
+
+// SetEventResultRequestValidationError is the validation error returned by
+// SetEventResultRequest.Validate if the designated constraints aren't met.
+type SetEventResultRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetEventResultRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetEventResultRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetEventResultRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetEventResultRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetEventResultRequestValidationError) ErrorName() string {
+	return "SetEventResultRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetEventResultRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetEventResultRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetEventResultRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetEventResultRequestValidationError{}
+
+// Validate checks the field values on GetEventResultRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetEventResultRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetEventResultRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetEventResultRequestMultiError, or nil if none found.
+func (m *GetEventResultRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetEventResultRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetEventResultRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetEventResultRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetEventResultRequestMultiError is an error wrapping multiple validation
+// errors returned by GetEventResultRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetEventResultRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetEventResultRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetEventResultRequestMultiError) AllErrors() []error { return m }
+
+// GetEventResultRequestValidationError is the validation error returned by
+// GetEventResultRequest.Validate if the designated constraints aren't met.
+type GetEventResultRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEventResultRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEventResultRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEventResultRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEventResultRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEventResultRequestValidationError) ErrorName() string {
+	return "GetEventResultRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetEventResultRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEventResultRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEventResultRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEventResultRequestValidationError{}
+
+// Validate checks the field values on UpdateScoreRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateScoreRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateScoreRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateScoreRequestMultiError, or nil if none found.
+func (m *UpdateScoreRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateScoreRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdateScoreRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetHomeScore() < 0 {
+		err := UpdateScoreRequestValidationError{
+			field:  "HomeScore",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAwayScore() < 0 {
+		err := UpdateScoreRequestValidationError{
+			field:  "AwayScore",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateScoreRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateScoreRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateScoreRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateScoreRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateScoreRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateScoreRequestMultiError) AllErrors() []error { return m }
+
+// UpdateScoreRequestValidationError is the validation error returned by
+// UpdateScoreRequest.Validate if the designated constraints aren't met.
+type UpdateScoreRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateScoreRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateScoreRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateScoreRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateScoreRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateScoreRequestValidationError) ErrorName() string {
+	return "UpdateScoreRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateScoreRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateScoreRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateScoreRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateScoreRequestValidationError{}
+
+// Validate checks the field values on ListParlayRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListParlayRulesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListParlayRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListParlayRulesRequestMultiError, or nil if none found.
+func (m *ListParlayRulesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListParlayRulesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListParlayRulesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListParlayRulesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListParlayRulesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListParlayRulesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListParlayRulesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListParlayRulesRequestMultiError) AllErrors() []error { return m }
+
+// ListParlayRulesRequestValidationError is the validation error returned by
+// ListParlayRulesRequest.Validate if the designated constraints aren't met.
+type ListParlayRulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListParlayRulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListParlayRulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListParlayRulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListParlayRulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListParlayRulesRequestValidationError) ErrorName() string {
+	return "ListParlayRulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListParlayRulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListParlayRulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListParlayRulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListParlayRulesRequestValidationError{}
+
+// Validate checks the field values on ListParlayRulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListParlayRulesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListParlayRulesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListParlayRulesResponseMultiError, or nil if none found.
+func (m *ListParlayRulesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListParlayRulesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListParlayRulesResponseValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListParlayRulesResponseValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListParlayRulesResponseValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListParlayRulesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListParlayRulesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListParlayRulesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListParlayRulesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListParlayRulesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListParlayRulesResponseMultiError) AllErrors() []error { return m }
+
+// ListParlayRulesResponseValidationError is the validation error returned by
+// ListParlayRulesResponse.Validate if the designated constraints aren't met.
+type ListParlayRulesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListParlayRulesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListParlayRulesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListParlayRulesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListParlayRulesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListParlayRulesResponseValidationError) ErrorName() string {
+	return "ListParlayRulesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListParlayRulesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListParlayRulesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListParlayRulesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListParlayRulesResponseValidationError{}
+
+// Validate checks the field values on SetParlayRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetParlayRulesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetParlayRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetParlayRulesRequestMultiError, or nil if none found.
+func (m *SetParlayRulesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetParlayRulesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetParlayRulesRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetParlayRulesRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SetParlayRulesRequestValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SetParlayRulesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetParlayRulesRequestMultiError is an error wrapping multiple validation
+// errors returned by SetParlayRulesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetParlayRulesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetParlayRulesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetParlayRulesRequestMultiError) AllErrors() []error { return m }
+
+// SetParlayRulesRequestValidationError is the validation error returned by
+// SetParlayRulesRequest.Validate if the designated constraints aren't met.
+type SetParlayRulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetParlayRulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetParlayRulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetParlayRulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetParlayRulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetParlayRulesRequestValidationError) ErrorName() string {
+	return "SetParlayRulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetParlayRulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetParlayRulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetParlayRulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetParlayRulesRequestValidationError{}
+
+// Validate checks the field values on ListFeatureFlagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFeatureFlagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFeatureFlagsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFeatureFlagsRequestMultiError, or nil if none found.
+func (m *ListFeatureFlagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFeatureFlagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListFeatureFlagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFeatureFlagsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListFeatureFlagsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListFeatureFlagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFeatureFlagsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFeatureFlagsRequestMultiError) AllErrors() []error { return m }
+
+// ListFeatureFlagsRequestValidationError is the validation error returned by
+// ListFeatureFlagsRequest.Validate if the designated constraints aren't met.
+type ListFeatureFlagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFeatureFlagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFeatureFlagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFeatureFlagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFeatureFlagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFeatureFlagsRequestValidationError) ErrorName() string {
+	return "ListFeatureFlagsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFeatureFlagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFeatureFlagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFeatureFlagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFeatureFlagsRequestValidationError{}
+
+// Validate checks the field values on ListFeatureFlagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFeatureFlagsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFeatureFlagsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFeatureFlagsResponseMultiError, or nil if none found.
+func (m *ListFeatureFlagsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFeatureFlagsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFlags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListFeatureFlagsResponseValidationError{
+						field:  fmt.Sprintf("Flags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListFeatureFlagsResponseValidationError{
+						field:  fmt.Sprintf("Flags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListFeatureFlagsResponseValidationError{
+					field:  fmt.Sprintf("Flags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListFeatureFlagsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFeatureFlagsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListFeatureFlagsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListFeatureFlagsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFeatureFlagsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFeatureFlagsResponseMultiError) AllErrors() []error { return m }
+
+// ListFeatureFlagsResponseValidationError is the validation error returned by
+// ListFeatureFlagsResponse.Validate if the designated constraints aren't met.
+type ListFeatureFlagsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFeatureFlagsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFeatureFlagsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFeatureFlagsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFeatureFlagsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFeatureFlagsResponseValidationError) ErrorName() string {
+	return "ListFeatureFlagsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFeatureFlagsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFeatureFlagsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFeatureFlagsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFeatureFlagsResponseValidationError{}
+
+// Validate checks the field values on SetFeatureFlagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetFeatureFlagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetFeatureFlagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetFeatureFlagRequestMultiError, or nil if none found.
+func (m *SetFeatureFlagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetFeatureFlagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Enabled
+
+	if len(errors) > 0 {
+		return SetFeatureFlagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetFeatureFlagRequestMultiError is an error wrapping multiple validation
+// errors returned by SetFeatureFlagRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetFeatureFlagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetFeatureFlagRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetFeatureFlagRequestMultiError) AllErrors() []error { return m }
+
+// SetFeatureFlagRequestValidationError is the validation error returned by
+// SetFeatureFlagRequest.Validate if the designated constraints aren't met.
+type SetFeatureFlagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetFeatureFlagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetFeatureFlagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetFeatureFlagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetFeatureFlagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetFeatureFlagRequestValidationError) ErrorName() string {
+	return "SetFeatureFlagRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetFeatureFlagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetFeatureFlagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetFeatureFlagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetFeatureFlagRequestValidationError{}
+
+// Validate checks the field values on CheckSchemaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckSchemaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckSchemaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckSchemaRequestMultiError, or nil if none found.
+func (m *CheckSchemaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckSchemaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CheckSchemaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckSchemaRequestMultiError is an error wrapping multiple validation errors
+// returned by CheckSchemaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CheckSchemaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckSchemaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckSchemaRequestMultiError) AllErrors() []error { return m }
+
+// CheckSchemaRequestValidationError is the validation error returned by
+// CheckSchemaRequest.Validate if the designated constraints aren't met.
+type CheckSchemaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckSchemaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckSchemaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckSchemaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckSchemaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckSchemaRequestValidationError) ErrorName() string {
+	return "CheckSchemaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckSchemaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckSchemaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckSchemaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckSchemaRequestValidationError{}
+
+// Validate checks the field values on CheckSchemaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckSchemaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckSchemaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckSchemaResponseMultiError, or nil if none found.
+func (m *CheckSchemaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckSchemaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckSchemaResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckSchemaResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckSchemaResponseValidationError{
+					field:  fmt.Sprintf("Tables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CheckSchemaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckSchemaResponseMultiError is an error wrapping multiple validation
+// errors returned by CheckSchemaResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CheckSchemaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckSchemaResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckSchemaResponseMultiError) AllErrors() []error { return m }
+
+// CheckSchemaResponseValidationError is the validation error returned by
+// CheckSchemaResponse.Validate if the designated constraints aren't met.
+type CheckSchemaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckSchemaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckSchemaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckSchemaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckSchemaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckSchemaResponseValidationError) ErrorName() string {
+	return "CheckSchemaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckSchemaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckSchemaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckSchemaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckSchemaResponseValidationError{}
+
+// Validate checks the field values on ListLogSamplingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLogSamplingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLogSamplingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLogSamplingRequestMultiError, or nil if none found.
+func (m *ListLogSamplingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLogSamplingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListLogSamplingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLogSamplingRequestMultiError is an error wrapping multiple validation
+// errors returned by ListLogSamplingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListLogSamplingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLogSamplingRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLogSamplingRequestMultiError) AllErrors() []error { return m }
+
+// ListLogSamplingRequestValidationError is the validation error returned by
+// ListLogSamplingRequest.Validate if the designated constraints aren't met.
+type ListLogSamplingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLogSamplingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLogSamplingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLogSamplingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLogSamplingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLogSamplingRequestValidationError) ErrorName() string {
+	return "ListLogSamplingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLogSamplingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLogSamplingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLogSamplingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLogSamplingRequestValidationError{}
+
+// Validate checks the field values on ListLogSamplingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLogSamplingResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLogSamplingResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLogSamplingResponseMultiError, or nil if none found.
+func (m *ListLogSamplingResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLogSamplingResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListLogSamplingResponseValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListLogSamplingResponseValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListLogSamplingResponseValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListLogSamplingResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLogSamplingResponseMultiError is an error wrapping multiple validation
+// errors returned by ListLogSamplingResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListLogSamplingResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLogSamplingResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLogSamplingResponseMultiError) AllErrors() []error { return m }
+
+// ListLogSamplingResponseValidationError is the validation error returned by
+// ListLogSamplingResponse.Validate if the designated constraints aren't met.
+type ListLogSamplingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLogSamplingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLogSamplingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLogSamplingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLogSamplingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLogSamplingResponseValidationError) ErrorName() string {
+	return "ListLogSamplingResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLogSamplingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLogSamplingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLogSamplingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLogSamplingResponseValidationError{}
+
+// Validate checks the field values on SetLogSamplingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetLogSamplingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetLogSamplingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetLogSamplingRequestMultiError, or nil if none found.
+func (m *SetLogSamplingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetLogSamplingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Method
+
+	// no validation rules for Rate
+
+	if len(errors) > 0 {
+		return SetLogSamplingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetLogSamplingRequestMultiError is an error wrapping multiple validation
+// errors returned by SetLogSamplingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetLogSamplingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetLogSamplingRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetLogSamplingRequestMultiError) AllErrors() []error { return m }
+
+// SetLogSamplingRequestValidationError is the validation error returned by
+// SetLogSamplingRequest.Validate if the designated constraints aren't met.
+type SetLogSamplingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetLogSamplingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetLogSamplingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetLogSamplingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetLogSamplingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetLogSamplingRequestValidationError) ErrorName() string {
+	return "SetLogSamplingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetLogSamplingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetLogSamplingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetLogSamplingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetLogSamplingRequestValidationError{}
+
+// Validate checks the field values on ListChangesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListChangesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListChangesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListChangesRequestMultiError, or nil if none found.
+func (m *ListChangesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListChangesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AfterSeq
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListChangesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListChangesRequestMultiError is an error wrapping multiple validation errors
+// returned by ListChangesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListChangesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListChangesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListChangesRequestMultiError) AllErrors() []error { return m }
+
+// ListChangesRequestValidationError is the validation error returned by
+// ListChangesRequest.Validate if the designated constraints aren't met.
+type ListChangesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListChangesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListChangesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListChangesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListChangesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListChangesRequestValidationError) ErrorName() string {
+	return "ListChangesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListChangesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListChangesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListChangesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListChangesRequestValidationError{}
+
+// Validate checks the field values on ListChangesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListChangesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListChangesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListChangesResponseMultiError, or nil if none found.
+func (m *ListChangesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListChangesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListChangesResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListChangesResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListChangesResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for LastSeq
+
+	if len(errors) > 0 {
+		return ListChangesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListChangesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListChangesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListChangesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListChangesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListChangesResponseMultiError) AllErrors() []error { return m }
+
+// ListChangesResponseValidationError is the validation error returned by
+// ListChangesResponse.Validate if the designated constraints aren't met.
+type ListChangesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListChangesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListChangesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListChangesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListChangesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListChangesResponseValidationError) ErrorName() string {
+	return "ListChangesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListChangesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListChangesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListChangesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListChangesResponseValidationError{}
+
+// Validate checks the field values on WatchEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchEventsRequestMultiError, or nil if none found.
+func (m *WatchEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchEventsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchEventsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchEventsRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WatchEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchEventsRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchEventsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatchEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchEventsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchEventsRequestMultiError) AllErrors() []error { return m }
+
+// WatchEventsRequestValidationError is the validation error returned by
+// WatchEventsRequest.Validate if the designated constraints aren't met.
+type WatchEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchEventsRequestValidationError) ErrorName() string {
+	return "WatchEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchEventsRequestValidationError{}
+
+// Validate checks the field values on WatchChangesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchChangesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchChangesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchChangesRequestMultiError, or nil if none found.
+func (m *WatchChangesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchChangesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AfterSeq
+
+	if len(errors) > 0 {
+		return WatchChangesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchChangesRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchChangesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchChangesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchChangesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchChangesRequestMultiError) AllErrors() []error { return m }
+
+// WatchChangesRequestValidationError is the validation error returned by
+// WatchChangesRequest.Validate if the designated constraints aren't met.
+type WatchChangesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchChangesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchChangesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchChangesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchChangesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchChangesRequestValidationError) ErrorName() string {
+	return "WatchChangesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchChangesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchChangesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchChangesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchChangesRequestValidationError{}
+
+// Validate checks the field values on ListPayloadLoggingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPayloadLoggingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPayloadLoggingRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPayloadLoggingRequestMultiError, or nil if none found.
+func (m *ListPayloadLoggingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPayloadLoggingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListPayloadLoggingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPayloadLoggingRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPayloadLoggingRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListPayloadLoggingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPayloadLoggingRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPayloadLoggingRequestMultiError) AllErrors() []error { return m }
+
+// ListPayloadLoggingRequestValidationError is the validation error returned by
+// ListPayloadLoggingRequest.Validate if the designated constraints aren't met.
+type ListPayloadLoggingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPayloadLoggingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPayloadLoggingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPayloadLoggingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPayloadLoggingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPayloadLoggingRequestValidationError) ErrorName() string {
+	return "ListPayloadLoggingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPayloadLoggingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPayloadLoggingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPayloadLoggingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPayloadLoggingRequestValidationError{}
+
+// Validate checks the field values on ListPayloadLoggingResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPayloadLoggingResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPayloadLoggingResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPayloadLoggingResponseMultiError, or nil if none found.
+func (m *ListPayloadLoggingResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPayloadLoggingResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPayloadLoggingResponseValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPayloadLoggingResponseValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPayloadLoggingResponseValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPayloadLoggingResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPayloadLoggingResponseMultiError is an error wrapping multiple
+// validation errors returned by ListPayloadLoggingResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListPayloadLoggingResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPayloadLoggingResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPayloadLoggingResponseMultiError) AllErrors() []error { return m }
+
+// ListPayloadLoggingResponseValidationError is the validation error returned
+// by ListPayloadLoggingResponse.Validate if the designated constraints aren't met.
+type ListPayloadLoggingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPayloadLoggingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPayloadLoggingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPayloadLoggingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPayloadLoggingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPayloadLoggingResponseValidationError) ErrorName() string {
+	return "ListPayloadLoggingResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPayloadLoggingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPayloadLoggingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPayloadLoggingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPayloadLoggingResponseValidationError{}
+
+// Validate checks the field values on SetPayloadLoggingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetPayloadLoggingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetPayloadLoggingRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetPayloadLoggingRequestMultiError, or nil if none found.
+func (m *SetPayloadLoggingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetPayloadLoggingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Method
+
+	// no validation rules for Enabled
+
+	if all {
+		switch v := interface{}(m.GetRedact()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetPayloadLoggingRequestValidationError{
+					field:  "Redact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetPayloadLoggingRequestValidationError{
+					field:  "Redact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRedact()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetPayloadLoggingRequestValidationError{
+				field:  "Redact",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MaxBytes
+
+	if len(errors) > 0 {
+		return SetPayloadLoggingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetPayloadLoggingRequestMultiError is an error wrapping multiple validation
+// errors returned by SetPayloadLoggingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetPayloadLoggingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetPayloadLoggingRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetPayloadLoggingRequestMultiError) AllErrors() []error { return m }
+
+// SetPayloadLoggingRequestValidationError is the validation error returned by
+// SetPayloadLoggingRequest.Validate if the designated constraints aren't met.
+type SetPayloadLoggingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetPayloadLoggingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetPayloadLoggingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetPayloadLoggingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetPayloadLoggingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetPayloadLoggingRequestValidationError) ErrorName() string {
+	return "SetPayloadLoggingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetPayloadLoggingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetPayloadLoggingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetPayloadLoggingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetPayloadLoggingRequestValidationError{}
+
+// Validate checks the field values on SearchEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchEventsRequestMultiError, or nil if none found.
+func (m *SearchEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 1 || l > 256 {
+		err := SearchEventsRequestValidationError{
+			field:  "Query",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLimit() < 0 {
+		err := SearchEventsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Visible != nil {
+		// no validation rules for Visible
+	}
+
+	if len(errors) > 0 {
+		return SearchEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchEventsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchEventsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchEventsRequestMultiError) AllErrors() []error { return m }
+
+// SearchEventsRequestValidationError is the validation error returned by
+// SearchEventsRequest.Validate if the designated constraints aren't met.
+type SearchEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchEventsRequestValidationError) ErrorName() string {
+	return "SearchEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchEventsRequestValidationError{}
+
+// Validate checks the field values on SearchEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchEventsResponseMultiError, or nil if none found.
+func (m *SearchEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchEventsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchEventsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchEventsResponseMultiError) AllErrors() []error { return m }
+
+// SearchEventsResponseValidationError is the validation error returned by
+// SearchEventsResponse.Validate if the designated constraints aren't met.
+type SearchEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchEventsResponseValidationError) ErrorName() string {
+	return "SearchEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchEventsResponseValidationError{}
+
+// Validate checks the field values on ListRecentRequestsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRecentRequestsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRecentRequestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRecentRequestsRequestMultiError, or nil if none found.
+func (m *ListRecentRequestsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRecentRequestsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Method
+
+	if m.GetLimit() < 0 {
+		err := ListRecentRequestsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListRecentRequestsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRecentRequestsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRecentRequestsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListRecentRequestsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRecentRequestsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRecentRequestsRequestMultiError) AllErrors() []error { return m }
+
+// ListRecentRequestsRequestValidationError is the validation error returned by
+// ListRecentRequestsRequest.Validate if the designated constraints aren't met.
+type ListRecentRequestsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRecentRequestsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRecentRequestsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRecentRequestsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRecentRequestsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRecentRequestsRequestValidationError) ErrorName() string {
+	return "ListRecentRequestsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRecentRequestsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRecentRequestsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRecentRequestsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRecentRequestsRequestValidationError{}
+
+// Validate checks the field values on ListRecentRequestsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRecentRequestsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRecentRequestsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRecentRequestsResponseMultiError, or nil if none found.
+func (m *ListRecentRequestsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRecentRequestsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRequests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRecentRequestsResponseValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRecentRequestsResponseValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRecentRequestsResponseValidationError{
+					field:  fmt.Sprintf("Requests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRecentRequestsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRecentRequestsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListRecentRequestsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListRecentRequestsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRecentRequestsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRecentRequestsResponseMultiError) AllErrors() []error { return m }
+
+// ListRecentRequestsResponseValidationError is the validation error returned
+// by ListRecentRequestsResponse.Validate if the designated constraints aren't met.
+type ListRecentRequestsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRecentRequestsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRecentRequestsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRecentRequestsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRecentRequestsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRecentRequestsResponseValidationError) ErrorName() string {
+	return "ListRecentRequestsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRecentRequestsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRecentRequestsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRecentRequestsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRecentRequestsResponseValidationError{}
+
+// Validate checks the field values on GetOperationalSummaryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOperationalSummaryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOperationalSummaryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOperationalSummaryRequestMultiError, or nil if none found.
+func (m *GetOperationalSummaryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOperationalSummaryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetOperationalSummaryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOperationalSummaryRequestMultiError is an error wrapping multiple
+// validation errors returned by GetOperationalSummaryRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetOperationalSummaryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOperationalSummaryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOperationalSummaryRequestMultiError) AllErrors() []error { return m }
+
+// GetOperationalSummaryRequestValidationError is the validation error returned
+// by GetOperationalSummaryRequest.Validate if the designated constraints
+// aren't met.
+type GetOperationalSummaryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOperationalSummaryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOperationalSummaryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOperationalSummaryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOperationalSummaryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOperationalSummaryRequestValidationError) ErrorName() string {
+	return "GetOperationalSummaryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOperationalSummaryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOperationalSummaryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOperationalSummaryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOperationalSummaryRequestValidationError{}
+
+// Validate checks the field values on OperationalSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OperationalSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OperationalSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OperationalSummaryMultiError, or nil if none found.
+func (m *OperationalSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OperationalSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CountsByStatus
+
+	// no validation rules for UpcomingNextHour
+
+	if all {
+		switch v := interface{}(m.GetWindow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OperationalSummaryValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OperationalSummaryValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWindow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OperationalSummaryValidationError{
+				field:  "Window",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Requests
+
+	// no validation rules for Errors
+
+	// no validation rules for ErrorRate
+
+	if len(errors) > 0 {
+		return OperationalSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// OperationalSummaryMultiError is an error wrapping multiple validation errors
+// returned by OperationalSummary.ValidateAll() if the designated constraints
+// aren't met.
+type OperationalSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OperationalSummaryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OperationalSummaryMultiError) AllErrors() []error { return m }
+
+// OperationalSummaryValidationError is the validation error returned by
+// OperationalSummary.Validate if the designated constraints aren't met.
+type OperationalSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OperationalSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OperationalSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OperationalSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OperationalSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OperationalSummaryValidationError) ErrorName() string {
+	return "OperationalSummaryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OperationalSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOperationalSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OperationalSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OperationalSummaryValidationError{}
+
+// Validate checks the field values on ListLeaguesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLeaguesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLeaguesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLeaguesRequestMultiError, or nil if none found.
+func (m *ListLeaguesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLeaguesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListLeaguesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLeaguesRequestMultiError is an error wrapping multiple validation errors
+// returned by ListLeaguesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListLeaguesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLeaguesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLeaguesRequestMultiError) AllErrors() []error { return m }
+
+// ListLeaguesRequestValidationError is the validation error returned by
+// ListLeaguesRequest.Validate if the designated constraints aren't met.
+type ListLeaguesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLeaguesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLeaguesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLeaguesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLeaguesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLeaguesRequestValidationError) ErrorName() string {
+	return "ListLeaguesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLeaguesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLeaguesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLeaguesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLeaguesRequestValidationError{}
+
+// Validate checks the field values on ListLeaguesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLeaguesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLeaguesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLeaguesResponseMultiError, or nil if none found.
+func (m *ListLeaguesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLeaguesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLeagues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListLeaguesResponseValidationError{
+						field:  fmt.Sprintf("Leagues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListLeaguesResponseValidationError{
+						field:  fmt.Sprintf("Leagues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListLeaguesResponseValidationError{
+					field:  fmt.Sprintf("Leagues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListLeaguesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLeaguesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListLeaguesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListLeaguesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLeaguesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLeaguesResponseMultiError) AllErrors() []error { return m }
+
+// ListLeaguesResponseValidationError is the validation error returned by
+// ListLeaguesResponse.Validate if the designated constraints aren't met.
+type ListLeaguesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLeaguesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLeaguesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLeaguesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLeaguesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLeaguesResponseValidationError) ErrorName() string {
+	return "ListLeaguesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLeaguesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLeaguesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLeaguesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLeaguesResponseValidationError{}
+
+// Validate checks the field values on ListSportsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListSportsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSportsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSportsRequestMultiError, or nil if none found.
+func (m *ListSportsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSportsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListSportsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListSportsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListSportsRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListSportsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSportsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListSportsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListSportsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSportsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSportsRequestMultiError) AllErrors() []error { return m }
+
+// ListSportsRequestValidationError is the validation error returned by
+// ListSportsRequest.Validate if the designated constraints aren't met.
+type ListSportsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSportsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSportsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSportsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSportsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSportsRequestValidationError) ErrorName() string {
+	return "ListSportsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSportsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSportsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSportsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSportsRequestValidationError{}
+
+// Validate checks the field values on ListSportsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSportsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSportsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSportsResponseMultiError, or nil if none found.
+func (m *ListSportsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSportsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSports() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSportsResponseValidationError{
+						field:  fmt.Sprintf("Sports[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSportsResponseValidationError{
+						field:  fmt.Sprintf("Sports[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSportsResponseValidationError{
+					field:  fmt.Sprintf("Sports[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSportsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSportsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListSportsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListSportsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSportsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSportsResponseMultiError) AllErrors() []error { return m }
+
+// ListSportsResponseValidationError is the validation error returned by
+// ListSportsResponse.Validate if the designated constraints aren't met.
+type ListSportsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSportsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSportsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSportsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSportsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSportsResponseValidationError) ErrorName() string {
+	return "ListSportsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSportsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSportsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSportsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSportsResponseValidationError{}
+
+// Validate checks the field values on GetLeagueRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetLeagueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLeagueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetLeagueRequestMultiError, or nil if none found.
+func (m *GetLeagueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLeagueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetLeagueRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetLeagueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLeagueRequestMultiError is an error wrapping multiple validation errors
+// returned by GetLeagueRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetLeagueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLeagueRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLeagueRequestMultiError) AllErrors() []error { return m }
+
+// GetLeagueRequestValidationError is the validation error returned by
+// GetLeagueRequest.Validate if the designated constraints aren't met.
+type GetLeagueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLeagueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLeagueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLeagueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLeagueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLeagueRequestValidationError) ErrorName() string { return "GetLeagueRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetLeagueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLeagueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLeagueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLeagueRequestValidationError{}
+
+// Validate checks the field values on ListTeamsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTeamsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTeamsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTeamsRequestMultiError, or nil if none found.
+func (m *ListTeamsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTeamsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListTeamsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTeamsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListTeamsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListTeamsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTeamsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTeamsRequestMultiError) AllErrors() []error { return m }
+
+// ListTeamsRequestValidationError is the validation error returned by
+// ListTeamsRequest.Validate if the designated constraints aren't met.
+type ListTeamsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTeamsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTeamsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTeamsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTeamsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTeamsRequestValidationError) ErrorName() string { return "ListTeamsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTeamsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTeamsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTeamsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTeamsRequestValidationError{}
+
+// Validate checks the field values on ListTeamsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTeamsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTeamsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTeamsResponseMultiError, or nil if none found.
+func (m *ListTeamsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTeamsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTeams() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTeamsResponseValidationError{
+						field:  fmt.Sprintf("Teams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTeamsResponseValidationError{
+						field:  fmt.Sprintf("Teams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTeamsResponseValidationError{
+					field:  fmt.Sprintf("Teams[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTeamsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTeamsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListTeamsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListTeamsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTeamsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTeamsResponseMultiError) AllErrors() []error { return m }
+
+// ListTeamsResponseValidationError is the validation error returned by
+// ListTeamsResponse.Validate if the designated constraints aren't met.
+type ListTeamsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTeamsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTeamsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTeamsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTeamsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTeamsResponseValidationError) ErrorName() string {
+	return "ListTeamsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTeamsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTeamsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTeamsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTeamsResponseValidationError{}
+
+// Validate checks the field values on GetTeamRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetTeamRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTeamRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetTeamRequestMultiError,
+// or nil if none found.
+func (m *GetTeamRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTeamRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetTeamRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetTeamRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTeamRequestMultiError is an error wrapping multiple validation errors
+// returned by GetTeamRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetTeamRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTeamRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTeamRequestMultiError) AllErrors() []error { return m }
+
+// GetTeamRequestValidationError is the validation error returned by
+// GetTeamRequest.Validate if the designated constraints aren't met.
+type GetTeamRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTeamRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTeamRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTeamRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTeamRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTeamRequestValidationError) ErrorName() string { return "GetTeamRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetTeamRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTeamRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTeamRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTeamRequestValidationError{}
+
+// Validate checks the field values on ListTeamAliasesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTeamAliasesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTeamAliasesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTeamAliasesRequestMultiError, or nil if none found.
+func (m *ListTeamAliasesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTeamAliasesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTeamIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := ListTeamAliasesRequestValidationError{
+				field:  fmt.Sprintf("TeamIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTeamAliasesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTeamAliasesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTeamAliasesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTeamAliasesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTeamAliasesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTeamAliasesRequestMultiError) AllErrors() []error { return m }
+
+// ListTeamAliasesRequestValidationError is the validation error returned by
+// ListTeamAliasesRequest.Validate if the designated constraints aren't met.
+type ListTeamAliasesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTeamAliasesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTeamAliasesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTeamAliasesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTeamAliasesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTeamAliasesRequestValidationError) ErrorName() string {
+	return "ListTeamAliasesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTeamAliasesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTeamAliasesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTeamAliasesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTeamAliasesRequestValidationError{}
+
+// Validate checks the field values on ListTeamAliasesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTeamAliasesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTeamAliasesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTeamAliasesResponseMultiError, or nil if none found.
+func (m *ListTeamAliasesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTeamAliasesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTeams() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTeamAliasesResponseValidationError{
+						field:  fmt.Sprintf("Teams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTeamAliasesResponseValidationError{
+						field:  fmt.Sprintf("Teams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTeamAliasesResponseValidationError{
+					field:  fmt.Sprintf("Teams[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTeamAliasesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTeamAliasesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTeamAliasesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListTeamAliasesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTeamAliasesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTeamAliasesResponseMultiError) AllErrors() []error { return m }
+
+// ListTeamAliasesResponseValidationError is the validation error returned by
+// ListTeamAliasesResponse.Validate if the designated constraints aren't met.
+type ListTeamAliasesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTeamAliasesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTeamAliasesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTeamAliasesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTeamAliasesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTeamAliasesResponseValidationError) ErrorName() string {
+	return "ListTeamAliasesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTeamAliasesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTeamAliasesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTeamAliasesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTeamAliasesResponseValidationError{}
+
+// Validate checks the field values on SetTeamAliasesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetTeamAliasesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetTeamAliasesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetTeamAliasesRequestMultiError, or nil if none found.
+func (m *SetTeamAliasesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetTeamAliasesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetTeamId() <= 0 {
+		err := SetTeamAliasesRequestValidationError{
+			field:  "TeamId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetAliases() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 256 {
+			err := SetTeamAliasesRequestValidationError{
+				field:  fmt.Sprintf("Aliases[%v]", idx),
+				reason: "value length must be between 1 and 256 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SetTeamAliasesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetTeamAliasesRequestMultiError is an error wrapping multiple validation
+// errors returned by SetTeamAliasesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetTeamAliasesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetTeamAliasesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetTeamAliasesRequestMultiError) AllErrors() []error { return m }
+
+// SetTeamAliasesRequestValidationError is the validation error returned by
+// SetTeamAliasesRequest.Validate if the designated constraints aren't met.
+type SetTeamAliasesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetTeamAliasesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetTeamAliasesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetTeamAliasesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetTeamAliasesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetTeamAliasesRequestValidationError) ErrorName() string {
+	return "SetTeamAliasesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetTeamAliasesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetTeamAliasesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetTeamAliasesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetTeamAliasesRequestValidationError{}
+
+// Validate checks the field values on GetSportPriorityRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSportPriorityRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSportPriorityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSportPriorityRequestMultiError, or nil if none found.
+func (m *GetSportPriorityRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSportPriorityRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetSportPriorityRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSportPriorityRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSportPriorityRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetSportPriorityRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSportPriorityRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSportPriorityRequestMultiError) AllErrors() []error { return m }
+
+// GetSportPriorityRequestValidationError is the validation error returned by
+// GetSportPriorityRequest.Validate if the designated constraints aren't met.
+type GetSportPriorityRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSportPriorityRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSportPriorityRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSportPriorityRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSportPriorityRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSportPriorityRequestValidationError) ErrorName() string {
+	return "GetSportPriorityRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSportPriorityRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSportPriorityRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSportPriorityRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSportPriorityRequestValidationError{}
+
+// Validate checks the field values on SetSportPriorityRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetSportPriorityRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetSportPriorityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetSportPriorityRequestMultiError, or nil if none found.
+func (m *SetSportPriorityRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetSportPriorityRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	_SetSportPriorityRequest_Sports_Unique := make(map[string]struct{}, len(m.GetSports()))
+
+	for idx, item := range m.GetSports() {
+		_, _ = idx, item
+
+		if _, exists := _SetSportPriorityRequest_Sports_Unique[item]; exists {
+			err := SetSportPriorityRequestValidationError{
+				field:  fmt.Sprintf("Sports[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_SetSportPriorityRequest_Sports_Unique[item] = struct{}{}
+		}
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := SetSportPriorityRequestValidationError{
+				field:  fmt.Sprintf("Sports[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SetSportPriorityRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetSportPriorityRequestMultiError is an error wrapping multiple validation
+// errors returned by SetSportPriorityRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetSportPriorityRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetSportPriorityRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetSportPriorityRequestMultiError) AllErrors() []error { return m }
+
+// SetSportPriorityRequestValidationError is the validation error returned by
+// SetSportPriorityRequest.Validate if the designated constraints aren't met.
+type SetSportPriorityRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetSportPriorityRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetSportPriorityRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetSportPriorityRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetSportPriorityRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetSportPriorityRequestValidationError) ErrorName() string {
+	return "SetSportPriorityRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetSportPriorityRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetSportPriorityRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetSportPriorityRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetSportPriorityRequestValidationError{}
+
+// Validate checks the field values on GetMarketsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetMarketsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMarketsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMarketsRequestMultiError, or nil if none found.
+func (m *GetMarketsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMarketsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetEventId() <= 0 {
+		err := GetMarketsRequestValidationError{
+			field:  "EventId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetMarketsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMarketsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetMarketsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetMarketsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMarketsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMarketsRequestMultiError) AllErrors() []error { return m }
+
+// GetMarketsRequestValidationError is the validation error returned by
+// GetMarketsRequest.Validate if the designated constraints aren't met.
+type GetMarketsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMarketsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMarketsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMarketsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMarketsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMarketsRequestValidationError) ErrorName() string {
+	return "GetMarketsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMarketsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMarketsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMarketsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMarketsRequestValidationError{}
+
+// Validate checks the field values on GetMarketsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMarketsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMarketsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMarketsResponseMultiError, or nil if none found.
+func (m *GetMarketsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMarketsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMarkets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMarketsResponseValidationError{
+						field:  fmt.Sprintf("Markets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMarketsResponseValidationError{
+						field:  fmt.Sprintf("Markets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMarketsResponseValidationError{
+					field:  fmt.Sprintf("Markets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetMarketsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMarketsResponseMultiError is an error wrapping multiple validation errors
+// returned by GetMarketsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetMarketsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMarketsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMarketsResponseMultiError) AllErrors() []error { return m }
+
+// GetMarketsResponseValidationError is the validation error returned by
+// GetMarketsResponse.Validate if the designated constraints aren't met.
+type GetMarketsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMarketsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMarketsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMarketsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMarketsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMarketsResponseValidationError) ErrorName() string {
+	return "GetMarketsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMarketsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMarketsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMarketsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMarketsResponseValidationError{}
+
+// Validate checks the field values on UpdatePriceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePriceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePriceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePriceRequestMultiError, or nil if none found.
+func (m *UpdatePriceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePriceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetEventId() <= 0 {
+		err := UpdatePriceRequestValidationError{
+			field:  "EventId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UpdatePriceRequest_Side_InLookup[m.GetSide()]; !ok {
+		err := UpdatePriceRequestValidationError{
+			field:  "Side",
+			reason: "value must be in list [HOME AWAY]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPrice(); val <= 1 || val > 1000 {
+		err := UpdatePriceRequestValidationError{
+			field:  "Price",
+			reason: "value must be inside range (1, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdatePriceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePriceRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdatePriceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdatePriceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePriceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePriceRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePriceRequestValidationError is the validation error returned by
+// UpdatePriceRequest.Validate if the designated constraints aren't met.
+type UpdatePriceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePriceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePriceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePriceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePriceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePriceRequestValidationError) ErrorName() string {
+	return "UpdatePriceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePriceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePriceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePriceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePriceRequestValidationError{}
+
+var _UpdatePriceRequest_Side_InLookup = map[string]struct{}{
+	"HOME": {},
+	"AWAY": {},
+}
+
+// Validate checks the field values on Event with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Event) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Event with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in EventMultiError, or nil if none found.
+func (m *Event) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Event) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Sport
+
+	// no validation rules for League
+
+	// no validation rules for HomeSideName
+
+	// no validation rules for AwaySideName
+
+	// no validation rules for Name
+
+	// no validation rules for Visible
+
+	if all {
+		switch v := interface{}(m.GetAdvertisedStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "AdvertisedStartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "AdvertisedStartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAdvertisedStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "AdvertisedStartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Status
+
+	// no validation rules for MultiEligible
+
+	// no validation rules for MaxLegs
+
+	if all {
+		switch v := interface{}(m.GetLeagueDetails()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "LeagueDetails",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "LeagueDetails",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLeagueDetails()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "LeagueDetails",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResult()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSettledAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "SettledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "SettledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSettledAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "SettledAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for HomeSideId
+
+	// no validation rules for AwaySideId
+
+	if all {
+		switch v := interface{}(m.GetScore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "Score",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "Score",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "Score",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ResultPending
+
+	if all {
+		switch v := interface{}(m.GetEstimatedSettlementTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "EstimatedSettlementTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "EstimatedSettlementTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEstimatedSettlementTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "EstimatedSettlementTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EventMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventMultiError is an error wrapping multiple validation errors returned by
+// Event.ValidateAll() if the designated constraints aren't met.
+type EventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventMultiError) AllErrors() []error { return m }
+
+// EventValidationError is the validation error returned by Event.Validate if
+// the designated constraints aren't met.
+type EventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventValidationError) ErrorName() string { return "EventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventValidationError{}
+
+// Validate checks the field values on Score with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Score) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Score with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ScoreMultiError, or nil if none found.
+func (m *Score) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Score) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HomeScore
+
+	// no validation rules for AwayScore
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScoreValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScoreValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScoreValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ScoreMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScoreMultiError is an error wrapping multiple validation errors returned by
+// Score.ValidateAll() if the designated constraints aren't met.
+type ScoreMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScoreMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScoreMultiError) AllErrors() []error { return m }
+
+// ScoreValidationError is the validation error returned by Score.Validate if
+// the designated constraints aren't met.
+type ScoreValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScoreValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScoreValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScoreValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScoreValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScoreValidationError) ErrorName() string { return "ScoreValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScoreValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScore.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScoreValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScoreValidationError{}
+
+// Validate checks the field values on EventResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EventResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EventResultMultiError, or
+// nil if none found.
+func (m *EventResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HomeScore
+
+	// no validation rules for AwayScore
+
+	// no validation rules for WinningSide
+
+	if len(errors) > 0 {
+		return EventResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventResultMultiError is an error wrapping multiple validation errors
+// returned by EventResult.ValidateAll() if the designated constraints aren't met.
+type EventResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventResultMultiError) AllErrors() []error { return m }
+
+// EventResultValidationError is the validation error returned by
+// EventResult.Validate if the designated constraints aren't met.
+type EventResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventResultValidationError) ErrorName() string { return "EventResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventResultValidationError{}
+
+// Validate checks the field values on Sport with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Sport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Sport with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SportMultiError, or nil if none found.
+func (m *Sport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Sport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for EventCount
+
+	if len(errors) > 0 {
+		return SportMultiError(errors)
+	}
+
+	return nil
+}
+
+// SportMultiError is an error wrapping multiple validation errors returned by
+// Sport.ValidateAll() if the designated constraints aren't met.
+type SportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SportMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SportMultiError) AllErrors() []error { return m }
+
+// SportValidationError is the validation error returned by Sport.Validate if
+// the designated constraints aren't met.
+type SportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SportValidationError) ErrorName() string { return "SportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SportValidationError{}
+
+// Validate checks the field values on League with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *League) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on League with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in LeagueMultiError, or nil if none found.
+func (m *League) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *League) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Sport
+
+	// no validation rules for Country
+
+	if len(errors) > 0 {
+		return LeagueMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeagueMultiError is an error wrapping multiple validation errors returned by
+// League.ValidateAll() if the designated constraints aren't met.
+type LeagueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeagueMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeagueMultiError) AllErrors() []error { return m }
+
+// LeagueValidationError is the validation error returned by League.Validate if
+// the designated constraints aren't met.
+type LeagueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeagueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeagueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeagueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeagueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeagueValidationError) ErrorName() string { return "LeagueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LeagueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeague.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeagueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeagueValidationError{}
+
+// Validate checks the field values on Team with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Team) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Team with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TeamMultiError, or nil if none found.
+func (m *Team) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Team) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Sport
+
+	if len(errors) > 0 {
+		return TeamMultiError(errors)
+	}
+
+	return nil
+}
+
+// TeamMultiError is an error wrapping multiple validation errors returned by
+// Team.ValidateAll() if the designated constraints aren't met.
+type TeamMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TeamMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TeamMultiError) AllErrors() []error { return m }
+
+// TeamValidationError is the validation error returned by Team.Validate if the
+// designated constraints aren't met.
+type TeamValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TeamValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TeamValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TeamValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TeamValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TeamValidationError) ErrorName() string { return "TeamValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TeamValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTeam.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TeamValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TeamValidationError{}
+
+// Validate checks the field values on SportPriority with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SportPriority) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SportPriority with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SportPriorityMultiError, or
+// nil if none found.
+func (m *SportPriority) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SportPriority) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SportPriorityMultiError(errors)
+	}
+
+	return nil
+}
+
+// SportPriorityMultiError is an error wrapping multiple validation errors
+// returned by SportPriority.ValidateAll() if the designated constraints
+// aren't met.
+type SportPriorityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SportPriorityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SportPriorityMultiError) AllErrors() []error { return m }
+
+// SportPriorityValidationError is the validation error returned by
+// SportPriority.Validate if the designated constraints aren't met.
+type SportPriorityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SportPriorityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SportPriorityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SportPriorityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SportPriorityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SportPriorityValidationError) ErrorName() string { return "SportPriorityValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SportPriorityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSportPriority.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SportPriorityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SportPriorityValidationError{}
+
+// Validate checks the field values on TeamAliases with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TeamAliases) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TeamAliases with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TeamAliasesMultiError, or
+// nil if none found.
+func (m *TeamAliases) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TeamAliases) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TeamId
+
+	if len(errors) > 0 {
+		return TeamAliasesMultiError(errors)
+	}
+
+	return nil
+}
+
+// TeamAliasesMultiError is an error wrapping multiple validation errors
+// returned by TeamAliases.ValidateAll() if the designated constraints aren't met.
+type TeamAliasesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TeamAliasesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TeamAliasesMultiError) AllErrors() []error { return m }
+
+// TeamAliasesValidationError is the validation error returned by
+// TeamAliases.Validate if the designated constraints aren't met.
+type TeamAliasesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TeamAliasesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TeamAliasesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TeamAliasesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TeamAliasesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TeamAliasesValidationError) ErrorName() string { return "TeamAliasesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TeamAliasesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTeamAliases.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TeamAliasesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TeamAliasesValidationError{}
+
+// Validate checks the field values on Market with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Market) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Market with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MarketMultiError, or nil if none found.
+func (m *Market) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Market) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	for idx, item := range m.GetPrices() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MarketValidationError{
+						field:  fmt.Sprintf("Prices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MarketValidationError{
+						field:  fmt.Sprintf("Prices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MarketValidationError{
+					field:  fmt.Sprintf("Prices[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MarketMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarketMultiError is an error wrapping multiple validation errors returned by
+// Market.ValidateAll() if the designated constraints aren't met.
+type MarketMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarketMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarketMultiError) AllErrors() []error { return m }
+
+// MarketValidationError is the validation error returned by Market.Validate if
+// the designated constraints aren't met.
+type MarketValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarketValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarketValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarketValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarketValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarketValidationError) ErrorName() string { return "MarketValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MarketValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarket.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarketValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarketValidationError{}
+
+// Validate checks the field values on Price with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Price) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Price with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PriceMultiError, or nil if none found.
+func (m *Price) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Price) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Side
+
+	// no validation rules for Name
+
+	// no validation rules for Price
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PriceValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PriceValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PriceValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PriceMultiError(errors)
+	}
+
+	return nil
+}
+
+// PriceMultiError is an error wrapping multiple validation errors returned by
+// Price.ValidateAll() if the designated constraints aren't met.
+type PriceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PriceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PriceMultiError) AllErrors() []error { return m }
+
+// PriceValidationError is the validation error returned by Price.Validate if
+// the designated constraints aren't met.
+type PriceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PriceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PriceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PriceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PriceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PriceValidationError) ErrorName() string { return "PriceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PriceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPrice.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PriceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PriceValidationError{}
+
+// Validate checks the field values on ParlayRule with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ParlayRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ParlayRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ParlayRuleMultiError, or
+// nil if none found.
+func (m *ParlayRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ParlayRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sport
+
+	// no validation rules for MultiEligible
+
+	// no validation rules for MaxLegs
+
+	if len(errors) > 0 {
+		return ParlayRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// ParlayRuleMultiError is an error wrapping multiple validation errors
+// returned by ParlayRule.ValidateAll() if the designated constraints aren't met.
+type ParlayRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ParlayRuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ParlayRuleMultiError) AllErrors() []error { return m }
+
+// ParlayRuleValidationError is the validation error returned by
+// ParlayRule.Validate if the designated constraints aren't met.
+type ParlayRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ParlayRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ParlayRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ParlayRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ParlayRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ParlayRuleValidationError) ErrorName() string { return "ParlayRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ParlayRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sParlayRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ParlayRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ParlayRuleValidationError{}
+
+// Validate checks the field values on TableSchema with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TableSchema) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TableSchema with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TableSchemaMultiError, or
+// nil if none found.
+func (m *TableSchema) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TableSchema) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Table
+
+	// no validation rules for Ok
+
+	if len(errors) > 0 {
+		return TableSchemaMultiError(errors)
+	}
+
+	return nil
+}
+
+// TableSchemaMultiError is an error wrapping multiple validation errors
+// returned by TableSchema.ValidateAll() if the designated constraints aren't met.
+type TableSchemaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TableSchemaMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TableSchemaMultiError) AllErrors() []error { return m }
+
+// TableSchemaValidationError is the validation error returned by
+// TableSchema.Validate if the designated constraints aren't met.
+type TableSchemaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TableSchemaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TableSchemaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TableSchemaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TableSchemaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TableSchemaValidationError) ErrorName() string { return "TableSchemaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TableSchemaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTableSchema.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TableSchemaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TableSchemaValidationError{}
+
+// Validate checks the field values on FeatureFlag with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FeatureFlag) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FeatureFlag with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FeatureFlagMultiError, or
+// nil if none found.
+func (m *FeatureFlag) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FeatureFlag) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Enabled
+
+	if len(errors) > 0 {
+		return FeatureFlagMultiError(errors)
+	}
+
+	return nil
+}
+
+// FeatureFlagMultiError is an error wrapping multiple validation errors
+// returned by FeatureFlag.ValidateAll() if the designated constraints aren't met.
+type FeatureFlagMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FeatureFlagMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FeatureFlagMultiError) AllErrors() []error { return m }
+
+// FeatureFlagValidationError is the validation error returned by
+// FeatureFlag.Validate if the designated constraints aren't met.
+type FeatureFlagValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FeatureFlagValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FeatureFlagValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FeatureFlagValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FeatureFlagValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FeatureFlagValidationError) ErrorName() string { return "FeatureFlagValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FeatureFlagValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFeatureFlag.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FeatureFlagValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FeatureFlagValidationError{}
+
+// Validate checks the field values on LogSamplingRule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LogSamplingRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LogSamplingRule with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LogSamplingRuleMultiError, or nil if none found.
+func (m *LogSamplingRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LogSamplingRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Method
+
+	// no validation rules for Rate
+
+	if len(errors) > 0 {
+		return LogSamplingRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// LogSamplingRuleMultiError is an error wrapping multiple validation errors
+// returned by LogSamplingRule.ValidateAll() if the designated constraints
+// aren't met.
+type LogSamplingRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogSamplingRuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogSamplingRuleMultiError) AllErrors() []error { return m }
+
+// LogSamplingRuleValidationError is the validation error returned by
+// LogSamplingRule.Validate if the designated constraints aren't met.
+type LogSamplingRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogSamplingRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogSamplingRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogSamplingRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogSamplingRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogSamplingRuleValidationError) ErrorName() string { return "LogSamplingRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogSamplingRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogSamplingRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogSamplingRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogSamplingRuleValidationError{}
+
+// Validate checks the field values on Change with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Change) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Change with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ChangeMultiError, or nil if none found.
+func (m *Change) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Change) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Seq
+
+	// no validation rules for EventId
+
+	// no validation rules for Operation
+
+	if all {
+		switch v := interface{}(m.GetChangedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChangeValidationError{
+					field:  "ChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChangeValidationError{
+					field:  "ChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChangedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChangeValidationError{
+				field:  "ChangedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangeMultiError is an error wrapping multiple validation errors returned by
+// Change.ValidateAll() if the designated constraints aren't met.
+type ChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangeMultiError) AllErrors() []error { return m }
+
+// ChangeValidationError is the validation error returned by Change.Validate if
+// the designated constraints aren't met.
+type ChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangeValidationError) ErrorName() string { return "ChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangeValidationError{}
+
+// Validate checks the field values on PayloadLoggingRule with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PayloadLoggingRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PayloadLoggingRule with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PayloadLoggingRuleMultiError, or nil if none found.
+func (m *PayloadLoggingRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PayloadLoggingRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Method
+
+	// no validation rules for Enabled
+
+	if all {
+		switch v := interface{}(m.GetRedact()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PayloadLoggingRuleValidationError{
+					field:  "Redact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PayloadLoggingRuleValidationError{
+					field:  "Redact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRedact()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PayloadLoggingRuleValidationError{
+				field:  "Redact",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MaxBytes
+
+	if len(errors) > 0 {
+		return PayloadLoggingRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// PayloadLoggingRuleMultiError is an error wrapping multiple validation errors
+// returned by PayloadLoggingRule.ValidateAll() if the designated constraints
+// aren't met.
+type PayloadLoggingRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PayloadLoggingRuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PayloadLoggingRuleMultiError) AllErrors() []error { return m }
+
+// PayloadLoggingRuleValidationError is the validation error returned by
+// PayloadLoggingRule.Validate if the designated constraints aren't met.
+type PayloadLoggingRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PayloadLoggingRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PayloadLoggingRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PayloadLoggingRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PayloadLoggingRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PayloadLoggingRuleValidationError) ErrorName() string {
+	return "PayloadLoggingRuleValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PayloadLoggingRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPayloadLoggingRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PayloadLoggingRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PayloadLoggingRuleValidationError{}
+
+// Validate checks the field values on RecentRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RecentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecentRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RecentRequestMultiError, or
+// nil if none found.
+func (m *RecentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Method
+
+	// no validation rules for Code
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecentRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecentRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecentRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLatency()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecentRequestValidationError{
+					field:  "Latency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecentRequestValidationError{
+					field:  "Latency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLatency()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecentRequestValidationError{
+				field:  "Latency",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RecentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecentRequestMultiError is an error wrapping multiple validation errors
+// returned by RecentRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RecentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecentRequestMultiError) AllErrors() []error { return m }
+
+// RecentRequestValidationError is the validation error returned by
+// RecentRequest.Validate if the designated constraints aren't met.
+type RecentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecentRequestValidationError) ErrorName() string { return "RecentRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RecentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecentRequestValidationError{}
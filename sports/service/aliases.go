@@ -0,0 +1,19 @@
+package service
+
+import (
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"golang.org/x/net/context"
+)
+
+func (s *sportsService) ListTeamAliases(ctx context.Context, in *sports.ListTeamAliasesRequest) (*sports.ListTeamAliasesResponse, error) {
+	teams, err := s.teamsRepo.ListAliases(ctx, in.TeamIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sports.ListTeamAliasesResponse{Teams: teams}, nil
+}
+
+func (s *sportsService) SetTeamAliases(ctx context.Context, in *sports.SetTeamAliasesRequest) (*sports.TeamAliases, error) {
+	return s.teamsRepo.SetAliases(ctx, in.TeamId, in.Aliases)
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"time"
+
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"git.neds.sh/matty/entain/pkg/sqlfilter"
+	"golang.org/x/net/context"
+)
+
+// changesPollInterval is how often WatchChanges checks for new changes.
+const changesPollInterval = time.Second
+
+func (s *sportsService) ListChanges(ctx context.Context, in *sports.ListChangesRequest) (*sports.ListChangesResponse, error) {
+	changes, err := s.eventsRepo.ListChanges(ctx, in.AfterSeq, in.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	lastSeq := in.AfterSeq
+	if len(changes) > 0 {
+		lastSeq = changes[len(changes)-1].Seq
+	}
+
+	return &sports.ListChangesResponse{Changes: changes, LastSeq: lastSeq}, nil
+}
+
+// WatchChanges tails the changes, each subscriber polls from its own
+// position so none can hold up another.
+func (s *sportsService) WatchChanges(in *sports.WatchChangesRequest, stream sports.Sports_WatchChangesServer) error {
+	ctx := stream.Context()
+
+	ticker := time.NewTicker(changesPollInterval)
+	defer ticker.Stop()
+
+	afterSeq := in.AfterSeq
+
+	for {
+		changes, err := s.eventsRepo.ListChanges(ctx, afterSeq, sqlfilter.MaxPageSize)
+		if err != nil {
+			return err
+		}
+
+		for _, change := range changes {
+			if err := stream.Send(change); err != nil {
+				return err
+			}
+			afterSeq = change.Seq
+		}
+
+		// Keep reading while there is a backlog.
+		if len(changes) == sqlfilter.MaxPageSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"errors"
+	"strconv"
+
+	"git.neds.sh/jmassey/entain/sports/db"
+	"git.neds.sh/matty/entain/pkg/errorreason"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// ErrorStatus maps the errors of the repositories of the service onto their
+// statuses, for errstatus.ToStatus.
+func ErrorStatus(err error) error {
+	var (
+		validationErr *db.ValidationError
+		notFoundErr   *db.NotFoundError
+	)
+
+	switch {
+	case errors.As(err, &notFoundErr):
+		return notFoundStatus(notFoundErr)
+	case errors.Is(err, db.ErrNotFound):
+		return errorreason.NotFound.Error(db.ErrNotFound.Error(), nil)
+	case errors.As(err, &validationErr):
+		return errorreason.InvalidValue.Error(validationErr.Error(), nil)
+	}
+
+	return nil
+}
+
+// resourceTypes are the message types of the resources of the service.
+var resourceTypes = map[string]string{
+	db.ResourceEvent:  "sports.Event",
+	db.ResourceLeague: "sports.League",
+	db.ResourceTeam:   "sports.Team",
+	db.ResourceResult: "sports.EventResult",
+}
+
+// notFoundStatus reports the missing resource in the status details as well
+// as the message, so callers don't need to parse the message for the ID.
+func notFoundStatus(err *db.NotFoundError) error {
+	return errorreason.NotFound.Error(err.Error(), nil, &errdetails.ResourceInfo{
+		ResourceType: resourceTypes[err.Resource],
+		ResourceName: strconv.FormatInt(err.ID, 10),
+		Description:  err.Error(),
+	})
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"git.neds.sh/matty/entain/pkg/errorreason"
+	"git.neds.sh/matty/entain/pkg/errstatus"
+	"git.neds.sh/matty/entain/pkg/testutil"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// TestDatabaseErrorsDontLeak checks the errors of the database reach callers
+// over gRPC as INTERNAL with a generic message, never with the query or the
+// message of the driver, which are only logged.
+func TestDatabaseErrorsDontLeak(t *testing.T) {
+	db := testutil.OpenDB(t)
+
+	// A real error of the driver, naming the table and column queried.
+	_, driverErr := db.QueryContext(context.Background(), "SELECT secret_column FROM missing_events WHERE id = ?", 1)
+	if driverErr == nil {
+		t.Fatal("querying a missing table succeeded")
+	}
+
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{name: "driver", err: driverErr},
+		{name: "wrapped driver", err: fmt.Errorf("listing events filtered by sports,visible: %w", driverErr)},
+		{name: "wrapped twice", err: fmt.Errorf("getting event: %w", fmt.Errorf("scanning event: %w", driverErr))},
+		{name: "connection", err: fmt.Errorf("listing events filtered by none: SELECT id FROM events: %w", sql.ErrConnDone)},
+	}
+
+	leaks := []string{"SELECT", "secret_column", "missing_events", "no such table", "sql:", "listing events"}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			core, logs := observer.New(zap.ErrorLevel)
+			conn := testutil.Dial(t, func(s *grpc.Server) {
+				sports.RegisterSportsServer(s, &failingSports{err: tt.err})
+			}, grpc.UnaryInterceptor(errstatus.NewUnaryInterceptor(zap.New(core), ErrorStatus)))
+
+			_, err := sports.NewSportsClient(conn).ListEvents(context.Background(), &sports.ListEventsRequest{})
+
+			st, ok := status.FromError(err)
+			if !ok {
+				t.Fatalf("error %v is not a status", err)
+			}
+
+			if st.Code() != codes.Internal {
+				t.Errorf("code = %s, want Internal", st.Code())
+			}
+			if st.Message() != "internal error" {
+				t.Errorf("message = %q, want %q", st.Message(), "internal error")
+			}
+			if reason := statusReason(st); reason != errorreason.Internal.Reason {
+				t.Errorf("reason = %q, want %q", reason, errorreason.Internal.Reason)
+			}
+
+			rendered, err := protojson.Marshal(st.Proto())
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, leak := range leaks {
+				if strings.Contains(string(rendered), leak) {
+					t.Errorf("status %s contains %q", rendered, leak)
+				}
+			}
+
+			if logs.Len() != 1 {
+				t.Fatalf("logged %d lines, want 1", logs.Len())
+			}
+			if logged := logs.All()[0].ContextMap()["error"]; !strings.Contains(fmt.Sprint(logged), tt.err.Error()) {
+				t.Errorf("logged error %q, want %q", logged, tt.err)
+			}
+		})
+	}
+}
+
+// failingSports fails to list events with err.
+type failingSports struct {
+	sports.UnimplementedSportsServer
+
+	err error
+}
+
+func (s *failingSports) ListEvents(context.Context, *sports.ListEventsRequest) (*sports.ListEventsResponse, error) {
+	return nil, s.err
+}
+
+func statusReason(st *status.Status) string {
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info.Reason
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"strconv"
+
+	"git.neds.sh/matty/entain/pkg/logging"
+	"git.neds.sh/matty/entain/pkg/stalecache"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// staleAgeMetadataKey is the response header giving the age in seconds of a
+// stale response served in place of an error.
+const staleAgeMetadataKey = "x-stale-age"
+
+// fallbackMethods are the hot list RPCs whose last responses are served when
+// they fail.
+var fallbackMethods = map[string]bool{
+	"/sports.Sports/ListEvents": true,
+}
+
+// fallbackCodes are the codes of the failures of the server, such as the
+// database erroring, which are answered with a stale response. Failures of
+// the request are returned as they are.
+var fallbackCodes = map[codes.Code]bool{
+	codes.Unknown:          true,
+	codes.Internal:         true,
+	codes.Unavailable:      true,
+	codes.DeadlineExceeded: true,
+}
+
+// NewUnaryFallbackInterceptor keeps the last response to each request of the
+// fallback methods, and serves it when the request fails with a server error
+// while it is young enough for the cache, giving its age in the
+// x-stale-age header. The lists are more useful stale than missing. It goes
+// before the error interceptor in the chain so it sees the final status.
+// Nothing is cached when cache is nil.
+func NewUnaryFallbackInterceptor(cache *stalecache.Cache, logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		request, ok := req.(proto.Message)
+		if cache == nil || !fallbackMethods[info.FullMethod] || !ok {
+			return handler(ctx, req)
+		}
+
+		// The default ordering of the events depends on the experiment
+		// variant of the caller.
+		key, err := stalecache.Key(info.FullMethod, request, experimentVariant(ctx, experimentDefaultOrdering))
+		if err != nil {
+			return handler(ctx, req)
+		}
+
+		resp, err := handler(ctx, req)
+		if err == nil {
+			if response, ok := resp.(proto.Message); ok {
+				cache.Put(key, response)
+			}
+			return resp, nil
+		}
+
+		if !fallbackCodes[status.Code(err)] {
+			return nil, err
+		}
+
+		cached, age, ok := cache.Get(key)
+		if !ok {
+			return nil, err
+		}
+
+		if headerErr := grpc.SetHeader(ctx, metadata.Pairs(staleAgeMetadataKey, strconv.Itoa(int(age.Seconds())))); headerErr != nil {
+			return nil, err
+		}
+
+		logging.ForRequest(ctx, logger).Warn("serving stale response", zap.String("method", info.FullMethod), zap.Duration("age", age), zap.Error(err))
+
+		return cached, nil
+	}
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"git.neds.sh/matty/entain/pkg/featureflag"
+	"golang.org/x/net/context"
+)
+
+// Feature flags gating behaviour of the sports service.
+const (
+	// FlagParlayRules enables deciding the multi eligibility of events from
+	// the parlay rules, when off no events are eligible.
+	FlagParlayRules = "parlay_rules"
+	// FlagWatchEvents enables the WatchEvents stream.
+	FlagWatchEvents = "watch_events"
+)
+
+// NewFeatureFlags creates the sports feature flags in their default states.
+func NewFeatureFlags() *featureflag.Flags {
+	return featureflag.New(map[string]bool{
+		FlagParlayRules: true,
+		FlagWatchEvents: true,
+	})
+}
+
+func (s *sportsService) ListFeatureFlags(ctx context.Context, in *sports.ListFeatureFlagsRequest) (*sports.ListFeatureFlagsResponse, error) {
+	var flags []*sports.FeatureFlag
+
+	for _, name := range s.flags.Names() {
+		flags = append(flags, &sports.FeatureFlag{Name: name, Enabled: s.flags.Enabled(name)})
+	}
+
+	return &sports.ListFeatureFlagsResponse{Flags: flags}, nil
+}
+
+func (s *sportsService) SetFeatureFlag(ctx context.Context, in *sports.SetFeatureFlagRequest) (*sports.FeatureFlag, error) {
+	if err := s.flags.Set(in.Name, in.Enabled); err != nil {
+		return nil, err
+	}
+
+	return &sports.FeatureFlag{Name: in.Name, Enabled: s.flags.Enabled(in.Name)}, nil
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"golang.org/x/net/context"
+)
+
+func (s *sportsService) ListLeagues(ctx context.Context, in *sports.ListLeaguesRequest) (*sports.ListLeaguesResponse, error) {
+	leagues, err := s.leaguesRepo.List(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sports.ListLeaguesResponse{Leagues: leagues}, nil
+}
+
+func (s *sportsService) GetLeague(ctx context.Context, in *sports.GetLeagueRequest) (*sports.League, error) {
+	return s.leaguesRepo.Get(ctx, in.Id)
+}
+
+// embedLeagues sets the league details of the events, looking up each league
+// once. Events of leagues which don't exist are left without details.
+func (s *sportsService) embedLeagues(ctx context.Context, events []*sports.Event) error {
+	var ids []int64
+	seen := make(map[int64]bool)
+	for _, event := range events {
+		if !seen[event.League] {
+			seen[event.League] = true
+			ids = append(ids, event.League)
+		}
+	}
+
+	leagues, err := s.leaguesRepo.GetMany(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		event.LeagueDetails = leagues[event.League]
+	}
+
+	return nil
+}
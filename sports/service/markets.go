@@ -0,0 +1,21 @@
+package service
+
+import (
+	"time"
+
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"golang.org/x/net/context"
+)
+
+func (s *sportsService) GetMarkets(ctx context.Context, in *sports.GetMarketsRequest) (*sports.GetMarketsResponse, error) {
+	markets, err := s.eventsRepo.ListMarkets(ctx, in.EventId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sports.GetMarketsResponse{Markets: markets}, nil
+}
+
+func (s *sportsService) UpdatePrice(ctx context.Context, in *sports.UpdatePriceRequest) (*sports.Price, error) {
+	return s.eventsRepo.SetPrice(ctx, in.EventId, in.Side, in.Price, time.Now())
+}
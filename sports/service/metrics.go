@@ -0,0 +1,49 @@
+package service
+
+import (
+	"time"
+
+	"git.neds.sh/matty/entain/pkg/metrics"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// NewUnaryMetricsInterceptor records the count and latency of unary requests
+// by method and status, and keeps each with the fields it set in the request
+// log. It must come before the error interceptor in the chain to see the
+// final status.
+func NewUnaryMetricsInterceptor(rpc *metrics.RPC, requestLog *metrics.RequestLog) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		code, elapsed := status.Code(err).String(), time.Since(start)
+		rpc.Observe(info.FullMethod, code, elapsed)
+
+		var fields []string
+		if message, ok := req.(proto.Message); ok {
+			fields = metrics.RequestFields(message)
+		}
+		requestLog.Add(info.FullMethod, fields, code, start, elapsed)
+
+		return resp, err
+	}
+}
+
+// NewStreamMetricsInterceptor records the count and duration of streams by
+// method and status, like NewUnaryMetricsInterceptor. Streams are kept in the
+// request log without their fields.
+func NewStreamMetricsInterceptor(rpc *metrics.RPC, requestLog *metrics.RequestLog) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+
+		code, elapsed := status.Code(err).String(), time.Since(start)
+		rpc.Observe(info.FullMethod, code, elapsed)
+		requestLog.Add(info.FullMethod, nil, code, start, elapsed)
+
+		return err
+	}
+}
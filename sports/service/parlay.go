@@ -0,0 +1,104 @@
+package service
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sync"
+
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// ParlayRules decides whether events can be combined into multis/parlays.
+// Rules are held in memory so changes made through the admin RPC are lost on
+// restart, update the rules file to make them permanent.
+type ParlayRules struct {
+	mu    sync.RWMutex
+	rules []*sports.ParlayRule
+}
+
+// NewParlayRules creates an empty rule set, no events are multi eligible.
+func NewParlayRules() *ParlayRules {
+	return &ParlayRules{}
+}
+
+// LoadFile replaces the rules with those in a JSON file. The file has the
+// same format as the ListParlayRules response, e.g.
+//
+//	{"rules": [{"sport": "football", "multi_eligible": true, "max_legs": 10}]}
+func (p *ParlayRules) LoadFile(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var config sports.ListParlayRulesResponse
+	if err := protojson.Unmarshal(data, &config); err != nil {
+		return fmt.Errorf("invalid parlay rules file %s: %w", path, err)
+	}
+
+	return p.Set(config.Rules)
+}
+
+// List returns the current rules.
+func (p *ParlayRules) List() []*sports.ParlayRule {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.rules
+}
+
+// Set validates and replaces all the rules.
+func (p *ParlayRules) Set(rules []*sports.ParlayRule) error {
+	for _, rule := range rules {
+		if rule.Sport == "" {
+			return fmt.Errorf("parlay rule sport is required")
+		}
+		if rule.MaxLegs < 0 {
+			return fmt.Errorf("parlay rule for %s has negative max legs", rule.Sport)
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.rules = rules
+
+	return nil
+}
+
+// Apply sets the multi eligibility of an event from the most specific
+// matching rule. Events with no matching rule are not eligible.
+func (p *ParlayRules) Apply(event *sports.Event) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	var match *sports.ParlayRule
+
+	for _, rule := range p.rules {
+		if rule.Sport != event.Sport {
+			continue
+		}
+
+		if len(rule.Leagues) == 0 {
+			// A sport wide rule never overrides a league rule.
+			if match == nil {
+				match = rule
+			}
+			continue
+		}
+
+		for _, league := range rule.Leagues {
+			if league == event.League {
+				match = rule
+				break
+			}
+		}
+	}
+
+	event.MultiEligible = match != nil && match.MultiEligible
+	event.MaxLegs = 0
+	if event.MultiEligible {
+		event.MaxLegs = match.MaxLegs
+	}
+}
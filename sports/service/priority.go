@@ -0,0 +1,102 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"git.neds.sh/matty/entain/pkg/errorreason"
+	"golang.org/x/net/context"
+)
+
+// SportPriority is the order sports are listed in when events are listed
+// without an order_by, so the homepage ordering can be tuned without a
+// release. It is held in memory so changes made through the admin RPC are
+// lost on restart, update the flag to make them permanent.
+type SportPriority struct {
+	mu     sync.RWMutex
+	sports []string
+}
+
+// NewSportPriority creates a priority listing no sport first, leaving events
+// in the order of the database.
+func NewSportPriority() *SportPriority {
+	return &SportPriority{}
+}
+
+// List returns the sports listed first, in order.
+func (p *SportPriority) List() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.sports
+}
+
+// Set validates and replaces the sports listed first.
+func (p *SportPriority) Set(sports []string) error {
+	seen := make(map[string]bool, len(sports))
+	for _, sport := range sports {
+		if sport == "" {
+			return fmt.Errorf("sport priority sport is required")
+		}
+		if seen[sport] {
+			return fmt.Errorf("sport priority lists %s more than once", sport)
+		}
+		seen[sport] = true
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.sports = sports
+
+	return nil
+}
+
+// Parse replaces the sports listed first with those of a comma separated
+// list, e.g. "football,tennis".
+func (p *SportPriority) Parse(list string) error {
+	var sports []string
+	for _, sport := range strings.Split(list, ",") {
+		if sport = strings.TrimSpace(sport); sport != "" {
+			sports = append(sports, sport)
+		}
+	}
+
+	return p.Set(sports)
+}
+
+// Sort orders the sports by their priority, the others after them by name.
+func (p *SportPriority) Sort(sportsList []*sports.Sport) {
+	p.mu.RLock()
+	rank := make(map[string]int, len(p.sports))
+	for i, sport := range p.sports {
+		rank[sport] = i
+	}
+	p.mu.RUnlock()
+
+	rankOf := func(sport string) int {
+		if i, ok := rank[sport]; ok {
+			return i
+		}
+		return len(rank)
+	}
+
+	sort.SliceStable(sportsList, func(i, j int) bool {
+		return rankOf(sportsList[i].Name) < rankOf(sportsList[j].Name)
+	})
+}
+
+func (s *sportsService) GetSportPriority(ctx context.Context, in *sports.GetSportPriorityRequest) (*sports.SportPriority, error) {
+	return &sports.SportPriority{Sports: s.sportPriority.List()}, nil
+}
+
+func (s *sportsService) SetSportPriority(ctx context.Context, in *sports.SetSportPriorityRequest) (*sports.SportPriority, error) {
+	if err := s.sportPriority.Set(in.Sports); err != nil {
+		return nil, errorreason.InvalidValue.Error(err.Error(), nil)
+	}
+
+	return &sports.SportPriority{Sports: s.sportPriority.List()}, nil
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"git.neds.sh/matty/entain/pkg/errorreason"
+	"git.neds.sh/matty/entain/pkg/ratelimit"
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// forwardedForMetadataKey carries the addresses a request was forwarded for
+// by the gateway, the last is the address the gateway was called from.
+const forwardedForMetadataKey = "x-forwarded-for"
+
+// NewUnaryRateLimitInterceptor refuses unary requests over the limit of their
+// client for the method with ResourceExhausted, detailing when to retry. A nil
+// limiter doesn't limit.
+func NewUnaryRateLimitInterceptor(limiter *ratelimit.Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := rateLimit(ctx, limiter, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// NewStreamRateLimitInterceptor limits how often streams are opened, like
+// NewUnaryRateLimitInterceptor.
+func NewStreamRateLimitInterceptor(limiter *ratelimit.Limiter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := rateLimit(ss.Context(), limiter, info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func rateLimit(ctx context.Context, limiter *ratelimit.Limiter, method string) error {
+	if limiter == nil {
+		return nil
+	}
+
+	retryAfter, ok := limiter.Allow(method, rateLimitClient(ctx))
+	if ok {
+		return nil
+	}
+
+	message := fmt.Sprintf("rate limit exceeded, retry in %s", retryAfter.Round(time.Millisecond))
+
+	return errorreason.RateLimited.Error(message, nil, &errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryAfter),
+	})
+}
+
+// rateLimitClient identifies the client of a request for rate limiting, by the
+// identity the gateway authenticated, or else the address the gateway was
+// called from. Earlier forwarded addresses are given by the caller so aren't
+// trusted. Requests made directly are limited by their own address.
+func rateLimitClient(ctx context.Context) string {
+	if client := clientIdentity(ctx); client != "" {
+		return "client:" + client
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(forwardedForMetadataKey); len(values) > 0 {
+			addrs := strings.Split(values[len(values)-1], ",")
+			return "ip:" + strings.TrimSpace(addrs[len(addrs)-1])
+		}
+	}
+
+	if p, ok := peer.FromContext(ctx); ok {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return "ip:" + host
+		}
+		return "ip:" + p.Addr.String()
+	}
+
+	return ""
+}
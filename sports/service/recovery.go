@@ -0,0 +1,52 @@
+package service
+
+import (
+	"git.neds.sh/matty/entain/pkg/errorreason"
+	"git.neds.sh/matty/entain/pkg/logging"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// NewUnaryRecoveryInterceptor converts a panic in a unary RPC into an Internal
+// status, logging it with its stack trace, so one bad request doesn't take
+// down the server. It goes after the logging interceptor in the chain, so
+// the panic is logged with the request ID and the interceptors before it see
+// the Internal status.
+func NewUnaryRecoveryInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				resp, err = nil, recovered(logging.ForRequest(ctx, logger), info.FullMethod, r)
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// NewStreamRecoveryInterceptor converts a panic in a streaming RPC into an
+// Internal status, like NewUnaryRecoveryInterceptor.
+func NewStreamRecoveryInterceptor(logger *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(logging.ForRequest(ss.Context(), logger), info.FullMethod, r)
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
+
+// recovered logs a panic, called from the deferred function recovering it so
+// the stack trace is that of the panic.
+func recovered(logger *zap.Logger, method string, r interface{}) error {
+	logger.Error("panic handling request",
+		zap.String("method", method),
+		zap.Any("panic", r),
+		zap.Stack("stack"),
+	)
+
+	return errorreason.Internal.Error("internal error", nil)
+}
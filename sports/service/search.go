@@ -0,0 +1,19 @@
+package service
+
+import (
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"golang.org/x/net/context"
+)
+
+func (s *sportsService) SearchEvents(ctx context.Context, in *sports.SearchEventsRequest) (*sports.SearchEventsResponse, error) {
+	events, err := s.eventsRepo.Search(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, event := range events {
+		s.decorateEvent(event)
+	}
+
+	return &sports.SearchEventsResponse{Events: events}, nil
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"time"
+
+	"git.neds.sh/jmassey/entain/sports/db"
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"git.neds.sh/matty/entain/pkg/sqlfilter"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// anySport is the sport of the settlement delay applying to sports without
+// one of their own.
+const anySport = "*"
+
+// SettlementDelays are how long results of each sport typically take to be
+// recorded after their events close, estimating when events awaiting one are
+// settled.
+type SettlementDelays map[string]time.Duration
+
+// ParseSettlementDelays parses the delays of sports given as sport=duration
+// pairs, * giving the delay of the other sports, e.g. "football=30m,*=1h".
+func ParseSettlementDelays(s string) (SettlementDelays, error) {
+	return parseSportDurations(s, "settlement delay")
+}
+
+// Apply flags an event as awaiting its result when it has closed without
+// one, estimating when it is settled if its sport has a delay.
+func (d SettlementDelays) Apply(event *sports.Event) {
+	if event.Status != sqlfilter.StatusClosed || event.Result != nil {
+		return
+	}
+
+	event.ResultPending = true
+
+	delay, ok := d[event.Sport]
+	if !ok {
+		delay, ok = d[anySport]
+	}
+	if !ok {
+		return
+	}
+
+	closesAt := db.ClosesAt(event.Sport, event.AdvertisedStartTime.AsTime())
+	event.EstimatedSettlementTime = timestamppb.New(closesAt.Add(delay))
+}
@@ -1,41 +1,344 @@
 package service
 
 import (
+	"time"
+
 	"git.neds.sh/jmassey/entain/sports/db"
 	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"git.neds.sh/matty/entain/pkg/errorreason"
+	"git.neds.sh/matty/entain/pkg/fanout"
+	"git.neds.sh/matty/entain/pkg/featureflag"
+	"git.neds.sh/matty/entain/pkg/logsample"
+	"git.neds.sh/matty/entain/pkg/metrics"
+	"git.neds.sh/matty/entain/pkg/payloadlog"
+	"git.neds.sh/matty/entain/pkg/sqlfilter"
 	"golang.org/x/net/context"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type Sports interface {
 	// ListEvents will return a collection of events.
 	ListEvents(ctx context.Context, in *sports.ListEventsRequest) (*sports.ListEventsResponse, error)
 	GetEvent(ctx context.Context, in *sports.GetEventRequest) (*sports.Event, error)
+	// CreateEvent will create a new event.
+	CreateEvent(ctx context.Context, in *sports.CreateEventRequest) (*sports.Event, error)
+	// UpdateEvent will replace an existing event.
+	UpdateEvent(ctx context.Context, in *sports.UpdateEventRequest) (*sports.Event, error)
+	// PatchEvent will update only the fields of an event in the update mask.
+	PatchEvent(ctx context.Context, in *sports.PatchEventRequest) (*sports.Event, error)
+	// DeleteEvent will delete an event.
+	DeleteEvent(ctx context.Context, in *sports.DeleteEventRequest) (*emptypb.Empty, error)
+	// SetEventResult will record the result of an event, settling it.
+	SetEventResult(ctx context.Context, in *sports.SetEventResultRequest) (*sports.Event, error)
+	// GetEventResult will return the result of a settled event.
+	GetEventResult(ctx context.Context, in *sports.GetEventResultRequest) (*sports.EventResult, error)
+	// UpdateScore will record the current score of an event in play.
+	UpdateScore(ctx context.Context, in *sports.UpdateScoreRequest) (*sports.Event, error)
+	// CheckSchema will report any drift between the database schema and
+	// the columns the service requires.
+	CheckSchema(ctx context.Context, in *sports.CheckSchemaRequest) (*sports.CheckSchemaResponse, error)
+	// WatchEvents will stream events as their status, visibility, score or
+	// result changes.
+	WatchEvents(in *sports.WatchEventsRequest, stream sports.Sports_WatchEventsServer) error
+	// ListParlayRules will return the rules deciding multi eligibility.
+	ListParlayRules(ctx context.Context, in *sports.ListParlayRulesRequest) (*sports.ListParlayRulesResponse, error)
+	// SetParlayRules will replace the rules deciding multi eligibility.
+	SetParlayRules(ctx context.Context, in *sports.SetParlayRulesRequest) (*sports.ListParlayRulesResponse, error)
+	// ListFeatureFlags will return the feature flags of the service.
+	ListFeatureFlags(ctx context.Context, in *sports.ListFeatureFlagsRequest) (*sports.ListFeatureFlagsResponse, error)
+	// SetFeatureFlag will turn a feature flag on or off.
+	SetFeatureFlag(ctx context.Context, in *sports.SetFeatureFlagRequest) (*sports.FeatureFlag, error)
+	// ListLogSampling will return the fraction of requests logged by method.
+	ListLogSampling(ctx context.Context, in *sports.ListLogSamplingRequest) (*sports.ListLogSamplingResponse, error)
+	// SetLogSampling will change the fraction of requests to a method which
+	// are logged.
+	SetLogSampling(ctx context.Context, in *sports.SetLogSamplingRequest) (*sports.LogSamplingRule, error)
+	// ListPayloadLogging will return the methods whose request payloads are
+	// logged.
+	ListPayloadLogging(ctx context.Context, in *sports.ListPayloadLoggingRequest) (*sports.ListPayloadLoggingResponse, error)
+	// SetPayloadLogging will start or stop logging the request payloads of a
+	// method.
+	SetPayloadLogging(ctx context.Context, in *sports.SetPayloadLoggingRequest) (*sports.PayloadLoggingRule, error)
+	// ListChanges will return the changes to events after a sequence number.
+	ListChanges(ctx context.Context, in *sports.ListChangesRequest) (*sports.ListChangesResponse, error)
+	// WatchChanges will stream changes to events as they are made.
+	WatchChanges(in *sports.WatchChangesRequest, stream sports.Sports_WatchChangesServer) error
+	// SearchEvents will return the events whose name, or the name of either
+	// side, contains the query.
+	SearchEvents(ctx context.Context, in *sports.SearchEventsRequest) (*sports.SearchEventsResponse, error)
+	// ListSports will return the sports with events and how many each has.
+	ListSports(ctx context.Context, in *sports.ListSportsRequest) (*sports.ListSportsResponse, error)
+	// GetOperationalSummary will return counts of the events and the recent
+	// request and error rates of the service.
+	GetOperationalSummary(ctx context.Context, in *sports.GetOperationalSummaryRequest) (*sports.OperationalSummary, error)
+	// ListRecentRequests will return the last requests completed by the
+	// service, newest first.
+	ListRecentRequests(ctx context.Context, in *sports.ListRecentRequestsRequest) (*sports.ListRecentRequestsResponse, error)
+	// ListLeagues will return the leagues, by name.
+	ListLeagues(ctx context.Context, in *sports.ListLeaguesRequest) (*sports.ListLeaguesResponse, error)
+	// GetLeague will return a league by ID.
+	GetLeague(ctx context.Context, in *sports.GetLeagueRequest) (*sports.League, error)
+	// ListTeams will return the teams and players playing events, by name.
+	ListTeams(ctx context.Context, in *sports.ListTeamsRequest) (*sports.ListTeamsResponse, error)
+	// GetTeam will return a team by ID.
+	GetTeam(ctx context.Context, in *sports.GetTeamRequest) (*sports.Team, error)
+	// ListTeamAliases will return the aliases of teams, by team.
+	ListTeamAliases(ctx context.Context, in *sports.ListTeamAliasesRequest) (*sports.ListTeamAliasesResponse, error)
+	// SetTeamAliases will replace the aliases of a team, which the sides
+	// filter and searches match as well as its name.
+	SetTeamAliases(ctx context.Context, in *sports.SetTeamAliasesRequest) (*sports.TeamAliases, error)
+	// GetSportPriority will return the order sports are listed in when no
+	// order_by is given.
+	GetSportPriority(ctx context.Context, in *sports.GetSportPriorityRequest) (*sports.SportPriority, error)
+	// SetSportPriority will replace the order sports are listed in when no
+	// order_by is given.
+	SetSportPriority(ctx context.Context, in *sports.SetSportPriorityRequest) (*sports.SportPriority, error)
+	// GetMarkets will return the markets of an event with their prices.
+	GetMarkets(ctx context.Context, in *sports.GetMarketsRequest) (*sports.GetMarketsResponse, error)
+	// UpdatePrice will set the head to head price of a side of an event.
+	UpdatePrice(ctx context.Context, in *sports.UpdatePriceRequest) (*sports.Price, error)
 }
 
 // sportsService implements the Sports interface.
 type sportsService struct {
-	eventsRepo db.EventsRepo
+	eventsRepo    db.EventsRepo
+	leaguesRepo   db.LeaguesRepo
+	teamsRepo     db.TeamsRepo
+	eventHub      *EventHub
+	parlayRules   *ParlayRules
+	settlement    SettlementDelays
+	sportPriority *SportPriority
+	flags         *featureflag.Flags
+	logSampler    *logsample.Sampler
+	payloadLogger *payloadlog.Logger
+	rpcMetrics    *metrics.RPC
+	requestLog    *metrics.RequestLog
+	// queryTimeout bounds each of the queries run concurrently for a
+	// response.
+	queryTimeout time.Duration
 }
 
 // NewSportsService instantiates and returns a new sportsService.
-func NewSportsService(eventsRepo db.EventsRepo) Sports {
-	return &sportsService{eventsRepo}
+func NewSportsService(eventsRepo db.EventsRepo, leaguesRepo db.LeaguesRepo, teamsRepo db.TeamsRepo, eventHub *EventHub, parlayRules *ParlayRules, settlement SettlementDelays, sportPriority *SportPriority, flags *featureflag.Flags, logSampler *logsample.Sampler, payloadLogger *payloadlog.Logger, rpcMetrics *metrics.RPC, requestLog *metrics.RequestLog, queryTimeout time.Duration) Sports {
+	return &sportsService{eventsRepo, leaguesRepo, teamsRepo, eventHub, parlayRules, settlement, sportPriority, flags, logSampler, payloadLogger, rpcMetrics, requestLog, queryTimeout}
 }
 
 func (s *sportsService) ListEvents(ctx context.Context, in *sports.ListEventsRequest) (*sports.ListEventsResponse, error) {
-	events, err := s.eventsRepo.List(in.Filter, in.OrderBy)
+	page, err := sqlfilter.ParsePage(in.PageSize, in.PageToken)
+	if err != nil {
+		return nil, errorreason.InvalidValue.Error(err.Error(), nil)
+	}
+
+	// Only lists without an order_by of their own are ordered by the
+	// priority of their sports.
+	var sportPriority []string
+	if in.OrderBy == nil {
+		sportPriority = s.sportPriority.List()
+	}
+
+	var (
+		count  int64
+		events []*sports.Event
+	)
+
+	// The page, and the leagues embedded in it, don't depend on the count
+	// so they are queried together.
+	queries := fanout.New(ctx, s.queryTimeout)
+	queries.Go(func(ctx context.Context) (err error) {
+		count, err = s.eventsRepo.Count(ctx, in.Filter)
+		return err
+	})
+	if !in.CountOnly {
+		queries.Go(func(ctx context.Context) (err error) {
+			if events, err = s.eventsRepo.List(ctx, in.Filter, defaultOrderBy(ctx, in.OrderBy), sportPriority, page); err != nil {
+				return err
+			}
+
+			if in.IncludeLeague {
+				return s.embedLeagues(ctx, events)
+			}
+			return nil
+		})
+	}
+	if err := queries.Wait(); err != nil {
+		return nil, err
+	}
+
+	if in.CountOnly {
+		return &sports.ListEventsResponse{TotalCount: count, TotalSize: count}, nil
+	}
+
+	for _, event := range events {
+		s.decorateEvent(event)
+	}
+
+	return &sports.ListEventsResponse{
+		Events:        events,
+		TotalSize:     count,
+		NextPageToken: page.NextToken(len(events), count),
+	}, nil
+}
+
+func (s *sportsService) ListSports(ctx context.Context, in *sports.ListSportsRequest) (*sports.ListSportsResponse, error) {
+	result, err := s.eventsRepo.ListSports(ctx, in.Filter)
 	if err != nil {
 		return nil, err
 	}
 
-	return &sports.ListEventsResponse{Events: events}, nil
+	s.sportPriority.Sort(result)
+
+	return &sports.ListSportsResponse{Sports: result}, nil
 }
 
 func (s *sportsService) GetEvent(ctx context.Context, in *sports.GetEventRequest) (*sports.Event, error) {
-	event, err := s.eventsRepo.Get(in.Id)
+	event, err := s.eventsRepo.Get(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.decorateEvent(event)
+
+	if in.IncludeLeague {
+		if err := s.embedLeagues(ctx, []*sports.Event{event}); err != nil {
+			return nil, err
+		}
+	}
+
+	return event, nil
+}
+
+func (s *sportsService) CreateEvent(ctx context.Context, in *sports.CreateEventRequest) (*sports.Event, error) {
+	event, err := s.eventsRepo.Create(ctx, in.Event)
+	if err != nil {
+		return nil, err
+	}
+
+	s.decorateEvent(event)
+
+	return event, nil
+}
+
+func (s *sportsService) UpdateEvent(ctx context.Context, in *sports.UpdateEventRequest) (*sports.Event, error) {
+	event, err := s.eventsRepo.Update(ctx, in.Event)
+	if err != nil {
+		return nil, err
+	}
+
+	s.decorateEvent(event)
+
+	return event, nil
+}
+
+func (s *sportsService) PatchEvent(ctx context.Context, in *sports.PatchEventRequest) (*sports.Event, error) {
+	event, err := s.eventsRepo.Patch(ctx, in.Event, in.UpdateMask.GetPaths())
+	if err != nil {
+		return nil, err
+	}
+
+	s.decorateEvent(event)
+
+	return event, nil
+}
+
+func (s *sportsService) DeleteEvent(ctx context.Context, in *sports.DeleteEventRequest) (*emptypb.Empty, error) {
+	if err := s.eventsRepo.Delete(ctx, in.Id); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *sportsService) SetEventResult(ctx context.Context, in *sports.SetEventResultRequest) (*sports.Event, error) {
+	result := &sports.EventResult{HomeScore: in.HomeScore, AwayScore: in.AwayScore}
+
+	event, err := s.eventsRepo.SetResult(ctx, in.Id, result, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	s.decorateEvent(event)
+
+	return event, nil
+}
+
+func (s *sportsService) GetEventResult(ctx context.Context, in *sports.GetEventResultRequest) (*sports.EventResult, error) {
+	return s.eventsRepo.GetResult(ctx, in.Id)
+}
+
+func (s *sportsService) UpdateScore(ctx context.Context, in *sports.UpdateScoreRequest) (*sports.Event, error) {
+	score := &sports.Score{HomeScore: in.HomeScore, AwayScore: in.AwayScore}
+
+	event, err := s.eventsRepo.SetScore(ctx, in.Id, score, time.Now())
 	if err != nil {
 		return nil, err
 	}
 
+	s.decorateEvent(event)
+
 	return event, nil
 }
+
+func (s *sportsService) CheckSchema(ctx context.Context, in *sports.CheckSchemaRequest) (*sports.CheckSchemaResponse, error) {
+	tables, err := s.eventsRepo.CheckSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sports.CheckSchemaResponse{Tables: tables}, nil
+}
+
+func (s *sportsService) WatchEvents(in *sports.WatchEventsRequest, stream sports.Sports_WatchEventsServer) error {
+	if !s.flags.Enabled(FlagWatchEvents) {
+		return errorreason.FeatureDisabled.Error("watching events is disabled", map[string]string{"flag": FlagWatchEvents})
+	}
+
+	events, unsubscribe := s.eventHub.Subscribe(in.Filter)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case message, ok := <-events:
+			if !ok {
+				return errorreason.WatchClosed.Error("event watch closed, subscriber too slow or server shutting down", nil)
+			}
+
+			// Events are shared by every subscriber, so are decorated
+			// as copies.
+			event := proto.Clone(message).(*sports.Event)
+			s.decorateEvent(event)
+
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// decorateEvent sets the fields of an event derived by the service rather
+// than stored with it.
+func (s *sportsService) decorateEvent(event *sports.Event) {
+	s.applyParlayRules(event)
+	s.settlement.Apply(event)
+}
+
+// applyParlayRules sets the multi eligibility of an event, unless disabled.
+func (s *sportsService) applyParlayRules(event *sports.Event) {
+	if s.flags.Enabled(FlagParlayRules) {
+		s.parlayRules.Apply(event)
+	}
+}
+
+func (s *sportsService) ListParlayRules(ctx context.Context, in *sports.ListParlayRulesRequest) (*sports.ListParlayRulesResponse, error) {
+	return &sports.ListParlayRulesResponse{Rules: s.parlayRules.List()}, nil
+}
+
+func (s *sportsService) SetParlayRules(ctx context.Context, in *sports.SetParlayRulesRequest) (*sports.ListParlayRulesResponse, error) {
+	if err := s.parlayRules.Set(in.Rules); err != nil {
+		return nil, errorreason.InvalidValue.Error(err.Error(), nil)
+	}
+
+	return &sports.ListParlayRulesResponse{Rules: s.parlayRules.List()}, nil
+}
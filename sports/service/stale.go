@@ -0,0 +1,108 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"git.neds.sh/jmassey/entain/sports/db"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// StaleEventPolicy periodically hides events which started longer ago than
+// the threshold for their sport, so old events don't linger in listings.
+// Sports without a threshold are left alone.
+type StaleEventPolicy struct {
+	eventsRepo db.EventsRepo
+	staleAfter map[string]time.Duration
+	interval   time.Duration
+	logger     *zap.Logger
+}
+
+// NewStaleEventPolicy creates a policy hiding events of each sport once they
+// started staleAfter ago, checked every interval.
+func NewStaleEventPolicy(eventsRepo db.EventsRepo, staleAfter map[string]time.Duration, interval time.Duration, logger *zap.Logger) *StaleEventPolicy {
+	return &StaleEventPolicy{
+		eventsRepo: eventsRepo,
+		staleAfter: staleAfter,
+		interval:   interval,
+		logger:     logger,
+	}
+}
+
+// ParseStaleAfter parses the thresholds of sports given as sport=duration
+// pairs, e.g. "football=6h,tennis=12h".
+func ParseStaleAfter(s string) (map[string]time.Duration, error) {
+	return parseSportDurations(s, "stale event threshold")
+}
+
+// parseSportDurations parses durations of sports given as sport=duration
+// pairs, naming what they are in errors.
+func parseSportDurations(s string, what string) (map[string]time.Duration, error) {
+	durations := make(map[string]time.Duration)
+
+	for _, pair := range strings.Split(s, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid %s %q, expected sport=duration", what, pair)
+		}
+
+		duration, err := time.ParseDuration(strings.TrimSpace(parts[1]))
+		if err != nil || duration <= 0 {
+			return nil, fmt.Errorf("invalid %s %q, duration must be positive, e.g. 6h", what, pair)
+		}
+
+		durations[strings.TrimSpace(parts[0])] = duration
+	}
+
+	return durations, nil
+}
+
+// Run applies the policy every interval until the context is cancelled.
+func (p *StaleEventPolicy) Run(ctx context.Context) {
+	if len(p.staleAfter) == 0 {
+		return
+	}
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.apply(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *StaleEventPolicy) apply(ctx context.Context, now time.Time) {
+	sportNames := make([]string, 0, len(p.staleAfter))
+	for sport := range p.staleAfter {
+		sportNames = append(sportNames, sport)
+	}
+	sort.Strings(sportNames)
+
+	for _, sport := range sportNames {
+		after := p.staleAfter[sport]
+		reason := fmt.Sprintf("%s event started more than %s ago", sport, after)
+
+		hidden, err := p.eventsRepo.HideStale(ctx, sport, now.Add(-after), reason)
+		if err != nil {
+			p.logger.Error("failed hiding stale events", zap.String("sport", sport), zap.Error(err))
+			continue
+		}
+
+		if len(hidden) > 0 {
+			p.logger.Info("hid stale events", zap.String("sport", sport), zap.Int64s("ids", hidden))
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"time"
+
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"git.neds.sh/matty/entain/pkg/fanout"
+	"git.neds.sh/matty/entain/pkg/metrics"
+	"golang.org/x/net/context"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// upcomingWithin is how soon events must start to count as upcoming in the
+// operational summary.
+const upcomingWithin = time.Hour
+
+func (s *sportsService) GetOperationalSummary(ctx context.Context, in *sports.GetOperationalSummaryRequest) (*sports.OperationalSummary, error) {
+	var (
+		counts   map[string]int64
+		upcoming int64
+	)
+
+	queries := fanout.New(ctx, s.queryTimeout)
+	queries.Go(func(ctx context.Context) (err error) {
+		counts, err = s.eventsRepo.CountByStatus(ctx)
+		return err
+	})
+	queries.Go(func(ctx context.Context) (err error) {
+		upcoming, err = s.eventsRepo.Count(ctx, &sports.ListEventsRequestFilter{StartingWithin: durationpb.New(upcomingWithin)})
+		return err
+	})
+	if err := queries.Wait(); err != nil {
+		return nil, err
+	}
+
+	requests, errors := s.rpcMetrics.Recent("sports.Sports")
+
+	summary := &sports.OperationalSummary{
+		CountsByStatus:   counts,
+		UpcomingNextHour: upcoming,
+		Window:           durationpb.New(metrics.RecentSpan),
+		Requests:         requests,
+		Errors:           errors,
+	}
+	if requests > 0 {
+		summary.ErrorRate = float64(errors) / float64(requests)
+	}
+
+	return summary, nil
+}
+
+func (s *sportsService) ListRecentRequests(ctx context.Context, in *sports.ListRecentRequestsRequest) (*sports.ListRecentRequestsResponse, error) {
+	var requests []*sports.RecentRequest
+
+	for _, request := range s.requestLog.List(in.Method, int(in.Limit)) {
+		requests = append(requests, &sports.RecentRequest{
+			Method:    request.Method,
+			Fields:    request.Fields,
+			Code:      request.Code,
+			StartTime: timestamppb.New(request.Start),
+			Latency:   durationpb.New(request.Elapsed),
+		})
+	}
+
+	return &sports.ListRecentRequestsResponse{Requests: requests}, nil
+}
@@ -0,0 +1,19 @@
+package service
+
+import (
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"golang.org/x/net/context"
+)
+
+func (s *sportsService) ListTeams(ctx context.Context, in *sports.ListTeamsRequest) (*sports.ListTeamsResponse, error) {
+	teams, err := s.teamsRepo.List(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sports.ListTeamsResponse{Teams: teams}, nil
+}
+
+func (s *sportsService) GetTeam(ctx context.Context, in *sports.GetTeamRequest) (*sports.Team, error) {
+	return s.teamsRepo.Get(ctx, in.Id)
+}
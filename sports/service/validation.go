@@ -0,0 +1,61 @@
+package service
+
+import (
+	"git.neds.sh/matty/entain/pkg/errorreason"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// validator is a message with validation rules in the protos, checked by the
+// code protoc-gen-validate generates.
+type validator interface {
+	Validate() error
+}
+
+// UnaryValidationInterceptor rejects unary requests breaking the validation
+// rules of their message with InvalidArgument, before they reach the
+// repository.
+func UnaryValidationInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// StreamValidationInterceptor rejects the messages received on streams which
+// break their validation rules, like UnaryValidationInterceptor.
+func StreamValidationInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &validatedServerStream{ServerStream: ss})
+}
+
+// validatedServerStream validates each message received.
+type validatedServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatedServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return validate(m)
+}
+
+func validate(msg interface{}) error {
+	v, ok := msg.(validator)
+	if !ok {
+		return nil
+	}
+
+	if err := v.Validate(); err != nil {
+		var metadata map[string]string
+		if fieldErr, ok := err.(interface{ Field() string }); ok {
+			metadata = map[string]string{"field": fieldErr.Field()}
+		}
+
+		return errorreason.InvalidRequest.Error(err.Error(), metadata)
+	}
+
+	return nil
+}
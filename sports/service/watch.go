@@ -0,0 +1,150 @@
+package service
+
+import (
+	"time"
+
+	"git.neds.sh/jmassey/entain/sports/db"
+	"git.neds.sh/jmassey/entain/sports/proto/sports"
+	"git.neds.sh/matty/entain/pkg/watchhub"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+	"google.golang.org/protobuf/proto"
+)
+
+// EventHub periodically polls the events repository and fans out any events
+// whose status, visibility, score or result changed since the last poll to
+// subscribers.
+type EventHub struct {
+	hub *watchhub.Hub
+	now func() time.Time
+}
+
+// NewEventHub creates a new hub polling the events repository every interval.
+// Subscribers filtering on starting_within are matched by the clock now.
+func NewEventHub(eventsRepo db.EventsRepo, interval time.Duration, now func() time.Time, logger *zap.Logger) *EventHub {
+	list := func(ctx context.Context) ([]watchhub.Item, error) {
+		events, err := eventsRepo.List(ctx, nil, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]watchhub.Item, len(events))
+		for i, event := range events {
+			items[i] = watchhub.Item{
+				ID: event.Id,
+				State: &sports.Event{
+					Status:    event.Status,
+					Visible:   event.Visible,
+					Score:     event.Score,
+					Result:    event.Result,
+					SettledAt: event.SettledAt,
+				},
+				Message: event,
+			}
+		}
+
+		return items, nil
+	}
+
+	return &EventHub{hub: watchhub.New(list, interval, logger.With(zap.String("watched", "events"))), now: now}
+}
+
+// Run polls for changes until the context is cancelled.
+func (h *EventHub) Run(ctx context.Context) {
+	h.hub.Run(ctx)
+}
+
+// Subscribe registers interest in events matching the filter, see
+// watchhub.Hub.Subscribe. The events delivered are *sports.Event.
+func (h *EventHub) Subscribe(filter *sports.ListEventsRequestFilter) (<-chan proto.Message, func()) {
+	return h.hub.Subscribe(func(message proto.Message) bool {
+		return matchesFilter(message.(*sports.Event), filter, h.now())
+	})
+}
+
+// matchesFilter applies the same filtering as the events repository to a
+// single event in memory, bar the aliases of sides.
+func matchesFilter(event *sports.Event, filter *sports.ListEventsRequestFilter, now time.Time) bool {
+	if filter == nil {
+		return true
+	}
+
+	if len(filter.Sports) > 0 && !containsName(filter.Sports, event.Sport) {
+		return false
+	}
+
+	if len(filter.Leagues) > 0 && !containsID(filter.Leagues, event.League) {
+		return false
+	}
+
+	// Sides and matchups are matched by their names only, as the repository
+	// matches their aliases in its query.
+	if len(filter.Sides) > 0 && !containsName(filter.Sides, event.HomeSideName) && !containsName(filter.Sides, event.AwaySideName) {
+		return false
+	}
+
+	if len(filter.Matchups) > 0 && !containsMatchup(filter.Matchups, event) {
+		return false
+	}
+
+	if len(filter.Ids) > 0 && !containsID(filter.Ids, event.Id) {
+		return false
+	}
+
+	if containsID(filter.NotIds, event.Id) || containsName(filter.NotSports, event.Sport) {
+		return false
+	}
+
+	if filter.Visible != nil && *filter.Visible != event.Visible {
+		return false
+	}
+
+	if filter.Settled != nil && *filter.Settled != (event.Result != nil) {
+		return false
+	}
+
+	start := event.AdvertisedStartTime.AsTime()
+
+	if filter.AdvertisedStartAfter != nil && start.Before(filter.AdvertisedStartAfter.AsTime()) {
+		return false
+	}
+
+	if filter.AdvertisedStartBefore != nil && !start.Before(filter.AdvertisedStartBefore.AsTime()) {
+		return false
+	}
+
+	if filter.StartingWithin != nil {
+		if start.Before(now) || !start.Before(now.Add(filter.StartingWithin.AsDuration())) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsID(ids []int64, id int64) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+func containsMatchup(matchups []*sports.Matchup, event *sports.Event) bool {
+	for _, m := range matchups {
+		if m.HomeSide == event.HomeSideName && m.AwaySide == event.AwaySideName {
+			return true
+		}
+	}
+	return false
+}
+
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}